@@ -0,0 +1,170 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/rpc/client"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// the keeper of the fees of the messages of a module, the fees are governance params
+type MessageFeeKeeper interface {
+	MessageFee(ctx sdk.Ctx, msgType string) sdk.Int
+}
+
+// returns the message fee keepers of the nodes, apps and pocket core modules by the route of their messages
+func NewMessageFeeKeepers(nodesKeeper, appsKeeper, pocketKeeper MessageFeeKeeper) map[string]MessageFeeKeeper {
+	return map[string]MessageFeeKeeper{
+		nodesTypes.RouterKey:  nodesKeeper,
+		appsTypes.RouterKey:   appsKeeper,
+		pocketTypes.RouterKey: pocketKeeper,
+	}
+}
+
+// returns an AnteHandler that checks signatures and deducts the fees from the first signer;
+// the fee must cover the fee of every message from the state of its module (or the fee of the message for modules
+// without fee params) and, on CheckTx, the minimum gas prices of the node where the gas of a transaction is its size
+// in bytes, as the transactions don't meter gas
+func NewAnteHandler(ak auth.Keeper, feeKeepers map[string]MessageFeeKeeper) sdk.AnteHandler {
+	// the rpc client of the node is created once, on the first transaction, and reused after
+	var (
+		once sync.Once
+		c    client.Client
+	)
+	rpcClient := func(tmNode *node.Node) client.Client {
+		once.Do(func() { c = client.NewHTTP(tmNode.Config().RPC.ListenAddress, "/websocket") })
+		return c
+	}
+	return func(ctx sdk.Ctx, tx sdk.Tx, txBz []byte, tmNode *node.Node, simulate bool) (newCtx sdk.Ctx, res sdk.Result, abort bool) {
+		if addr := ak.GetModuleAddress(auth.FeeCollectorName); addr == nil {
+			panic(fmt.Sprintf("%s module account has not been set", auth.FeeCollectorName))
+		}
+		// all transactions must be of type auth.StdTx
+		stdTx, ok := tx.(auth.StdTx)
+		if !ok {
+			return newCtx, sdk.ErrInternal("tx must be StdTx").Result(), true
+		}
+		// check the fee covers the fees of the messages in state
+		requiredFee := TxFee(ctx, stdTx.GetMsgs(), feeKeepers)
+		if stdTx.Fee.AmountOf(sdk.DefaultStakeDenom).LT(requiredFee) {
+			return newCtx, sdk.ErrInsufficientFee(
+				fmt.Sprintf("insufficient fees; got: %q required: %q", stdTx.Fee, sdk.NewCoin(sdk.DefaultStakeDenom, requiredFee)),
+			).Result(), true
+		}
+		// the minimum gas prices are for local mempool purposes only, so they're checked on CheckTx
+		if ctx.IsCheckTx() && !simulate {
+			res := EnsureMinimumGasPrices(ctx, stdTx.Fee, int64(len(txBz)))
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+		}
+		params := ak.GetParams(ctx)
+		if res := auth.ValidateSigCount(stdTx, params); !res.IsOK() {
+			return newCtx, res, true
+		}
+		if err := tx.ValidateBasic(); err != nil {
+			return newCtx, err.Result(), true
+		}
+		if res := auth.ValidateMemo(stdTx, params); !res.IsOK() {
+			return newCtx, res, true
+		}
+		signerAddrs := stdTx.GetSigners()
+		signerAccs := make([]auth.Account, len(signerAddrs))
+		// fetch first signer, who's going to pay the fees
+		signerAccs[0], res = auth.GetSignerAcc(ctx, ak, signerAddrs[0])
+		if !res.IsOK() {
+			return newCtx, res, true
+		}
+		// deduct the fees
+		if !stdTx.Fee.IsZero() {
+			res = auth.DeductFees(ak, ctx, signerAccs[0], stdTx.Fee)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+			// reload the account as fees have been deducted
+			signerAccs[0] = ak.GetAccount(ctx, signerAccs[0].GetAddress())
+		}
+		// the hash of the transaction is the same for every signature, so the replay is checked once
+		if txIndexed(rpcClient(tmNode), txBz) {
+			return newCtx,
+				sdk.ErrUnauthorized(fmt.Sprint("transaction with this hash already found, possible replay attack, please try a different entropy")).Result(),
+				true
+		}
+		signBytes := auth.GetSignBytes(ctx.ChainID(), stdTx)
+		stdSigs := stdTx.GetSignatures()
+		for i := 0; i < len(stdSigs); i++ {
+			// skip the fee payer, account is cached and fees were deducted already
+			if i != 0 {
+				signerAccs[i], res = auth.GetSignerAcc(ctx, ak, signerAddrs[i])
+				if !res.IsOK() {
+					return newCtx, res, true
+				}
+			}
+			// check signature
+			signerAccs[i], res = processSig(signerAccs[i], stdSigs[i], signBytes, simulate)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+			ak.SetAccount(ctx, signerAccs[i])
+		}
+		return ctx, sdk.Result{}, false // continue...
+	}
+}
+
+// returns the fee of the messages from the state of their modules
+func TxFee(ctx sdk.Ctx, msgs []sdk.Msg, feeKeepers map[string]MessageFeeKeeper) sdk.Int {
+	fee := sdk.ZeroInt()
+	for _, msg := range msgs {
+		if k, ok := feeKeepers[msg.Route()]; ok {
+			fee = fee.Add(k.MessageFee(ctx, msg.Type()))
+			continue
+		}
+		fee = fee.Add(msg.GetFee())
+	}
+	return fee
+}
+
+// verifies the fee meets the minimum gas prices of the node for the gas of the transaction
+func EnsureMinimumGasPrices(ctx sdk.Ctx, fee sdk.Coins, gas int64) sdk.Result {
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() {
+		return sdk.Result{}
+	}
+	requiredFees := make(sdk.Coins, 0, len(minGasPrices))
+	for _, gp := range minGasPrices {
+		amount := gp.Amount.MulInt64(gas).Ceil().RoundInt()
+		requiredFees = append(requiredFees, sdk.NewCoin(gp.Denom, amount))
+	}
+	if !fee.IsAnyGTE(requiredFees) {
+		return sdk.ErrInsufficientFee(
+			fmt.Sprintf("insufficient fees; got: %q required: %q for the minimum gas prices %q", fee, requiredFees, minGasPrices),
+		).Result()
+	}
+	return sdk.Result{}
+}
+
+// returns true if the transaction is already in the tx index of the node
+// todo when editing tendermint, pass txIndexer so no http
+func txIndexed(c client.Client, txBz []byte) bool {
+	_, err := c.Tx(tmTypes.Tx(txBz).Hash(), false)
+	return err == nil
+}
+
+// verifies the signature against the public key of the account, or the one of the signature if the account has none
+func processSig(acc auth.Account, sig auth.StdSignature, signBytes []byte, simulate bool) (auth.Account, sdk.Result) {
+	pubKey, res := auth.ProcessPubKey(acc, sig)
+	if !res.IsOK() {
+		return nil, res
+	}
+	if !simulate && !pubKey.VerifyBytes(signBytes, sig.Signature) {
+		return nil, sdk.ErrUnauthorized("signature verification failed; verify correct account sequence and chain-id").Result()
+	}
+	return acc, res
+}
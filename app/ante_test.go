@@ -0,0 +1,69 @@
+package app
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/nodes"
+	types2 "github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+type mockMessageFeeKeeper int64
+
+func (k mockMessageFeeKeeper) MessageFee(ctx sdk.Ctx, msgType string) sdk.Int {
+	return sdk.NewInt(int64(k))
+}
+
+func TestTxFee(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+	msgs := []sdk.Msg{types2.MsgSend{}, types2.MsgSend{}}
+	// the fee is from the state of the module of the message
+	fee := TxFee(ctx, msgs, map[string]MessageFeeKeeper{types2.RouterKey: mockMessageFeeKeeper(10)})
+	assert.Equal(t, int64(20), fee.Int64())
+	// or the fee of the message for modules without fee params
+	fee = TxFee(ctx, msgs, map[string]MessageFeeKeeper{})
+	assert.Equal(t, int64(2*types2.SendFee), fee.Int64())
+}
+
+func TestEnsureMinimumGasPrices(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, true, log.NewNopLogger())
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(100)))
+	// no minimum gas prices
+	assert.True(t, EnsureMinimumGasPrices(ctx, fee, 1000).IsOK())
+	gasPrices, err := sdk.ParseDecCoins("0.1" + sdk.DefaultStakeDenom)
+	assert.Nil(t, err)
+	ctx = ctx.WithMinGasPrices(gasPrices)
+	assert.True(t, EnsureMinimumGasPrices(ctx, fee, 1000).IsOK())
+	assert.False(t, EnsureMinimumGasPrices(ctx, fee, 1001).IsOK())
+}
+
+func TestAnteHandler_InsufficientFee(t *testing.T) {
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	memCli, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	msg, err := Codec().MarshalJSON(types2.MsgSend{FromAddress: cb.GetAddress(), ToAddress: kp.GetAddress(), Amount: sdk.NewInt(1)})
+	assert.Nil(t, err)
+	// the fee is below the fee of the message in state
+	unsigned, err := BuildUnsignedTx(string(msg), "pocket-test", 0, types2.SendFee-1, "", true)
+	assert.Nil(t, err)
+	_, txHex, err := signOfflineTx(kb, cb.GetAddress(), "test", unsigned)
+	assert.Nil(t, err)
+	txBz, err := hex.DecodeString(txHex)
+	assert.Nil(t, err)
+	select {
+	case <-evtChan:
+		txResp, err := nodes.RawTx(memCodec(), memCli, cb.GetAddress(), txBz)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(sdk.CodeInsufficientFee), txResp.Code)
+	}
+	cleanup()
+	stopCli()
+}
@@ -41,11 +41,14 @@ func NewPocketCoreApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.
 		authSubspace,
 		moduleAccountPermissions,
 	)
+	// The chain validator validates the chains of the stakes against the pocket core chain registry
+	chainValidator := pocketKeeper.NewChainValidator(pocketSubspace)
 	// The nodesKeeper keeper handles pocket core nodes
 	app.nodesKeeper = nodesKeeper.NewKeeper(
 		app.cdc,
 		app.keys[nodesTypes.StoreKey],
 		app.accountKeeper,
+		chainValidator,
 		nodesSubspace,
 		nodesTypes.DefaultCodespace,
 	)
@@ -55,6 +58,7 @@ func NewPocketCoreApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.
 		app.keys[appsTypes.StoreKey],
 		app.nodesKeeper,
 		app.accountKeeper,
+		chainValidator,
 		appsSubspace,
 		appsTypes.DefaultCodespace,
 	)
@@ -64,7 +68,7 @@ func NewPocketCoreApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.
 		app.cdc,
 		app.nodesKeeper,
 		app.appsKeeper,
-		NewHostedChains(),
+		NewHostedChains(nil),
 		pocketSubspace,
 	)
 	// The governance keeper
@@ -102,7 +106,7 @@ func NewPocketCoreApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 	// The initChainer handles translating the genesis.json file into initial state for the network
 	app.SetInitChainer(app.InitChainer)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper))
+	app.SetAnteHandler(NewAnteHandler(app.accountKeeper, NewMessageFeeKeepers(app.nodesKeeper, app.appsKeeper, app.pocketKeeper)))
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	// initialize stores
@@ -21,13 +21,18 @@ func init() {
 	accountsCmd.AddCommand(createCmd)
 	accountsCmd.AddCommand(getValidator)
 	accountsCmd.AddCommand(setValidator)
+	accountsCmd.AddCommand(setServicer)
 	accountsCmd.AddCommand(deleteCmd)
 	accountsCmd.AddCommand(listCmd)
 	accountsCmd.AddCommand(showCmd)
 	accountsCmd.AddCommand(updatePassphraseCmd)
 	accountsCmd.AddCommand(signCmd)
+	accountsCmd.AddCommand(remoteSignerCmd)
 	accountsCmd.AddCommand(importArmoredCmd)
 	accountsCmd.AddCommand(importCmd)
+	accountsCmd.AddCommand(importMnemonicCmd)
+	accountsCmd.AddCommand(backupCmd)
+	accountsCmd.AddCommand(restoreCmd)
 	accountsCmd.AddCommand(exportCmd)
 	accountsCmd.AddCommand(exportRawCmd)
 	accountsCmd.AddCommand(sendTxCmd)
@@ -46,22 +51,136 @@ var accountsCmd = &cobra.Command{
 from creating and deleting accounts; to importing and exporting accounts.`,
 }
 
+var (
+	mnemonicAccounts uint32
+	mnemonicIndex    uint32
+)
+
+func init() {
+	createCmd.Flags().Uint32Var(&mnemonicAccounts, "accounts", 1, "the number of accounts to derive from the generated mnemonic")
+	importMnemonicCmd.Flags().Uint32Var(&mnemonicAccounts, "accounts", 1, "the number of accounts to derive from the mnemonic")
+	importMnemonicCmd.Flags().Uint32Var(&mnemonicIndex, "index", 0, "the index of the first account to derive from the mnemonic")
+}
+
 // createCmd represents the create command
 var createCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a new account",
-	Long: `Creates and persists a new account in the Keybase.
-Will prompt the user for a passphrase to encrypt the generated keypair.`,
+	Long: `Creates and persists a new account in the Keybase, derived from a newly generated BIP-0039 mnemonic.
+Derives --accounts accounts from the mnemonic, on the HD path m/44'/635'/0'/0'/<index>'.
+Will prompt the user for an optional BIP-0039 password for the mnemonic and for a passphrase to encrypt the generated keypairs.
+Write the mnemonic down: it recovers the accounts with the import command.`,
+	Args: cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
 		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
+		mnemonic, err := app.NewMnemonic()
+		if err != nil {
+			fmt.Printf("Account generation Failed, %s", err)
+			return
+		}
+		fmt.Print("Enter BIP-0039 Password (optional): \n")
+		bip39Pass := app.Credentials()
 		fmt.Print("Enter Passphrase: \n")
-		kp, err := kb.Create(app.Credentials())
+		kps, err := app.ImportMnemonic(kb, mnemonic, bip39Pass, app.Credentials(), 0, mnemonicAccounts)
 		if err != nil {
 			fmt.Printf("Account generation Failed, %s", err)
 			return
 		}
-		fmt.Printf("Account generated successfully:\nAddress: %s\n", kp.GetAddress())
+		fmt.Printf("Account generated successfully.\nMnemonic: %s\n", mnemonic)
+		printDerivedAccounts(kps, 0)
+	},
+}
+
+// importMnemonicCmd represents the import command
+var importMnemonicCmd = &cobra.Command{
+	Use:   "import <mnemonic>",
+	Short: "Import accounts using a mnemonic",
+	Long: `Imports the accounts derived from the BIP-0039 <mnemonic>, on the HD path m/44'/635'/0'/0'/<index>'.
+Derives --accounts accounts starting at --index, the accounts already in the Keybase are kept.
+Will prompt the user for the BIP-0039 password of the mnemonic and for a passphrase to encrypt the imported keypairs.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		kb := keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
+		// the words of the mnemonic may be passed as separate arguments
+		mnemonic := strings.Join(args, " ")
+		fmt.Print("Enter BIP-0039 Password (optional): \n")
+		bip39Pass := app.Credentials()
+		fmt.Print("Enter Encrypt Passphrase: \n")
+		kps, err := app.ImportMnemonic(kb, mnemonic, bip39Pass, app.Credentials(), mnemonicIndex, mnemonicAccounts)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Account imported successfully.")
+		printDerivedAccounts(kps, mnemonicIndex)
+	},
+}
+
+// print the addresses of the accounts derived from a mnemonic with their HD paths
+func printDerivedAccounts(kps []keys.KeyPair, firstIndex uint32) {
+	for i, kp := range kps {
+		fmt.Printf("Address: %s (%s)\n", kp.GetAddress(), fmt.Sprintf(app.PocketHDPathFormat, firstIndex+uint32(i)))
+	}
+}
+
+// backupCmd represents the backup command
+var backupCmd = &cobra.Command{
+	Use:   "backup <path>",
+	Short: "Back up the Keybase",
+	Long: `Backs up every account of the Keybase and its multisig accounts to the file at <path>, encrypted with a backup passphrase.
+The accounts stay encrypted with their own passphrases inside the backup.
+Will prompt the user for the backup passphrase.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		fmt.Println("Enter Backup Passphrase")
+		pass := app.Credentials()
+		fmt.Println("Confirm Backup Passphrase")
+		if app.Credentials() != pass {
+			fmt.Println("the passphrases do not match")
+			return
+		}
+		backup, n, err := app.BackupKeybase(app.MustGetKeybase(), app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir, pass)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if err := ioutil.WriteFile(args[0], backup, 0600); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Backed up %d accounts to %s\n", n, args[0])
+	},
+}
+
+// restoreCmd represents the restore command
+var restoreCmd = &cobra.Command{
+	Use:   "restore <path>",
+	Short: "Restore the Keybase from a backup",
+	Long: `Restores every account of the Keybase backup file at <path> into the Keybase, the accounts already in the Keybase are kept.
+The restored accounts keep the passphrases they had when backed up.
+Will prompt the user for the backup passphrase, then for the passphrase of every account to restore.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		backup, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Backup Passphrase")
+		pass := app.Credentials()
+		n, err := app.RestoreKeybase(keys.New(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir), app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir, backup, pass, func(addr types.Address) string {
+			fmt.Printf("Enter the Passphrase of %s\n", addr)
+			return app.Credentials()
+		})
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Restored %d accounts from %s\n", n, args[0])
 	},
 }
 
@@ -98,6 +217,27 @@ var setValidator = &cobra.Command{
 	},
 }
 
+var setServicer = &cobra.Command{
+	Use:   "set-servicer <address>",
+	Short: "Sets the servicer account of the node",
+	Long: `Sets the account the node signs relays, claims and proofs with, separate from the validator account used for consensus.
+The account must be registered as the servicer key of the node with the rotate-servicer-key transaction.
+The replaced servicer key is kept in the data directory to prove the relays it served.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		addr, err := types.AddressFromHex(args[0])
+		if err != nil {
+			fmt.Printf("Address Error %s", err)
+			return
+		}
+		fmt.Println("Enter the password:")
+		if err := app.SetServicer(addr, app.Credentials()); err != nil {
+			fmt.Println(err)
+		}
+	},
+}
+
 // deleteCmd represents the delete command
 var deleteCmd = &cobra.Command{
 	Use:   "delete  <address>",
@@ -251,6 +391,27 @@ Will prompt the user for the account passphrase.`,
 	},
 }
 
+var remoteSignerCmd = &cobra.Command{
+	Use:   "remote-signer <address> <http|privval> <signerAddr>",
+	Short: "Run a stand-in remote signer for the servicer key of a node",
+	Long: `Runs a remote signer for the servicer key of a node with the specified <address> account credentials,
+so the node signs relays, claims and proofs through this process instead of holding the key (see remote_signer_type in the config).
+The servicer key must be separate from the consensus key of the node, the signer only signs relays, probes and servicer transactions.
+The http signer listens on <signerAddr> (host:port) over https with mutual tls (see the remote_signer_tls files in the config),
+the privval signer dials the node at <signerAddr> (tcp://host:port or unix://path).
+Will prompt the user for the account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		fmt.Println("Enter passphrase: ")
+		err := app.ServeRemoteSigner(args[0], app.Credentials(), args[1], args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+	},
+}
+
 var importArmoredCmd = &cobra.Command{
 	Use:   "import-armored <path/to/armoredJson>",
 	Short: "Import keypair using armor",
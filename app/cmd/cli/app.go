@@ -16,10 +16,21 @@ import (
 func init() {
 	rootCmd.AddCommand(appCmd)
 	appCmd.AddCommand(appStakeCmd)
+	appCmd.AddCommand(appEditStakeCmd)
+	appCmd.AddCommand(appDepositCmd)
 	appCmd.AddCommand(appUnstakeCmd)
+	appCmd.AddCommand(appPartialUnstakeCmd)
 	appCmd.AddCommand(createAATCmd)
+	appCmd.AddCommand(revokeAATCmd)
+	createAATCmd.Flags().Int64Var(&aatExpirationHeight, "expiration-height", 0, "the session height the token expires at (creates a 0.0.2 token)")
+	createAATCmd.Flags().StringVar(&aatChains, "chains", "", "a comma separated list of the only chains the token is valid for (creates a 0.0.2 token)")
+	createAATCmd.Flags().Int64Var(&aatMaxRelays, "max-relays", 0, "the max relays per servicer per session of the token (creates a 0.0.2 token)")
 }
 
+var aatExpirationHeight int64
+var aatChains string
+var aatMaxRelays int64
+
 var appCmd = &cobra.Command{
 	Use:   "apps",
 	Short: "application management",
@@ -57,6 +68,68 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 	},
 }
 
+var appEditStakeCmd = &cobra.Command{
+	Use:   "edit-stake <fromAddr> <amount> <chains>",
+	Short: "Edit the stake of an app in the network",
+	Long: `Edit the stake of a staked app without unstaking, increasing the stake to <amount> and changing the chains.
+The stake can't be decreased and the new chains are applied at the end of the current session.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		fromAddr := args[0]
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		reg, err := regexp.Compile("[^,a-zA-Z0-9]+")
+		if err != nil {
+			log.Fatal(err)
+		}
+		rawChains := reg.ReplaceAllString(args[2], "")
+		chains := strings.Split(rawChains, ",")
+		fmt.Println("Enter passphrase: ")
+		res, err := app.EditStakeApp(chains, fromAddr, app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
+var appDepositCmd = &cobra.Command{
+	Use:   "deposit <fromAddr> <amount> <chains>",
+	Short: "Deposit into the escrow of a prepaid app",
+	Long: `Deposit <amount> into the escrow of a prepaid app, which pays the servicers for its relays instead of a stake.
+The first deposit of an unstaked account stakes it as a prepaid app on <chains>; the app is paused once the escrow runs out and a deposit resumes it.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		fromAddr := args[0]
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		reg, err := regexp.Compile("[^,a-zA-Z0-9]+")
+		if err != nil {
+			log.Fatal(err)
+		}
+		rawChains := reg.ReplaceAllString(args[2], "")
+		chains := strings.Split(rawChains, ",")
+		fmt.Println("Enter passphrase: ")
+		res, err := app.DepositApp(chains, fromAddr, app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
 var appUnstakeCmd = &cobra.Command{
 	Use:   "unstake <fromAddr>",
 	Short: "Unstake an app from the network",
@@ -75,10 +148,37 @@ Prompts the user for the <fromAddr> account passphrase.`,
 	},
 }
 
+var appPartialUnstakeCmd = &cobra.Command{
+	Use:   "partial-unstake <fromAddr> <amount>",
+	Short: "Unstake part of the stake of an app in the network",
+	Long: `Unstakes the <amount> of tokens from the stake of the app, while the app stays staked with the rest.
+The tokens are released to <fromAddr> after the unstaking time of the network and the max relays of the app are lowered right away.
+The stake left must stay above the minimum stake.
+Prompts the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := app.PartialUnstakeApp(args[0], app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
 var createAATCmd = &cobra.Command{
-	Use:   "create-aat <appAddr> <clientPubKey>",
+	Use:   "create-aat <appAddr> <clientPubKey> --expiration-height <height> --chains <chains> --max-relays <relays>",
 	Short: "Creates an application authentication token",
 	Long: `Creates a signed application authentication token (version 0.0.1 of the AAT spec), that can be embedded into application software for Relay servicing.
+If any of the scope flags are set, a scoped token (version 0.0.2 of the AAT spec) is created, that expires at the --expiration-height,
+is only valid for the --chains and is limited to --max-relays per servicer per session.
 Will prompt the user for the <appAddr> account passphrase.
 Read the Application Authentication Token documentation for more information.
 NOTE: USE THIS METHOD AT YOUR OWN RISK. READ THE APPLICATION SECURITY GUIDELINES IN ORDER TO UNDERSTAND WHAT'S THE RECOMMENDED AAT CONFIGURATION FOR YOUR APPLICATION.`,
@@ -101,7 +201,39 @@ NOTE: USE THIS METHOD AT YOUR OWN RISK. READ THE APPLICATION SECURITY GUIDELINES
 			return
 		}
 		fmt.Println("Enter passphrase: ")
-		aatBytes, err := app.GenerateAAT(hex.EncodeToString(res.PublicKey.RawBytes()), args[1], app.Credentials())
+		var aatBytes []byte
+		if aatExpirationHeight != 0 || aatChains != "" || aatMaxRelays != 0 {
+			var chains []string
+			if aatChains != "" {
+				chains = strings.Split(aatChains, ",")
+			}
+			aatBytes, err = app.GenerateScopedAAT(hex.EncodeToString(res.PublicKey.RawBytes()), args[1], aatExpirationHeight, chains, aatMaxRelays, app.Credentials())
+		} else {
+			aatBytes, err = app.GenerateAAT(hex.EncodeToString(res.PublicKey.RawBytes()), args[1], app.Credentials())
+		}
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
 		fmt.Println(string(aatBytes))
 	},
 }
+
+var revokeAATCmd = &cobra.Command{
+	Use:   "revoke-aat <appAddr> <clientPubKey>",
+	Short: "Revokes the application authentication tokens of a client",
+	Long: `Revokes every application authentication token the app issued to the <clientPubKey>.
+Relays of revoked tokens are rejected from the next block, and proofs of sessions started after the revocation are invalid.
+Will prompt the user for the <appAddr> account passphrase.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		fmt.Println("Enter Password: ")
+		res, err := app.RevokeAAT(args[0], args[1], app.Credentials())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -16,8 +15,11 @@ func init() {
 	govCmd.AddCommand(govDAOTransfer)
 	govCmd.AddCommand(govChangeParam)
 	govCmd.AddCommand(govUpgrade)
+	govChangeParam.Flags().BoolVar(&paramDryRun, "dry-run", false, "simulate the change against the current state and report the affected nodes and apps, without broadcasting it")
 }
 
+var paramDryRun bool
+
 var govCmd = &cobra.Command{
 	Use:   "gov",
 	Short: "governance management",
@@ -59,17 +61,29 @@ var govChangeParam = &cobra.Command{
 	Use:   "change_param <fromAddr> <paramKey module/param> <paramValue (jsonObj)>",
 	Short: "Edit a param in the network",
 	Long: `If authorized, submit a tx to change any param from any module.
+The <paramValue> of the pos, application and pocketcore params is decoded into the type of the param (amino json, quote 64 bit integers)
+and the resulting params are validated before broadcast.
+With --dry-run the change is simulated against the current state, reporting the affected nodes and apps, and not broadcast.
 Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
-		fmt.Println("Enter Password: ")
-		var i interface{}
-		err := json.Unmarshal([]byte(args[2]), &i)
+		if paramDryRun {
+			report, err := app.QueryParamChange(args[1], []byte(args[2]), 0)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			fmt.Println(report.String())
+			return
+		}
+		value, err := app.NewParamChange(args[1], []byte(args[2]))
 		if err != nil {
-			panic(err)
+			fmt.Println(err)
+			return
 		}
-		res, err := app.ChangeParam(args[0], args[1], i, app.Credentials())
+		fmt.Println("Enter Password: ")
+		res, err := app.ChangeParam(args[0], args[1], value, app.Credentials())
 		if err != nil {
 			fmt.Println(err)
 			return
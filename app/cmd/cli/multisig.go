@@ -0,0 +1,209 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(multisigCmd)
+	multisigCmd.AddCommand(msCreateCmd)
+	multisigCmd.AddCommand(msListCmd)
+	multisigCmd.AddCommand(msShowCmd)
+	multisigCmd.AddCommand(msDeleteCmd)
+	multisigCmd.AddCommand(msBuildCmd)
+	multisigCmd.AddCommand(msSignCmd)
+	multisigCmd.AddCommand(msMergeCmd)
+	multisigCmd.AddCommand(msStatusCmd)
+	msBuildCmd.Flags().BoolVar(&txOffline, "offline", false, "build the transaction without a connection to a node, the chain id is required")
+	msBuildCmd.Flags().StringVar(&txChainID, "chain-id", "", "the chain id of the network, fetched from the node when not offline and empty")
+	msBuildCmd.Flags().Int64Var(&txEntropy, "entropy", 0, "the entropy of the transaction preventing its replay, random when 0")
+	msBuildCmd.Flags().Int64Var(&txFee, "fee", 0, "the fee of the transaction, the fee of the message in state (or its default fee offline) when 0")
+	msBuildCmd.Flags().StringVar(&txMemo, "memo", "", "the memo of the transaction")
+	msBuildCmd.Flags().StringVar(&txOutput, "output", "", "the file to write the partial signature file to, stdout when empty")
+	msSignCmd.Flags().StringVar(&txOutput, "output", "", "the file to write the partial signature file to, stdout when empty")
+	msMergeCmd.Flags().StringVar(&txOutput, "output", "", "the file to write the merged partial signature file to, stdout when empty")
+}
+
+// multisigCmd represents the multisig namespace command
+var multisigCmd = &cobra.Command{
+	Use:   "multisig",
+	Short: "named multisig accounts and their transactions",
+	Long: `The multisig namespace handles the named multisig accounts of the keybase and the signing of their transactions.
+A multisig transaction is a partial signature file passed between the members, or signed in parallel and merged,
+until every member signed; then the hex encoded transaction is broadcast with the send-raw-tx command of the accounts namespace.`,
+}
+
+var msCreateCmd = &cobra.Command{
+	Use:   "create <name> <ordered-comma-separated-hex-pubkeys>",
+	Short: "Create a named multisig account",
+	Long: `Adds the multisig account of the ordered members to the keybase under <name>.
+Every member signs the transactions of a multisig account.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		ma, err := app.NewMultisigAccount(args[0], strings.Split(strings.TrimSpace(args[1]), ","))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if err := app.AddMultisigAccount(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir, ma); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Multisig account created successfully:\n%s", ma.String())
+	},
+}
+
+var msListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the multisig accounts",
+	Long:  `Lists the named multisig accounts of the keybase.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		mas, err := app.ListMultisigAccounts(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		for i, ma := range mas {
+			addr, _ := ma.Address()
+			fmt.Printf("(%d) %s %s (%d members)\n", i, ma.Name, addr, len(ma.PublicKeys))
+		}
+	},
+}
+
+var msShowCmd = &cobra.Command{
+	Use:   "show <name-or-address>",
+	Short: "Show a multisig account",
+	Long:  `Shows the address and the ordered members of the multisig account.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		ma, err := app.GetMultisigAccount(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir, args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Print(ma.String())
+	},
+}
+
+var msDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a multisig account",
+	Long:  `Deletes the multisig account from the keybase, the keys of its members are untouched.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		if err := app.DeleteMultisigAccount(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir, args[0]); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Multisig account deleted successfully")
+	},
+}
+
+var msBuildCmd = &cobra.Command{
+	Use:   "build <name-or-address> <json-message> --offline --chain-id <chainID> --entropy <entropy> --fee <fee> --memo <memo> --output <path>",
+	Short: "Build a multisig transaction",
+	Long: `Builds the partial signature file of the amino json <json-message> signed by the multisig account.
+With --offline nothing is fetched from a node, so the --chain-id is required.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		ma, err := app.GetMultisigAccount(app.GlobalConfig.PocketConfig.KeybaseName, app.GlobalConfig.PocketConfig.DataDir, args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := app.BuildMultisigTx(ma, args[1], txChainID, txEntropy, txFee, txMemo, txOffline)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		writeTxOutput(res)
+	},
+}
+
+var msSignCmd = &cobra.Command{
+	Use:   "sign <member-address> <path/to/multisig-tx.json> --output <path>",
+	Short: "Sign a multisig transaction",
+	Long: `Adds the signature of the member <member-address> from the keybase to the partial signature file, without a connection to a node.
+Once every member signed, the hex encoded transaction is printed to broadcast with: pocket accounts send-raw-tx <fromAddr> <txBytes>.
+Will prompt the user for the account passphrase.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		bz, err := ioutil.ReadFile(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Passphrase: ")
+		res, txHex, err := app.SignMultisigTx(args[0], app.Credentials(), bz)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		writeMultisigTxOutput(res, txHex)
+	},
+}
+
+var msMergeCmd = &cobra.Command{
+	Use:   "merge <path/to/multisig-tx.json>... --output <path>",
+	Short: "Merge the signatures of multisig transactions",
+	Long:  `Merges the signatures of the partial signature files of the same multisig transaction, signed in parallel by the members.`,
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		var txs [][]byte
+		for _, path := range args {
+			bz, err := ioutil.ReadFile(path)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			txs = append(txs, bz)
+		}
+		res, txHex, err := app.MergeMultisigTxs(txs...)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		writeMultisigTxOutput(res, txHex)
+	},
+}
+
+var msStatusCmd = &cobra.Command{
+	Use:   "status <path/to/multisig-tx.json>",
+	Short: "Show the status of a multisig transaction",
+	Long:  `Shows the members that signed the partial signature file and the members it's waiting on.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		bz, err := ioutil.ReadFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		status, err := app.GetMultisigTxStatus(bz)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Print(status.String())
+	},
+}
+
+// write the partial signature file and print the transaction bytes once complete
+func writeMultisigTxOutput(tx []byte, txHex string) {
+	writeTxOutput(tx)
+	if txHex == "" {
+		fmt.Println("The transaction is waiting on the signatures of the other members")
+		return
+	}
+	fmt.Printf("Transaction bytes:\n%s\n", txHex)
+}
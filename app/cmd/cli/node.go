@@ -15,21 +15,80 @@ import (
 func init() {
 	rootCmd.AddCommand(nodesCmd)
 	nodesCmd.AddCommand(nodeStakeCmd)
+	nodesCmd.AddCommand(nodeEditStakeCmd)
+	nodesCmd.AddCommand(nodeRotateServicerKeyCmd)
 	nodesCmd.AddCommand(nodeUnstakeCmd)
+	nodesCmd.AddCommand(nodePartialUnstakeCmd)
 	nodesCmd.AddCommand(nodeUnjailCmd)
+	nodesCmd.AddCommand(nodeDelegateCmd)
+	nodesCmd.AddCommand(nodeUndelegateCmd)
+	nodeStakeCmd.Flags().Int64Var(&commissionRate, "commission-rate", 0, "the percentage (0-100) of the delegator rewards kept by the node")
+	nodeStakeCmd.Flags().StringVar(&outputAddress, "output-address", "", "the address receiving the rewards and unstaked tokens of the node (must co-sign)")
+	nodeStakeCmd.Flags().StringVar(&currentOutputAddress, "current-output-address", "", "the current output address of a restaking node changing its output address (must co-sign)")
 }
 
+var (
+	commissionRate       int64
+	outputAddress        string
+	currentOutputAddress string
+)
+
 var nodesCmd = &cobra.Command{
 	Use:   "nodes",
 	Short: "node management",
 	Long: `The node namespace handles all node related interactions,
-from staking and unstaking; to unjailing.`,
+from staking and unstaking; to unjailing and delegating.`,
 }
 
 var nodeStakeCmd = &cobra.Command{
 	Use:   "stake <fromAddr> <amount> <chains> <serviceURI>",
 	Short: "Stake a node in the network",
 	Long: `Stake the node into the network, making it available for service.
+Will prompt the user for the <fromAddr> account passphrase.
+If an --output-address different from <fromAddr> is set, will also prompt for the output account passphrase.
+Once set, the output address of a node only changes with the --current-output-address, which prompts for the current output account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		fromAddr := args[0]
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		reg, err := regexp.Compile("[^,a-zA-Z0-9]+")
+		if err != nil {
+			log.Fatal(err)
+		}
+		rawChains := reg.ReplaceAllString(args[2], "")
+		chains := strings.Split(rawChains, ",")
+		serviceURI := args[3]
+		fmt.Println("Enter Passphrase: ")
+		passphrase := app.Credentials()
+		var outputPassphrase string
+		if outputAddress != "" && outputAddress != fromAddr {
+			fmt.Println("Enter Output Passphrase: ")
+			outputPassphrase = app.Credentials()
+		}
+		var currentOutputPassphrase string
+		if currentOutputAddress != "" && currentOutputAddress != fromAddr && currentOutputAddress != outputAddress {
+			fmt.Println("Enter Current Output Passphrase: ")
+			currentOutputPassphrase = app.Credentials()
+		}
+		res, err := app.StakeNode(chains, serviceURI, fromAddr, passphrase, types.NewInt(int64(amount)), commissionRate, outputAddress, outputPassphrase, currentOutputAddress, currentOutputPassphrase)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
+var nodeEditStakeCmd = &cobra.Command{
+	Use:   "edit-stake <fromAddr> <amount> <chains> <serviceURI>",
+	Short: "Edit the stake of a node in the network",
+	Long: `Edit the stake of a staked node without unstaking, increasing the stake to <amount> and changing the chains and service URI.
+The stake can't be decreased and the new chains and service URI are applied at the end of the current session.
 Will prompt the user for the <fromAddr> account passphrase.`,
 	Args: cobra.ExactArgs(4),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -48,7 +107,33 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 		chains := strings.Split(rawChains, ",")
 		serviceURI := args[3]
 		fmt.Println("Enter Passphrase: ")
-		res, err := app.StakeNode(chains, serviceURI, fromAddr, app.Credentials(), types.NewInt(int64(amount)))
+		res, err := app.EditStakeNode(chains, serviceURI, fromAddr, app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
+var nodeRotateServicerKeyCmd = &cobra.Command{
+	Use:   "rotate-servicer-key <fromAddr> <servicerAddr>",
+	Short: "Rotate the key a node signs relays, claims and proofs with",
+	Long: `Registers the <servicerAddr> account as the servicer key of the <fromAddr> node, separate from its consensus key.
+The node signs relay responses, claims and proofs with the servicer key, so the servicer key must pay the claim and proof fees.
+Rotating to the <fromAddr> account removes the servicer key. The rotation is effective immediately: restart the node signing with the new key.
+Will prompt the user for the <fromAddr> and the <servicerAddr> account passphrases, as the servicer key co-signs the transaction.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		fmt.Println("Enter Passphrase: ")
+		passphrase := app.Credentials()
+		servicerPassphrase := passphrase
+		if args[1] != args[0] {
+			fmt.Println("Enter Servicer Passphrase: ")
+			servicerPassphrase = app.Credentials()
+		}
+		res, err := app.RotateServicerKey(args[0], passphrase, args[1], servicerPassphrase)
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -75,6 +160,31 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 	},
 }
 
+var nodePartialUnstakeCmd = &cobra.Command{
+	Use:   "partial-unstake <fromAddr> <amount>",
+	Short: "Unstake part of the stake of a node in the network",
+	Long: `Unstakes the <amount> of tokens from the stake of the node, while the node stays staked with the rest.
+The tokens are released to the output address of the node after the unstaking time of the network.
+The stake left must stay above the minimum stake and tokens delegated to the node can't be unstaked.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := app.PartialUnstakeNode(args[0], app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
 var nodeUnjailCmd = &cobra.Command{
 	Use:   "unjail <fromAddr>",
 	Short: "Unjails a node in the network",
@@ -92,3 +202,51 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
 	},
 }
+
+var nodeDelegateCmd = &cobra.Command{
+	Use:   "delegate <fromAddr> <nodeAddr> <amount>",
+	Short: "Delegate tokens to a node in the network",
+	Long: `Delegates the <amount> of tokens from <fromAddr> to the staked node at <nodeAddr>.
+The delegator earns a share of the relay rewards of the node, minus the node commission, and is slashed with the node.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		amount, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Passphrase: ")
+		res, err := app.DelegateToNode(args[0], args[1], app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
+
+var nodeUndelegateCmd = &cobra.Command{
+	Use:   "undelegate <fromAddr> <nodeAddr> <amount>",
+	Short: "Undelegate tokens from a node in the network",
+	Long: `Undelegates the <amount> of tokens of <fromAddr> from the node at <nodeAddr>.
+The tokens are released to <fromAddr> after the unstaking time of the network.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		amount, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Passphrase: ")
+		res, err := app.UndelegateFromNode(args[0], args[1], app.Credentials(), types.NewInt(int64(amount)))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Transaction Submitted: %s\n", res.TxHash)
+	},
+}
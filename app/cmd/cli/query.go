@@ -25,14 +25,20 @@ func init() {
 	queryCmd.AddCommand(queryBalance)
 	queryCmd.AddCommand(queryAccount)
 	queryCmd.AddCommand(queryNode)
+	queryCmd.AddCommand(queryNodeDelegations)
+	queryCmd.AddCommand(queryDelegations)
 	queryCmd.AddCommand(queryApps)
 	queryCmd.AddCommand(queryApp)
 	queryCmd.AddCommand(queryNodeParams)
 	queryCmd.AddCommand(queryAppParams)
+	queryCmd.AddCommand(queryAppRelays)
+	queryCmd.AddCommand(queryAppEscrow)
+	queryCmd.AddCommand(queryAppUsage)
 	queryCmd.AddCommand(queryNodeReceipts)
 	queryCmd.AddCommand(queryNodeReceipt)
 	queryCmd.AddCommand(queryPocketParams)
 	queryCmd.AddCommand(queryPocketSupportedChains)
+	queryCmd.AddCommand(queryChainRegistry)
 	queryCmd.AddCommand(querySupply)
 	queryCmd.AddCommand(queryUpgrade)
 	queryCmd.AddCommand(queryACL)
@@ -332,6 +338,65 @@ var queryNode = &cobra.Command{
 	},
 }
 
+var queryNodeDelegations = &cobra.Command{
+	Use:   "node-delegations <address> <height>",
+	Short: "Gets the delegations to a node",
+	Long:  `Retrieves the delegations to the node at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		res, err := app.QueryNodeDelegations(args[0], int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res.String())
+	},
+}
+
+var queryDelegations = &cobra.Command{
+	Use:   "delegations <address> <height>",
+	Short: "Gets the delegations and unbonding delegations of an account",
+	Long:  `Retrieves the delegations and unbonding delegations of the account at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		delegations, err := app.QueryDelegations(args[0], int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		unbonding, err := app.QueryUnbondingDelegations(args[0], int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Delegations:\n%s\n\nUnbonding Delegations:\n%s\n", delegations.String(), unbonding.String())
+	},
+}
+
 var queryNodeParams = &cobra.Command{
 	Use:   "node-params <height>",
 	Short: "Gets node parameters",
@@ -461,6 +526,104 @@ var queryAppParams = &cobra.Command{
 	},
 }
 
+var queryAppRelays = &cobra.Command{
+	Use:   "app-relays <stake> <height>",
+	Short: "Previews the relays of an app stake",
+	Long:  `Retrieves the max relays per session an application staking <stake> would be allotted at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		stake, ok := types.NewIntFromString(args[0])
+		if !ok {
+			fmt.Println("invalid stake amount: " + args[0])
+			return
+		}
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		res, err := app.QueryAppRelays(stake, int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("Max Relays: %s\n", res.String())
+	},
+}
+
+var queryAppEscrow = &cobra.Command{
+	Use:   "app-escrow <appAddr> <height>",
+	Short: "Gets the escrow of a prepaid app",
+	Long:  `Retrieves the escrow balance of the prepaid app <appAddr> at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		res, err := app.QueryAppEscrow(args[0], int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res.String())
+	},
+}
+
+var queryAppUsage = &cobra.Command{
+	Use:   "app-usage <appAddr> <sessionHeight> <height>",
+	Short: "Gets the relays consumed by an app",
+	Long:  `Retrieves the relays consumed by <appAddr> per session, aggregated from the verified receipts at <height>. A <sessionHeight> of 0 retrieves all sessions.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		var sessionHeight, height int
+		var err error
+		if len(args) > 1 {
+			sessionHeight, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		if len(args) > 2 {
+			height, err = strconv.Atoi(args[2])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		res, err := app.QueryAppUsage(args[0], int64(sessionHeight), int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("App Usage:")
+		for _, u := range res {
+			fmt.Printf("Session Height: %d, Total Relays: %d, Receipts: %d\n", u.SessionBlockHeight, u.TotalRelays, u.Receipts)
+		}
+		// the current allowance of the app, if still staked, to reconcile the usage against
+		if application, err := app.QueryApp(args[0], int64(height)); err == nil {
+			fmt.Printf("Max Relays Per Session: %s\n", application.MaxRelays.String())
+		}
+	},
+}
+
 var queryNodeReceipts = &cobra.Command{
 	Use:   "node-receipts <nodeAddr> <height>",
 	Short: "Gets node receipts for work completed",
@@ -577,6 +740,34 @@ var queryPocketSupportedChains = &cobra.Command{
 	},
 }
 
+var queryChainRegistry = &cobra.Command{
+	Use:   "chain-registry <height>",
+	Short: "Gets the pocket chain registry",
+	Long:  `Retrieves the metadata (name, type, health check, deprecation) of the networks supported by the network at the specified <height>`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		var height int
+		if len(args) == 0 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[0])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		res, err := app.QueryChainRegistry(int64(height))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		for i, chain := range res {
+			fmt.Printf("(%d)\t%s\n", i, chain.String())
+		}
+	},
+}
+
 var querySupply = &cobra.Command{
 	Use:   "supply <height>",
 	Short: "Gets the supply at <height>",
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/pokt-network/pocket-core/app"
 	"github.com/pokt-network/pocket-core/app/cmd/rpc"
@@ -70,27 +71,25 @@ var startCmd = &cobra.Command{
 	Long:  `Starts the Pocket node, picks up the config from the assigned <datadir>`,
 	Run: func(cmd *cobra.Command, args []string) {
 		tmNode := app.InitApp(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
-		go rpc.StartRPC(app.GlobalConfig.PocketConfig.RPCPort, simulateRelay)
-		// trap kill signals (2,3,15,9)
+		server, err := rpc.NewServer(app.GlobalConfig.PocketConfig.RPCPort, simulateRelay)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		// the rpc is started last and stopped first, draining the relays in flight
+		lifecycle := app.NewLifecycle(time.Duration(app.GlobalConfig.PocketConfig.ShutdownTimeout)*time.Millisecond,
+			append(app.NodeComponents(tmNode), app.Component{Name: "rpc", Start: server.Start, Stop: server.Stop})...)
+		// trap the exit signals (2,3,15)
 		signalChannel := make(chan os.Signal, 1)
 		signal.Notify(signalChannel,
 			syscall.SIGTERM,
 			syscall.SIGINT,
-			syscall.SIGQUIT,
-			os.Kill,
-			os.Interrupt)
-
-		defer func() {
-			sig := <-signalChannel
-			err := tmNode.Stop()
-			if err != nil {
-				fmt.Println(err)
-				return
-			}
-			message := fmt.Sprintf("Exit signal %s received\n", sig)
-			fmt.Println(message)
-			os.Exit(3)
-		}()
+			syscall.SIGQUIT)
+		if err := lifecycle.Run(signalChannel, server.Errors()); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Println("Pocket core stopped")
 	},
 }
 
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/spf13/cobra"
+)
+
+var (
+	txOffline bool
+	txChainID string
+	txEntropy int64
+	txFee     int64
+	txMemo    string
+	txOutput  string
+)
+
+func init() {
+	rootCmd.AddCommand(txCmd)
+	txCmd.AddCommand(txBuildCmd)
+	txCmd.AddCommand(txSignCmd)
+	txBuildCmd.Flags().BoolVar(&txOffline, "offline", false, "build the transaction without a connection to a node, the chain id is required")
+	txBuildCmd.Flags().StringVar(&txChainID, "chain-id", "", "the chain id of the network, fetched from the node when not offline and empty")
+	txBuildCmd.Flags().Int64Var(&txEntropy, "entropy", 0, "the entropy of the transaction preventing its replay, random when 0")
+	txBuildCmd.Flags().Int64Var(&txFee, "fee", 0, "the fee of the transaction, the fee of the message in state (or its default fee offline) when 0")
+	txBuildCmd.Flags().StringVar(&txMemo, "memo", "", "the memo of the transaction")
+	txBuildCmd.Flags().StringVar(&txOutput, "output", "", "the file to write the unsigned transaction to, stdout when empty")
+	txSignCmd.Flags().StringVar(&txOutput, "output", "", "the file to write the signed transaction to, stdout when empty")
+}
+
+// txCmd represents the tx namespace command
+var txCmd = &cobra.Command{
+	Use:   "tx",
+	Short: "offline transaction building and signing",
+	Long: `The tx namespace handles building transactions and signing them from the keybase, without a connection to a node.
+Signed transactions are broadcast with the send-raw-tx command of the accounts namespace.`,
+}
+
+var txBuildCmd = &cobra.Command{
+	Use:   "build <json-message> --offline --chain-id <chainID> --entropy <entropy> --fee <fee> --memo <memo> --output <path>",
+	Short: "Build an unsigned transaction",
+	Long: `Builds the unsigned transaction of the amino json <json-message>, like {"type":"pos/Send","value":{...}}.
+With --offline nothing is fetched from a node, so the --chain-id is required.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		res, err := app.BuildUnsignedTx(args[0], txChainID, txEntropy, txFee, txMemo, txOffline)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		writeTxOutput(res)
+	},
+}
+
+var txSignCmd = &cobra.Command{
+	Use:   "sign <address> <path/to/tx.json> --output <path>",
+	Short: "Sign a transaction",
+	Long: `Signs the transaction built with the build command with the key of <address> from the keybase, without a connection to a node.
+Transactions with several signers are passed from signer to signer, once every signer signed the hex encoded transaction
+is printed to broadcast with: pocket accounts send-raw-tx <fromAddr> <txBytes>.
+Will prompt the user for the account passphrase.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
+		bz, err := ioutil.ReadFile(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Passphrase: ")
+		res, txHex, err := app.SignOfflineTx(args[0], app.Credentials(), bz)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		writeTxOutput(res)
+		if txHex == "" {
+			fmt.Println("The transaction is waiting on the signatures of the other signers")
+			return
+		}
+		fmt.Printf("Transaction bytes:\n%s\n", txHex)
+	},
+}
+
+// write the transaction to the --output file or to stdout
+func writeTxOutput(tx []byte) {
+	if txOutput == "" {
+		fmt.Println(string(tx))
+		return
+	}
+	if err := ioutil.WriteFile(txOutput, tx, 0644); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Transaction written to %s\n", txOutput)
+}
@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/pokt-network/pocket-core/app"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/spf13/cobra"
 )
 
@@ -10,6 +12,7 @@ func init() {
 	rootCmd.AddCommand(utilCmd)
 	utilCmd.AddCommand(chainsGenCmd)
 	utilCmd.AddCommand(chainsDelCmd)
+	utilCmd.AddCommand(verifyRelayResponseCmd)
 }
 
 var utilCmd = &cobra.Command{
@@ -24,9 +27,18 @@ var chainsGenCmd = &cobra.Command{
 	Long:  `Generate the chains file for network identifiers`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, tmRPCPort, tmPeersPort)
-		c := app.NewHostedChains()
+		// attempt to retrieve the chain registry from a running node
+		registry, err := app.QueryChainRegistry(0)
+		if err != nil {
+			fmt.Println("unable to retrieve the chain registry, network identifiers will not be checked against it")
+		}
+		c := app.NewHostedChains(registry)
 		fmt.Println(app.GlobalConfig.PocketConfig.ChainsName + " contains: \n")
 		for _, chain := range c.M {
+			if blockchain, found := registry.Get(chain.ID); found {
+				fmt.Println(chain.ID + " (" + blockchain.Name + ") @ " + chain.URL)
+				continue
+			}
 			fmt.Println(chain.ID + " @ " + chain.URL)
 		}
 		fmt.Println("If incorrect: please remove the chains.json with the " + chainsDelCmd.NameAndAliases() + " command")
@@ -43,3 +55,40 @@ var chainsDelCmd = &cobra.Command{
 		fmt.Println("successfully deleted " + app.GlobalConfig.PocketConfig.ChainsName)
 	},
 }
+
+var verifyRelayResponseCmd = &cobra.Command{
+	Use:   "verify-relay-response <relayResponseJSON> [<relayJSON>]",
+	Short: "Verifies a relay response",
+	Long: `Verifies the servicer signature of the <relayResponseJSON> against the servicer public key of its proof.
+If the <relayJSON> sent by the client is provided, also verifies the request hash of the relay and that the response is bound to the proof of the relay.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		var resp types.RelayResponse
+		if err := json.Unmarshal([]byte(args[0]), &resp); err != nil {
+			fmt.Println(err)
+			return
+		}
+		if len(args) == 1 {
+			if err := resp.Validate(); err != nil {
+				fmt.Println(err)
+				return
+			}
+			if err := resp.VerifySignature(); err != nil {
+				fmt.Println(err)
+				return
+			}
+			fmt.Println("the relay response is signed by the servicer " + resp.Proof.ServicerPubKey)
+			return
+		}
+		var relay types.Relay
+		if err := json.Unmarshal([]byte(args[1]), &relay); err != nil {
+			fmt.Println(err)
+			return
+		}
+		if err := resp.Verify(relay); err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("the relay response is signed by the servicer " + resp.Proof.ServicerPubKey + " for the relay")
+	},
+}
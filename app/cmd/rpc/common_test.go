@@ -91,6 +91,7 @@ var (
 		auth.FeeCollectorName:     nil,
 		nodesTypes.StakedPoolName: {auth.Burner, auth.Staking, auth.Minter},
 		appsTypes.StakedPoolName:  {auth.Burner, auth.Staking, auth.Minter},
+		appsTypes.EscrowPoolName:  nil,
 		nodesTypes.ModuleName:     {auth.Burner, auth.Staking, auth.Minter},
 		govTypes.DAOAccountName:   {auth.Burner, auth.Staking, auth.Minter},
 		appsTypes.ModuleName:      nil,
@@ -121,6 +122,11 @@ type memoryPCApp struct {
 	mm            *module.Manager
 }
 
+// the ante handler of the in memory app, checking the fees against the message fees in state
+func newMemAnteHandler(a *memoryPCApp) sdk.AnteHandler {
+	return app.NewAnteHandler(a.accountKeeper, app.NewMessageFeeKeepers(a.nodesKeeper, a.appsKeeper, a.pocketKeeper))
+}
+
 // NewPocketCoreApp is a constructor function for pocketCoreApp
 func newMemPCApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseApp)) *memoryPCApp {
 	app := newMemoryPCBaseApp(logger, db, baseAppOptions...)
@@ -136,11 +142,14 @@ func newMemPCApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseA
 		authSubspace,
 		memoryModAccPerms,
 	)
+	// The chain validator validates the chains of the stakes against the pocket core chain registry
+	chainValidator := pocketKeeper.NewChainValidator(pocketSubspace)
 	// The nodesKeeper keeper handles pocket core nodes
 	app.nodesKeeper = nodesKeeper.NewKeeper(
 		app.cdc,
 		app.keys[nodesTypes.StoreKey],
 		app.accountKeeper,
+		chainValidator,
 		nodesSubspace,
 		nodesTypes.DefaultCodespace,
 	)
@@ -150,6 +159,7 @@ func newMemPCApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseA
 		app.keys[appsTypes.StoreKey],
 		app.nodesKeeper,
 		app.accountKeeper,
+		chainValidator,
 		appsSubspace,
 		appsTypes.DefaultCodespace,
 	)
@@ -191,7 +201,7 @@ func newMemPCApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseA
 	)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 	app.SetInitChainer(app.InitChainer)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper))
+	app.SetAnteHandler(newMemAnteHandler(app))
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	app.MountKVStores(app.keys)
@@ -482,7 +492,7 @@ func oneValTwoNodeGenesisState() []byte {
 	rawPocket := defaultGenesis[pocketTypes.ModuleName]
 	var pocketGenesisState pocketTypes.GenesisState
 	memCodec().MustUnmarshalJSON(rawPocket, &pocketGenesisState)
-	pocketGenesisState.Params.SupportedBlockchains = []string{dummyChainsHash}
+	pocketGenesisState.Params.ChainRegistry = pocketTypes.NewLegacyChainRegistry([]string{dummyChainsHash})
 	res3 := memCodec().MustMarshalJSON(pocketGenesisState)
 	defaultGenesis[pocketTypes.ModuleName] = res3
 	// set default governance in genesis
@@ -495,7 +505,7 @@ func oneValTwoNodeGenesisState() []byte {
 	govGenesisState.Params.Upgrade = govTypes.NewUpgrade(10000, "2.0.0")
 	res4 := memCodec().MustMarshalJSON(govGenesisState)
 	defaultGenesis[govTypes.ModuleName] = res4
-	pocketGenesisState.Params.SupportedBlockchains = []string{dummyChainsHash}
+	pocketGenesisState.Params.ChainRegistry = pocketTypes.NewLegacyChainRegistry([]string{dummyChainsHash})
 	// end genesis setup
 	genState = defaultGenesis
 	j, _ := memCodec().MarshalJSONIndent(defaultGenesis, "", "    ")
@@ -513,18 +523,22 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("gov/daoOwner", kp.GetAddress())
 		acl.SetOwner("gov/acl", kp.GetAddress())
 		acl.SetOwner("pos/StakeDenom", kp.GetAddress())
-		acl.SetOwner("pocketcore/SupportedBlockchains", kp.GetAddress())
+		acl.SetOwner("pocketcore/ChainRegistry", kp.GetAddress())
 		acl.SetOwner("pos/DowntimeJailDuration", kp.GetAddress())
 		acl.SetOwner("pos/SlashFractionDoubleSign", kp.GetAddress())
 		acl.SetOwner("pos/SlashFractionDowntime", kp.GetAddress())
 		acl.SetOwner("application/ApplicationStakeMinimum", kp.GetAddress())
 		acl.SetOwner("pocketcore/ClaimExpiration", kp.GetAddress())
+		acl.SetOwner("pocketcore/AppUsageRetention", kp.GetAddress())
 		acl.SetOwner("pocketcore/SessionNodeCount", kp.GetAddress())
 		acl.SetOwner("pocketcore/ReplayAttackBurnMultiplier", kp.GetAddress())
 		acl.SetOwner("pos/MaxValidators", kp.GetAddress())
 		acl.SetOwner("pos/ProposerPercentage", kp.GetAddress())
 		acl.SetOwner("application/StabilityAdjustment", kp.GetAddress())
 		acl.SetOwner("application/AppUnstakingTime", kp.GetAddress())
+		acl.SetOwner("application/AppMinimumJailDuration", kp.GetAddress())
+		acl.SetOwner("application/AppMisbehaviorReportThreshold", kp.GetAddress())
+		acl.SetOwner("application/PrepaidRelayPrice", kp.GetAddress())
 		acl.SetOwner("application/ParticipationRateOn", kp.GetAddress())
 		acl.SetOwner("pos/MaxEvidenceAge", kp.GetAddress())
 		acl.SetOwner("pos/MinSignedPerWindow", kp.GetAddress())
@@ -533,9 +547,14 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("application/BaseRelaysPerPOKT", kp.GetAddress())
 		acl.SetOwner("pocketcore/ClaimSubmissionWindow", kp.GetAddress())
 		acl.SetOwner("pos/DAOAllocation", kp.GetAddress())
+		acl.SetOwner("pos/ServicerLivenessJailThreshold", kp.GetAddress())
+		acl.SetOwner("pos/ServicerLivenessJailDuration", kp.GetAddress())
 		acl.SetOwner("pos/SignedBlocksWindow", kp.GetAddress())
 		acl.SetOwner("pos/BlocksPerSession", kp.GetAddress())
 		acl.SetOwner("application/MaxApplications", kp.GetAddress())
+		acl.SetOwner("pos/MessageFees", kp.GetAddress())
+		acl.SetOwner("application/MessageFees", kp.GetAddress())
+		acl.SetOwner("pocketcore/MessageFees", kp.GetAddress())
 		acl.SetOwner("gov/daoOwner", kp.GetAddress())
 		acl.SetOwner("gov/upgrade", kp.GetAddress())
 		testACL = acl
@@ -646,7 +665,7 @@ func fiveValidatorsOneAppGenesis() (genBz []byte, validators nodesTypes.Validato
 	rawPocket := defaultGenesis[pocketTypes.ModuleName]
 	var pocketGenesisState pocketTypes.GenesisState
 	memCodec().MustUnmarshalJSON(rawPocket, &pocketGenesisState)
-	pocketGenesisState.Params.SupportedBlockchains = []string{dummyChainsHash}
+	pocketGenesisState.Params.ChainRegistry = pocketTypes.NewLegacyChainRegistry([]string{dummyChainsHash})
 	res3 := memCodec().MustMarshalJSON(pocketGenesisState)
 	defaultGenesis[pocketTypes.ModuleName] = res3
 	// set default governance in genesis
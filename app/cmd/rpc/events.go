@@ -0,0 +1,584 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	EventsPath = "/v2/events"
+	// the notifications of the rpc, besides the events of the modules
+	EventTypeNewBlock              = "new_block"
+	EventTypeSessionChange         = "session_change"
+	AttributeKeyBlockHash          = "hash"
+	AttributeKeySessionBlockHeight = "session_block_height"
+	// the websocket methods of the subscriptions, the v2 methods are served on the websocket as well
+	EventsMethodSubscribe      = "subscribe"
+	EventsMethodUnsubscribe    = "unsubscribe"
+	EventsMethodUnsubscribeAll = "unsubscribe_all"
+	EventsMethodEvent          = "event"
+	// the max number of subscriptions of a websocket
+	MaxEventSubscriptions = 10
+	eventsSubscriber      = "pocket-rpc-events"
+	eventsBufferSize      = 100
+	eventsWriteWait       = 10 * time.Second
+	eventsPongWait        = 60 * time.Second
+	eventsPingPeriod      = eventsPongWait * 9 / 10
+	eventsResubscribeWait = time.Second
+)
+
+// the event types a websocket can subscribe to
+var SubscribableEventTypes = []string{
+	EventTypeNewBlock,
+	EventTypeSessionChange,
+	nodeTypes.EventTypeCompleteUnstaking,
+	nodeTypes.EventTypeCreateValidator,
+	nodeTypes.EventTypeStake,
+	nodeTypes.EventTypeEditStake,
+	nodeTypes.EventTypeWaitingToEditStake,
+	nodeTypes.EventTypeBeginUnstake,
+	nodeTypes.EventTypeWaitingToBeginUnstaking,
+	nodeTypes.EventTypeUnstake,
+	nodeTypes.EventTypePartialUnstake,
+	nodeTypes.EventTypeProposerReward,
+	nodeTypes.EventTypeDAOAllocation,
+	nodeTypes.EventTypeSlash,
+	nodeTypes.EventTypeLiveness,
+	nodeTypes.EventTypeServicerLiveness,
+	nodeTypes.EventTypeDelegate,
+	nodeTypes.EventTypeUndelegate,
+	nodeTypes.EventTypeCompleteUndelegation,
+	nodeTypes.EventTypeDelegatorReward,
+	nodeTypes.EventTypeRotateServicerKey,
+	pocketTypes.EventTypeClaim,
+	pocketTypes.EventTypeProof,
+}
+
+// the event hub of the rpc server
+var eventHub = NewEventHub(app.NewTMEventClient)
+
+// the event of a notification, the txhash is set for the events of a transaction
+type Event struct {
+	Type       string          `json:"type"`
+	Height     int64           `json:"height"`
+	TxHash     string          `json:"txhash,omitempty"`
+	Attributes []sdk.Attribute `json:"attributes,omitempty"`
+}
+
+// the params of a subscription, the event types (every type if empty), an address and a chain:
+// the address and chain filters don't apply to the new block and session change notifications
+type EventFilter struct {
+	Events  []string `json:"events"`
+	Address string   `json:"address"`
+	Chain   string   `json:"chain"`
+}
+
+func (f EventFilter) Validate() error {
+	for _, t := range f.Events {
+		if !isSubscribable(t) {
+			return fmt.Errorf("the event type %s is not one of: %s", t, strings.Join(SubscribableEventTypes, ", "))
+		}
+	}
+	if f.Address != "" {
+		if _, err := sdk.AddressFromHex(f.Address); err != nil {
+			return fmt.Errorf("invalid address %s: %s", f.Address, err.Error())
+		}
+	}
+	return nil
+}
+
+// returns true if the event passes the filter
+func (f EventFilter) Matches(e Event) bool {
+	if len(f.Events) != 0 {
+		var found bool
+		for _, t := range f.Events {
+			if t == e.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if e.Type == EventTypeNewBlock || e.Type == EventTypeSessionChange {
+		return true
+	}
+	if f.Address != "" && !hasAttributeValue(e, "", f.Address) {
+		return false
+	}
+	if f.Chain != "" && !hasAttributeValue(e, pocketTypes.AttributeKeyChain, f.Chain) {
+		return false
+	}
+	return true
+}
+
+// returns true if an attribute of the event (of any key if empty) has the value, case insensitive
+func hasAttributeValue(e Event, key, value string) bool {
+	for _, attr := range e.Attributes {
+		if (key == "" || attr.Key == key) && strings.EqualFold(attr.Value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSubscribable(eventType string) bool {
+	for _, t := range SubscribableEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// the hub of the event subscriptions, the websockets share one tendermint subscription to the new blocks and txs
+type EventHub struct {
+	mu            sync.Mutex
+	newClient     func() client.Client
+	client        client.Client
+	cancel        context.CancelFunc
+	subscriptions map[string]*eventSubscription
+	conns         map[*eventConn]struct{}
+	lastID        uint64
+}
+
+type eventSubscription struct {
+	id     string
+	filter EventFilter
+	conn   *eventConn
+}
+
+func NewEventHub(newClient func() client.Client) *EventHub {
+	return &EventHub{
+		newClient:     newClient,
+		subscriptions: make(map[string]*eventSubscription),
+		conns:         make(map[*eventConn]struct{}),
+	}
+}
+
+// subscribes the websocket to the events of the filter, the tendermint subscription starts with the first one
+func (h *EventHub) Subscribe(conn *eventConn, filter EventFilter) (id string, err error) {
+	if err := filter.Validate(); err != nil {
+		return "", NewInvalidParamsError(err)
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if conn.subscriptions >= MaxEventSubscriptions {
+		return "", NewInvalidParamsError(fmt.Errorf("a websocket can't have more than %d subscriptions", MaxEventSubscriptions))
+	}
+	if h.client == nil {
+		if err := h.start(); err != nil {
+			return "", err
+		}
+	}
+	h.lastID++
+	id = strconv.FormatUint(h.lastID, 10)
+	h.subscriptions[id] = &eventSubscription{id: id, filter: filter, conn: conn}
+	conn.subscriptions++
+	return id, nil
+}
+
+// unsubscribes the websocket, the subscriptions of other websockets aren't found
+func (h *EventHub) Unsubscribe(conn *eventConn, id string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	sub, ok := h.subscriptions[id]
+	if !ok || sub.conn != conn {
+		return NewInvalidParamsError(fmt.Errorf("the subscription %s was not found", id))
+	}
+	delete(h.subscriptions, id)
+	conn.subscriptions--
+	return nil
+}
+
+func (h *EventHub) UnsubscribeAll(conn *eventConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.unsubscribeAll(conn)
+}
+
+func (h *EventHub) unsubscribeAll(conn *eventConn) {
+	for id, sub := range h.subscriptions {
+		if sub.conn == conn {
+			delete(h.subscriptions, id)
+		}
+	}
+	conn.subscriptions = 0
+}
+
+// adds the websocket to the hub, up to the max event connections of the config
+func (h *EventHub) addConn(conn *eventConn) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	maxConns := app.GlobalConfig.PocketConfig.MaxEventConnections
+	if maxConns <= 0 {
+		maxConns = app.DefaultMaxEventConnections
+	}
+	if len(h.conns) >= maxConns {
+		return false
+	}
+	h.conns[conn] = struct{}{}
+	return true
+}
+
+func (h *EventHub) removeConn(conn *eventConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.unsubscribeAll(conn)
+	delete(h.conns, conn)
+}
+
+// closes the websockets and the tendermint subscription of the hub
+func (h *EventHub) Stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for conn := range h.conns {
+		h.unsubscribeAll(conn)
+		conn.close()
+	}
+	h.stop()
+}
+
+func (h *EventHub) stop() {
+	if h.client == nil {
+		return
+	}
+	h.cancel()
+	_ = h.client.UnsubscribeAll(context.Background(), eventsSubscriber)
+	_ = h.client.Stop()
+	h.client = nil
+}
+
+func (h *EventHub) start() error {
+	cli := h.newClient()
+	if !cli.IsRunning() {
+		if err := cli.Start(); err != nil {
+			return err
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	blocks, err := cli.Subscribe(ctx, eventsSubscriber, tmTypes.EventQueryNewBlock.String(), eventsBufferSize)
+	if err != nil {
+		cancel()
+		_ = cli.Stop()
+		return err
+	}
+	txs, err := cli.Subscribe(ctx, eventsSubscriber, tmTypes.EventQueryTx.String(), eventsBufferSize)
+	if err != nil {
+		cancel()
+		_ = cli.UnsubscribeAll(context.Background(), eventsSubscriber)
+		_ = cli.Stop()
+		return err
+	}
+	h.client, h.cancel = cli, cancel
+	go h.listen(ctx, blocks, txs)
+	return nil
+}
+
+// publishes the events of the tendermint subscription until it's cancelled, or resubscribes if tendermint closes it
+func (h *EventHub) listen(ctx context.Context, blocks, txs <-chan ctypes.ResultEvent) {
+	sessions := &sessionBlocks{}
+	for {
+		select {
+		case e, ok := <-blocks:
+			if !ok {
+				h.resubscribe(ctx)
+				return
+			}
+			h.publish(toEvents(e, sessions))
+		case e, ok := <-txs:
+			if !ok {
+				h.resubscribe(ctx)
+				return
+			}
+			h.publish(toEvents(e, sessions))
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// tears down the closed tendermint subscription and subscribes again while the hub has subscriptions,
+// unless the hub was stopped or restarted meanwhile
+func (h *EventHub) resubscribe(ctx context.Context) {
+	h.mu.Lock()
+	if ctx.Err() == nil {
+		h.stop()
+	}
+	h.mu.Unlock()
+	for {
+		h.mu.Lock()
+		if h.client != nil || len(h.subscriptions) == 0 {
+			h.mu.Unlock()
+			return
+		}
+		err := h.start()
+		h.mu.Unlock()
+		if err == nil {
+			return
+		}
+		time.Sleep(eventsResubscribeWait)
+	}
+}
+
+// sends the events to the subscriptions they match, a websocket that can't keep up is closed
+func (h *EventHub) publish(events []Event) {
+	if len(events) == 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, e := range events {
+		for _, sub := range h.subscriptions {
+			if !sub.filter.Matches(e) {
+				continue
+			}
+			if !sub.conn.trySend(newEventNotification(sub.id, e)) {
+				sub.conn.close()
+			}
+		}
+	}
+}
+
+// converts the tendermint event to the events of the subscriptions
+func toEvents(e ctypes.ResultEvent, sessions *sessionBlocks) (events []Event) {
+	switch data := e.Data.(type) {
+	case tmTypes.EventDataNewBlock:
+		height := data.Block.Height
+		events = append(events, Event{Type: EventTypeNewBlock, Height: height,
+			Attributes: []sdk.Attribute{{Key: AttributeKeyBlockHash, Value: data.Block.Hash().String()}}})
+		if sessions.isSessionBlock(height) {
+			events = append(events, Event{Type: EventTypeSessionChange, Height: height,
+				Attributes: []sdk.Attribute{{Key: AttributeKeySessionBlockHeight, Value: strconv.FormatInt(height, 10)}}})
+		}
+		events = append(events, toModuleEvents(height, "", data.ResultBeginBlock.Events)...)
+		events = append(events, toModuleEvents(height, "", data.ResultEndBlock.Events)...)
+	case tmTypes.EventDataTx:
+		hash := fmt.Sprintf("%X", data.Tx.Hash())
+		events = toModuleEvents(data.Height, hash, data.Result.Events)
+		sessions.update(data.Result.Events)
+	}
+	return
+}
+
+// the session block frequency of the node params, queried on the first block and again after a param change,
+// it's only used by the goroutine of the tendermint subscription
+type sessionBlocks struct {
+	frequency int64 // zero until queried
+}
+
+// returns true if the height is the first block of a session, as the session block height of pocketcore
+func (s *sessionBlocks) isSessionBlock(height int64) bool {
+	if s.frequency <= 0 {
+		params, err := app.QueryNodeParams(height)
+		if err != nil {
+			return false
+		}
+		s.frequency = params.SessionBlockFrequency
+	}
+	if s.frequency <= 0 {
+		return false
+	}
+	return s.frequency == 1 || height%s.frequency == 1
+}
+
+// drops the frequency if the events of the tx changed a param, so the next block queries it
+func (s *sessionBlocks) update(abciEvents []abci.Event) {
+	for _, e := range abciEvents {
+		if e.Type == govTypes.EventParamChange {
+			s.frequency = 0
+			return
+		}
+	}
+}
+
+func toModuleEvents(height int64, txHash string, abciEvents []abci.Event) (events []Event) {
+	for _, e := range abciEvents {
+		if e.Type == EventTypeNewBlock || e.Type == EventTypeSessionChange || !isSubscribable(e.Type) {
+			continue
+		}
+		events = append(events, Event{Type: e.Type, Height: height, TxHash: txHash, Attributes: sdk.StringifyEvent(e).Attributes})
+	}
+	return
+}
+
+func newEventNotification(id string, e Event) JSONRPCNotification {
+	params, _ := json.Marshal(struct {
+		Subscription string `json:"subscription"`
+		Event        Event  `json:"event"`
+	}{id, e})
+	return JSONRPCNotification{JSONRPC: JSONRPCVersion, Method: EventsMethodEvent, Params: params}
+}
+
+// the notification of an event of a subscription
+type JSONRPCNotification struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+var eventsUpgrader = websocket.Upgrader{
+	// the same origins as the cors of the rpc, the clients outside of a browser don't send one
+	CheckOrigin: func(r *http.Request) bool {
+		return r.Header.Get("Origin") == "" || allowedOrigin(r) != ""
+	},
+}
+
+// the websocket of the event subscriptions, the JSON-RPC 2.0 methods subscribe, unsubscribe and unsubscribe_all
+// and the v2 methods are called on it and the events are sent as notifications
+func Events(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	ws, err := eventsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	conn := &eventConn{ws: ws, send: make(chan interface{}, eventsBufferSize), done: make(chan struct{})}
+	if !eventHub.addConn(conn) {
+		_ = ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too many event connections"), time.Now().Add(eventsWriteWait))
+		_ = ws.Close()
+		return
+	}
+	go conn.writeLoop()
+	conn.readLoop(eventHub)
+	eventHub.removeConn(conn)
+	conn.close()
+}
+
+type eventConn struct {
+	ws            *websocket.Conn
+	send          chan interface{}
+	done          chan struct{}
+	closeOnce     sync.Once
+	subscriptions int // guarded by the mutex of the hub
+}
+
+func (c *eventConn) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		_ = c.ws.Close()
+	})
+}
+
+// queues the message without blocking, false if the queue is full
+func (c *eventConn) trySend(msg interface{}) bool {
+	select {
+	case <-c.done:
+		return true
+	case c.send <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *eventConn) reply(msg interface{}) {
+	select {
+	case <-c.done:
+	case c.send <- msg:
+	}
+}
+
+func (c *eventConn) readLoop(hub *EventHub) {
+	c.ws.SetReadLimit(maxRPCBodySize())
+	_ = c.ws.SetReadDeadline(time.Now().Add(eventsPongWait))
+	c.ws.SetPongHandler(func(string) error {
+		return c.ws.SetReadDeadline(time.Now().Add(eventsPongWait))
+	})
+	for {
+		_, msg, err := c.ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		if res, ok := c.call(hub, msg); ok {
+			c.reply(res)
+		}
+	}
+}
+
+func (c *eventConn) writeLoop() {
+	ticker := time.NewTicker(eventsPingPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case msg := <-c.send:
+			_ = c.ws.SetWriteDeadline(time.Now().Add(eventsWriteWait))
+			if err := c.ws.WriteJSON(msg); err != nil {
+				c.close()
+				return
+			}
+		case <-ticker.C:
+			if err := c.ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(eventsWriteWait)); err != nil {
+				c.close()
+				return
+			}
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// calls the subscription method of the request, or the v2 method
+func (c *eventConn) call(hub *EventHub, msg []byte) (JSONRPCResponse, bool) {
+	var req JSONRPCRequest
+	if err := json.Unmarshal(msg, &req); err != nil || req.JSONRPC != JSONRPCVersion {
+		return callJSONRPC(msg)
+	}
+	res := JSONRPCResponse{JSONRPC: JSONRPCVersion, ID: req.ID}
+	var result interface{}
+	switch req.Method {
+	case EventsMethodSubscribe:
+		var filter EventFilter
+		if len(req.Params) != 0 {
+			if err := json.Unmarshal(req.Params, &filter); err != nil {
+				res.Error = &V2Error{Code: CodeInvalidParams, Message: err.Error()}
+				break
+			}
+		}
+		id, err := hub.Subscribe(c, filter)
+		if err != nil {
+			res.Error = ToV2Error(err)
+			break
+		}
+		result = id
+	case EventsMethodUnsubscribe:
+		var params struct {
+			Subscription string `json:"subscription"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			res.Error = &V2Error{Code: CodeInvalidParams, Message: "the params must be an object with the subscription"}
+			break
+		}
+		if err := hub.Unsubscribe(c, params.Subscription); err != nil {
+			res.Error = ToV2Error(err)
+			break
+		}
+		result = true
+	case EventsMethodUnsubscribeAll:
+		hub.UnsubscribeAll(c)
+		result = true
+	default:
+		return callJSONRPC(msg)
+	}
+	if res.Error == nil {
+		res.Result, _ = json.Marshal(result)
+	}
+	return res, req.ID != nil
+}
@@ -0,0 +1,200 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestEventFilter(t *testing.T) {
+	addr := "3961ea836cd41f24f044bd754c47e9f228b86c07"
+	claim := Event{Type: pocketTypes.EventTypeClaim, Height: 5, Attributes: []sdk.Attribute{
+		{Key: pocketTypes.AttributeKeyValidator, Value: strings.ToUpper(addr)}, {Key: pocketTypes.AttributeKeyChain, Value: "0001"}}}
+	newBlock := Event{Type: EventTypeNewBlock, Height: 5}
+	tests := []struct {
+		name    string
+		filter  EventFilter
+		valid   bool
+		matches []bool // claim, new block
+	}{
+		{"every event", EventFilter{}, true, []bool{true, true}},
+		{"event type", EventFilter{Events: []string{pocketTypes.EventTypeClaim}}, true, []bool{true, false}},
+		{"address", EventFilter{Address: addr}, true, []bool{true, true}},
+		{"other address", EventFilter{Address: "0000000000000000000000000000000000000000"}, true, []bool{false, true}},
+		{"chain", EventFilter{Events: []string{pocketTypes.EventTypeClaim, EventTypeNewBlock}, Chain: "0001"}, true, []bool{true, true}},
+		{"other chain", EventFilter{Chain: "0002"}, true, []bool{false, true}},
+		{"unknown event type", EventFilter{Events: []string{"transfer"}}, false, nil},
+		{"invalid address", EventFilter{Address: "zz"}, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			assert.Equal(t, tt.valid, err == nil)
+			if tt.valid {
+				assert.Equal(t, tt.matches[0], tt.filter.Matches(claim))
+				assert.Equal(t, tt.matches[1], tt.filter.Matches(newBlock))
+			}
+		})
+	}
+}
+
+func TestEvents(t *testing.T) {
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	<-evtChan
+	kb := getInMemoryKeybase()
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	server := httptest.NewServer(Router(V2Routes()))
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+EventsPath, nil)
+	assert.Nil(t, err)
+	call := func(req string) JSONRPCResponse {
+		assert.Nil(t, ws.WriteMessage(websocket.TextMessage, []byte(req)))
+		var res JSONRPCResponse
+		assert.Nil(t, ws.SetReadDeadline(time.Now().Add(10*time.Second)))
+		assert.Nil(t, ws.ReadJSON(&res))
+		return res
+	}
+	// an invalid subscription
+	res := call(`{"jsonrpc":"2.0","id":1,"method":"subscribe","params":{"events":["transfer"]}}`)
+	assert.NotNil(t, res.Error)
+	assert.Equal(t, CodeInvalidParams, res.Error.Code)
+	// a v2 method on the websocket
+	res = call(`{"jsonrpc":"2.0","id":2,"method":"version"}`)
+	assert.Nil(t, res.Error)
+	assert.Equal(t, `"`+APIVersion+`"`, string(res.Result))
+	// the proposer rewards of the validator
+	res = call(`{"jsonrpc":"2.0","id":3,"method":"subscribe","params":{"events":["proposer_reward"],"address":"` + cb.GetAddress().String() + `"}}`)
+	assert.Nil(t, res.Error)
+	var id string
+	assert.Nil(t, json.Unmarshal(res.Result, &id))
+	var notification JSONRPCNotification
+	assert.Nil(t, ws.SetReadDeadline(time.Now().Add(30*time.Second)))
+	assert.Nil(t, ws.ReadJSON(&notification))
+	assert.Equal(t, EventsMethodEvent, notification.Method)
+	var params struct {
+		Subscription string `json:"subscription"`
+		Event        Event  `json:"event"`
+	}
+	assert.Nil(t, json.Unmarshal(notification.Params, &params))
+	assert.Equal(t, id, params.Subscription)
+	assert.Equal(t, nodeTypes.EventTypeProposerReward, params.Event.Type)
+	assert.NotZero(t, params.Event.Height)
+	assert.True(t, hasAttributeValue(params.Event, nodeTypes.AttributeKeyValidator, cb.GetAddress().String()))
+	// unsubscribe, the notifications already queued are skipped
+	assert.Nil(t, ws.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":4,"method":"unsubscribe","params":{"subscription":"`+id+`"}}`)))
+	for {
+		assert.Nil(t, ws.SetReadDeadline(time.Now().Add(10*time.Second)))
+		var msg JSONRPCResponse
+		if !assert.Nil(t, ws.ReadJSON(&msg)) || string(msg.ID) == "4" {
+			assert.Nil(t, msg.Error)
+			assert.Equal(t, "true", string(msg.Result))
+			break
+		}
+	}
+	res = call(`{"jsonrpc":"2.0","id":5,"method":"unsubscribe","params":{"subscription":"` + id + `"}}`)
+	assert.NotNil(t, res.Error)
+	_ = ws.Close()
+	eventHub.Stop()
+	server.Close()
+	cleanup()
+	stopCli()
+}
+
+// a tendermint client whose subscription channels are closed by the test
+type fakeEventClient struct {
+	client.Client
+	running bool
+	blocks  chan ctypes.ResultEvent
+	txs     chan ctypes.ResultEvent
+	stopped chan struct{}
+}
+
+func newFakeEventClient() *fakeEventClient {
+	return &fakeEventClient{
+		blocks:  make(chan ctypes.ResultEvent, 1),
+		txs:     make(chan ctypes.ResultEvent, 1),
+		stopped: make(chan struct{}),
+	}
+}
+
+func (c *fakeEventClient) Start() error    { c.running = true; return nil }
+func (c *fakeEventClient) Stop() error     { close(c.stopped); return nil }
+func (c *fakeEventClient) IsRunning() bool { return c.running }
+
+func (c *fakeEventClient) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan ctypes.ResultEvent, error) {
+	if query == tmTypes.EventQueryNewBlock.String() {
+		return c.blocks, nil
+	}
+	return c.txs, nil
+}
+
+func (c *fakeEventClient) UnsubscribeAll(context.Context, string) error { return nil }
+
+func TestEventHub_Resubscribe(t *testing.T) {
+	clients := make(chan *fakeEventClient, 2)
+	hub := NewEventHub(func() client.Client {
+		c := newFakeEventClient()
+		clients <- c
+		return c
+	})
+	conn := &eventConn{send: make(chan interface{}, eventsBufferSize), done: make(chan struct{})}
+	_, err := hub.Subscribe(conn, EventFilter{Events: []string{pocketTypes.EventTypeClaim}})
+	assert.Nil(t, err)
+	claimTx := ctypes.ResultEvent{Data: tmTypes.EventDataTx{TxResult: tmTypes.TxResult{Height: 1, Tx: tmTypes.Tx("tx"),
+		Result: abci.ResponseDeliverTx{Events: []abci.Event{{Type: pocketTypes.EventTypeClaim}}}}}}
+	first := <-clients
+	first.txs <- claimTx
+	select {
+	case <-conn.send:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event was not published")
+	}
+	// tendermint closes the subscription, the hub tears down the client and subscribes with a new one
+	close(first.txs)
+	var second *fakeEventClient
+	select {
+	case second = <-clients:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the hub did not resubscribe")
+	}
+	select {
+	case <-first.stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the closed client was not stopped")
+	}
+	second.txs <- claimTx
+	select {
+	case <-conn.send:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event was not published after resubscribing")
+	}
+	hub.Stop()
+	<-second.stopped
+}
+
+func TestSessionBlocks(t *testing.T) {
+	sessions := &sessionBlocks{frequency: 4}
+	assert.True(t, sessions.isSessionBlock(5))
+	assert.False(t, sessions.isSessionBlock(6))
+	// a tx without a param change keeps the frequency
+	sessions.update([]abci.Event{{Type: pocketTypes.EventTypeClaim}})
+	assert.Equal(t, int64(4), sessions.frequency)
+	// a param change drops it, so the next block queries the params
+	sessions.update([]abci.Event{{Type: govTypes.EventParamChange}})
+	assert.Zero(t, sessions.frequency)
+}
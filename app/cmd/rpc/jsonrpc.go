@@ -0,0 +1,369 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+	appTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	authTypes "github.com/pokt-network/posmint/x/auth/types"
+	govTypes "github.com/pokt-network/posmint/x/gov/types"
+)
+
+const (
+	JSONRPCVersion = "2.0"
+	JSONRPCPath    = "/v2/jsonrpc"
+	// the max number of calls of a JSON-RPC batch
+	MaxJSONRPCBatchSize = 100
+)
+
+// the JSON-RPC 2.0 error codes, the codes of the errors of the v2 resources as well
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	// the errors of the node without a codespace
+	CodeServerError = -32000
+)
+
+// the codes of the sdk errors are the base code of their codespace plus their code, e.g. 5060 for code 60 of pocketcore
+var codespaceBaseCodes = map[sdk.CodespaceType]int{
+	sdk.CodespaceRoot:            1000,
+	authTypes.DefaultCodespace:   2000,
+	nodeTypes.DefaultCodespace:   3000,
+	appTypes.DefaultCodespace:    4000,
+	pocketTypes.ModuleName:       5000,
+	govTypes.ModuleName:          6000,
+	sdk.CodespaceType("unknown"): 9000,
+}
+
+// the sdk errors of the resources that aren't found
+var notFoundErrors = map[sdk.CodespaceType][]sdk.CodeType{
+	sdk.CodespaceRoot:      {sdk.CodeUnknownAddress},
+	pocketTypes.ModuleName: {pocketTypes.CodeAppNotFoundError, pocketTypes.CodeNodeNotFoundError, pocketTypes.CodeClaimNotFoundError},
+}
+
+// the error of the v2 api, the error object of the JSON-RPC responses and the "error" of the v2 resources
+type V2Error struct {
+	Code    int          `json:"code"`
+	Message string       `json:"message"`
+	Data    *V2ErrorData `json:"data,omitempty"`
+	status  int
+}
+
+// the sdk error the v2 error is mapped from
+type V2ErrorData struct {
+	Codespace string `json:"codespace"`
+	Code      uint32 `json:"code"`
+}
+
+func (e *V2Error) Error() string {
+	return e.Message
+}
+
+// returns the http status of the error for the v2 resources
+func (e *V2Error) HTTPStatus() int {
+	if e.status != 0 {
+		return e.status
+	}
+	switch e.Code {
+	case CodeMethodNotFound:
+		return http.StatusNotFound
+	case CodeInternalError:
+		return http.StatusInternalServerError
+	}
+	if e.Data == nil {
+		return http.StatusBadRequest
+	}
+	codespace, code := sdk.CodespaceType(e.Data.Codespace), sdk.CodeType(e.Data.Code)
+	for _, c := range notFoundErrors[codespace] {
+		if c == code {
+			return http.StatusNotFound
+		}
+	}
+	if codespace == sdk.CodespaceRoot {
+		switch code {
+		case sdk.CodeUnauthorized:
+			return http.StatusUnauthorized
+		case sdk.CodeInternal:
+			return http.StatusInternalServerError
+		}
+	}
+	return http.StatusBadRequest
+}
+
+func NewInvalidParamsError(err error) *V2Error {
+	return &V2Error{Code: CodeInvalidParams, Message: err.Error()}
+}
+
+// maps the error to a v2 error, the sdk errors (or their abci logs) are mapped from their codespace and code
+func ToV2Error(err error) *V2Error {
+	if e, ok := err.(*V2Error); ok {
+		return e
+	}
+	log := err.Error()
+	if sdkErr, ok := err.(sdk.Error); ok {
+		log = sdkErr.ABCILog()
+	}
+	var abciLog struct {
+		Codespace sdk.CodespaceType `json:"codespace"`
+		Code      sdk.CodeType      `json:"code"`
+		Message   string            `json:"message"`
+	}
+	if json.Unmarshal([]byte(log), &abciLog) != nil || abciLog.Codespace == "" {
+		return &V2Error{Code: CodeServerError, Message: err.Error()}
+	}
+	base, ok := codespaceBaseCodes[abciLog.Codespace]
+	if !ok {
+		base = codespaceBaseCodes["unknown"]
+	}
+	return &V2Error{
+		Code:    base + int(abciLog.Code),
+		Message: abciLog.Message,
+		Data:    &V2ErrorData{Codespace: string(abciLog.Codespace), Code: uint32(abciLog.Code)},
+	}
+}
+
+// returns the routes of the v2 api: the resources of the methods, the JSON-RPC endpoint and the events websocket
+func V2Routes() Routes {
+	var routes Routes
+	for _, m := range V2Methods() {
+		if m.Admin {
+			continue
+		}
+		routes = append(routes, Route{Name: "V2_" + m.Name, Method: m.HTTPMethod, Path: m.Path, HandlerFunc: v2Resource(m)})
+	}
+	return append(routes,
+		Route{Name: "V2_JSONRPC", Method: "POST", Path: JSONRPCPath, HandlerFunc: JSONRPC},
+		Route{Name: "V2_Events", Method: "GET", Path: EventsPath, HandlerFunc: Events})
+}
+
+// calls the method with the args, validated against the params of the method
+func (m Method) call(args MethodArgs) (json.RawMessage, *V2Error) {
+	if err := m.validateArgs(args); err != nil {
+		return nil, ToV2Error(err)
+	}
+	res, err := m.Handler(args)
+	if err != nil {
+		return nil, ToV2Error(err)
+	}
+	if res == nil {
+		res = json.RawMessage("null")
+	}
+	return res, nil
+}
+
+// returns the handler of the v2 resource of the method
+func v2Resource(m Method) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		cors(&w, r)
+		args := MethodArgs{values: make(map[string]string)}
+		for _, p := range ps {
+			args.values[p.Key] = p.Value
+		}
+		if m.HTTPMethod == http.MethodGet {
+			for k, v := range r.URL.Query() {
+				args.values[k] = v[0]
+			}
+		} else {
+			body, err := readBody(w, r)
+			if err != nil {
+				writeV2Error(w, err)
+				return
+			}
+			args.body = body
+		}
+		res, err := m.call(args)
+		if err != nil {
+			writeV2Error(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(res)
+	}
+}
+
+// reads the body of the request up to the max rpc body size
+func readBody(w http.ResponseWriter, r *http.Request) ([]byte, *V2Error) {
+	maxBodySize := maxRPCBodySize()
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		if strings.Contains(err.Error(), "request body too large") {
+			return nil, &V2Error{Code: CodeInvalidRequest, Message: fmt.Sprintf("the request body exceeds %d bytes", maxBodySize), status: http.StatusRequestEntityTooLarge}
+		}
+		return nil, &V2Error{Code: CodeInvalidRequest, Message: err.Error()}
+	}
+	return body, nil
+}
+
+func maxRPCBodySize() int64 {
+	if app.GlobalConfig.PocketConfig.MaxRPCBodySize <= 0 {
+		return app.DefaultMaxRPCBodySize
+	}
+	return app.GlobalConfig.PocketConfig.MaxRPCBodySize
+}
+
+func writeV2Error(w http.ResponseWriter, err *V2Error) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(err.HTTPStatus())
+	_ = json.NewEncoder(w).Encode(struct {
+		Error *V2Error `json:"error"`
+	}{err})
+}
+
+type JSONRPCRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"` // absent for notifications
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type JSONRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *V2Error        `json:"error,omitempty"`
+}
+
+// the JSON-RPC 2.0 endpoint of the v2 methods, single calls and batches
+func JSONRPC(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	cors(&w, r)
+	body, err := readBody(w, r)
+	if err != nil {
+		writeJSONRPC(w, err.HTTPStatus(), JSONRPCResponse{JSONRPC: JSONRPCVersion, Error: err})
+		return
+	}
+	body = bytes.TrimSpace(body)
+	// a batch of calls
+	if len(body) > 0 && body[0] == '[' {
+		var batch []json.RawMessage
+		if err := json.Unmarshal(body, &batch); err != nil {
+			writeJSONRPC(w, http.StatusOK, JSONRPCResponse{JSONRPC: JSONRPCVersion, Error: &V2Error{Code: CodeParseError, Message: err.Error()}})
+			return
+		}
+		if len(batch) == 0 || len(batch) > MaxJSONRPCBatchSize {
+			writeJSONRPC(w, http.StatusOK, JSONRPCResponse{JSONRPC: JSONRPCVersion, Error: &V2Error{Code: CodeInvalidRequest,
+				Message: fmt.Sprintf("a batch must have between 1 and %d calls", MaxJSONRPCBatchSize)}})
+			return
+		}
+		responses := make([]JSONRPCResponse, 0, len(batch))
+		for _, call := range batch {
+			if res, ok := callJSONRPC(call); ok {
+				responses = append(responses, res)
+			}
+		}
+		// a batch of notifications has no response
+		if len(responses) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSONRPC(w, http.StatusOK, responses)
+		return
+	}
+	if res, ok := callJSONRPC(body); ok {
+		writeJSONRPC(w, http.StatusOK, res)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// calls the method of the request, the response is not returned for notifications
+func callJSONRPC(call json.RawMessage) (res JSONRPCResponse, ok bool) {
+	var req JSONRPCRequest
+	if err := json.Unmarshal(call, &req); err != nil {
+		code := CodeParseError
+		if _, isTypeErr := err.(*json.UnmarshalTypeError); isTypeErr {
+			code = CodeInvalidRequest
+		}
+		return JSONRPCResponse{JSONRPC: JSONRPCVersion, Error: &V2Error{Code: code, Message: err.Error()}}, true
+	}
+	res = JSONRPCResponse{JSONRPC: JSONRPCVersion, ID: req.ID}
+	if req.JSONRPC != JSONRPCVersion || req.Method == "" {
+		res.Error = &V2Error{Code: CodeInvalidRequest, Message: fmt.Sprintf("the request must be a JSON-RPC %s request with a method", JSONRPCVersion)}
+		return res, true
+	}
+	m, found := v2Method(req.Method)
+	if !found {
+		res.Error = &V2Error{Code: CodeMethodNotFound, Message: fmt.Sprintf("the method %s was not found", req.Method)}
+		return res, req.ID != nil
+	}
+	args, err := jsonRPCArgs(m, req.Params)
+	if err != nil {
+		res.Error = err
+		return res, req.ID != nil
+	}
+	res.Result, res.Error = m.call(args)
+	return res, req.ID != nil
+}
+
+// returns the args of the method from the params of the JSON-RPC request:
+// the params object is the body of the POST resources and the path and query params of the GET resources
+func jsonRPCArgs(m Method, params json.RawMessage) (MethodArgs, *V2Error) {
+	args := MethodArgs{values: make(map[string]string)}
+	if len(params) == 0 || string(params) == "null" {
+		return args, nil
+	}
+	if m.HTTPMethod != http.MethodGet {
+		args.body = params
+		return args, nil
+	}
+	var values map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(params))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return args, &V2Error{Code: CodeInvalidParams, Message: "the params must be an object"}
+	}
+	for k, v := range values {
+		switch value := v.(type) {
+		case string:
+			args.values[k] = value
+		case json.Number:
+			args.values[k] = value.String()
+		case bool:
+			args.values[k] = strconv.FormatBool(value)
+		case nil:
+		default:
+			return args, &V2Error{Code: CodeInvalidParams, Message: fmt.Sprintf("the param %s must be a string, a number or a boolean", k)}
+		}
+	}
+	return args, nil
+}
+
+// returns the admin resources of the v2 methods, see GetAdminRoutes
+func V2AdminRoutes() Routes {
+	var routes Routes
+	for _, m := range V2Methods() {
+		if m.Admin {
+			routes = append(routes, Route{Name: "V2_" + m.Name, Method: m.HTTPMethod, Path: m.Path, HandlerFunc: v2Resource(m)})
+		}
+	}
+	return routes
+}
+
+// returns the public method of the name, the admin methods aren't JSON-RPC methods
+func v2Method(name string) (Method, bool) {
+	for _, m := range V2Methods() {
+		if m.Name == name && !m.Admin {
+			return m, true
+		}
+	}
+	return Method{}, false
+}
+
+func writeJSONRPC(w http.ResponseWriter, status int, res interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,203 @@
+package rpc
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// the markers of the generated blocks of doc/rpc-spec.yaml, kept in sync by TestV2OpenAPISpec (-update-spec to rewrite them)
+const (
+	OpenAPIPathsBegin   = "  # BEGIN generated v2 paths"
+	OpenAPIPathsEnd     = "  # END generated v2 paths"
+	OpenAPISchemasBegin = "    # BEGIN generated v2 schemas"
+	OpenAPISchemasEnd   = "    # END generated v2 schemas"
+	// the v1 paths are relative to the servers of the spec, so the v2 paths set their own
+	openAPIV2Server = "http://localhost:8081"
+)
+
+// returns the openapi paths of the v2 methods and the JSON-RPC endpoint, in the yaml of doc/rpc-spec.yaml
+func OpenAPIV2Paths() string {
+	var b strings.Builder
+	for _, m := range V2Methods() {
+		fmt.Fprintf(&b, "  %s:\n", openAPIPath(m.Path))
+		writeOpenAPIServers(&b)
+		fmt.Fprintf(&b, "    %s:\n", strings.ToLower(m.HTTPMethod))
+		fmt.Fprintf(&b, "      tags:\n        - %s\n", m.Tag)
+		fmt.Fprintf(&b, "      summary: %s\n", m.Summary)
+		if m.Admin {
+			b.WriteString("      description: 'An admin resource, with the admin token as a bearer token or an admin client certificate.'\n")
+		} else {
+			fmt.Fprintf(&b, "      description: 'The %s method of the JSON-RPC endpoint.'\n", m.Name)
+		}
+		fmt.Fprintf(&b, "      operationId: %s\n", m.Name)
+		if len(m.Params) != 0 {
+			b.WriteString("      parameters:\n")
+			for _, p := range m.Params {
+				fmt.Fprintf(&b, "        - name: %s\n", p.Name)
+				fmt.Fprintf(&b, "          in: %s\n", p.In)
+				fmt.Fprintf(&b, "          description: %s\n", p.Description)
+				if p.Required {
+					b.WriteString("          required: true\n")
+				}
+				fmt.Fprintf(&b, "          schema:\n            type: %s\n", p.Type)
+			}
+		}
+		if m.HTTPMethod != http.MethodGet {
+			b.WriteString("      requestBody:\n        required: true\n        content:\n          application/json:\n            schema:\n")
+			fmt.Fprintf(&b, "              $ref: '#/components/schemas/%s'\n", m.BodySchema)
+		}
+		b.WriteString("      responses:\n")
+		b.WriteString("        '200':\n")
+		fmt.Fprintf(&b, "          description: The result of %s\n", m.Name)
+		b.WriteString("          content:\n            application/json:\n              schema:\n")
+		writeOpenAPIResult(&b, m)
+		b.WriteString("        default:\n          description: The error of the call\n")
+		b.WriteString("          content:\n            application/json:\n              schema:\n")
+		b.WriteString("                $ref: '#/components/schemas/V2ErrorResponse'\n")
+	}
+	fmt.Fprintf(&b, "  %s:\n", JSONRPCPath)
+	writeOpenAPIServers(&b)
+	b.WriteString(`    post:
+      tags:
+        - jsonrpc
+      summary: Call the v2 methods with JSON-RPC 2.0, a single call or a batch
+      operationId: jsonrpc
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              oneOf:
+                - $ref: '#/components/schemas/JSONRPCRequest'
+                - type: array
+                  items:
+                    $ref: '#/components/schemas/JSONRPCRequest'
+      responses:
+        '200':
+          description: The responses of the calls, in the order of the batch
+          content:
+            application/json:
+              schema:
+                oneOf:
+                  - $ref: '#/components/schemas/JSONRPCResponse'
+                  - type: array
+                    items:
+                      $ref: '#/components/schemas/JSONRPCResponse'
+        '204':
+          description: The calls were notifications
+`)
+	return b.String()
+}
+
+// returns the openapi schemas of the v2 errors and the JSON-RPC requests and responses
+func OpenAPIV2Schemas() string {
+	type codespaceCode struct {
+		codespace string
+		code      int
+	}
+	var codes []codespaceCode
+	for codespace, code := range codespaceBaseCodes {
+		codes = append(codes, codespaceCode{string(codespace), code})
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i].code < codes[j].code })
+	var bases []string
+	for _, c := range codes {
+		bases = append(bases, fmt.Sprintf("%d for %s", c.code, c.codespace))
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, `    V2Error:
+      type: object
+      properties:
+        code:
+          type: integer
+          description: 'The JSON-RPC 2.0 error code (%d parse error, %d invalid request, %d method not found, %d invalid params, %d internal error, %d error of the node), or the base code of the codespace of the sdk error plus its code: %s.'
+        message:
+          type: string
+        data:
+          type: object
+          description: The sdk error the error is mapped from
+          properties:
+            codespace:
+              type: string
+            code:
+              type: integer
+    V2ErrorResponse:
+      type: object
+      properties:
+        error:
+          $ref: '#/components/schemas/V2Error'
+    JSONRPCRequest:
+      type: object
+      required:
+        - jsonrpc
+        - method
+      properties:
+        jsonrpc:
+          type: string
+          enum:
+            - '%s'
+        id:
+          description: The id of the call, a call without an id is a notification
+          oneOf:
+            - type: string
+            - type: integer
+        method:
+          type: string
+          enum:
+`, CodeParseError, CodeInvalidRequest, CodeMethodNotFound, CodeInvalidParams, CodeInternalError, CodeServerError,
+		strings.Join(bases, ", "), JSONRPCVersion)
+	for _, m := range V2Methods() {
+		if !m.Admin {
+			fmt.Fprintf(&b, "            - %s\n", m.Name)
+		}
+	}
+	fmt.Fprintf(&b, `        params:
+          type: object
+          description: The params of the method, the path and query params of its GET resource or the body of its POST resource
+    JSONRPCResponse:
+      type: object
+      properties:
+        jsonrpc:
+          type: string
+          enum:
+            - '%s'
+        id:
+          oneOf:
+            - type: string
+            - type: integer
+        result:
+          description: The result of the method, the response of its resource
+        error:
+          $ref: '#/components/schemas/V2Error'
+`, JSONRPCVersion)
+	return b.String()
+}
+
+// converts the httprouter path to an openapi path
+func openAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, ":") {
+			segments[i] = "{" + s[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func writeOpenAPIServers(b *strings.Builder) {
+	fmt.Fprintf(b, "    servers:\n      - url: '%s'\n", openAPIV2Server)
+}
+
+func writeOpenAPIResult(b *strings.Builder, m Method) {
+	switch {
+	case m.ResultType == "array":
+		b.WriteString("                type: array\n                items:\n")
+		fmt.Fprintf(b, "                  $ref: '#/components/schemas/%s'\n", m.ResultSchema)
+	case m.ResultSchema != "":
+		fmt.Fprintf(b, "                $ref: '#/components/schemas/%s'\n", m.ResultSchema)
+	default:
+		fmt.Fprintf(b, "                type: %s\n", m.ResultType)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/pokt-network/pocket-core/app"
 	appTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
 	core_types "github.com/tendermint/tendermint/rpc/core/types"
 	"math/big"
 	"net/http"
@@ -19,6 +20,11 @@ type heightParams struct {
 	Height int64 `json:"height"`
 }
 
+type heightAndStakeParams struct {
+	Height int64   `json:"height"`
+	Stake  sdk.Int `json:"stake"`
+}
+
 type hashParams struct {
 	Hash string `json:"hash"`
 }
@@ -275,6 +281,31 @@ func NodeReceipts(w http.ResponseWriter, r *http.Request, ps httprouter.Params)
 	WriteResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+type queryAppUsage struct {
+	Address      string `json:"address"`
+	SBlockHeight int64  `json:"session_block_height"`
+	Height       int64  `json:"height"`
+}
+
+func AppUsage(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = queryAppUsage{}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.QueryAppUsage(params.Address, params.SBlockHeight, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 type queryNodeReceipts struct {
 	Address      string `json:"address"`
 	Blockchain   string `json:"blockchain"`
@@ -363,6 +394,48 @@ func AppParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func AppRelays(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = heightAndStakeParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Stake == (sdk.Int{}) {
+		WriteErrorResponse(w, 400, "a stake amount is required")
+		return
+	}
+	res, err := app.QueryAppRelays(params.Stake, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func AppEscrow(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = heightAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res, err := app.QueryAppEscrow(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func PocketParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = heightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"testing"
@@ -16,6 +17,7 @@ import (
 	types3 "github.com/pokt-network/pocket-core/x/apps/types"
 
 	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/sdk/client"
 	"github.com/pokt-network/pocket-core/x/nodes"
 	types2 "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
@@ -410,7 +412,7 @@ func TestRPC_QueryPocketParams(t *testing.T) {
 		resp := getJSONResponse(rec)
 		assert.NotNil(t, resp)
 		assert.NotEmpty(t, resp)
-		assert.True(t, strings.Contains(rec.Body.String(), "chains"))
+		assert.True(t, strings.Contains(rec.Body.String(), "chain_registry"))
 	}
 	cleanup()
 	stopCli()
@@ -743,3 +745,61 @@ func getJSONResponse(rec *httptest.ResponseRecorder) []byte {
 	}
 	return b
 }
+
+// "inProcessTransport" - routes every request to the in process rpc server (all session nodes share the dummy service url)
+type inProcessTransport struct {
+	url       *url.URL
+	transport http.RoundTripper
+}
+
+func (ipt inProcessTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme, req.URL.Host = ipt.url.Scheme, ipt.url.Host
+	return ipt.transport.RoundTrip(req)
+}
+
+func TestRPC_ClientSDK(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	kb := getInMemoryKeybase()
+	genBZ, validators, app := fiveValidatorsOneAppGenesis()
+	_, _, cleanup := NewInMemoryTendermintNode(t, genBZ)
+	// setup relay endpoint
+	defer gock.Off()
+	expectedRequest := `"jsonrpc":"2.0","method":"web3_sha3","params":["0x68656c6c6f20776f726c64"],"id":64`
+	expectedResponse := "0x47173285a8d7341e5e972fc677286384f802f8ef42a5ec5f03bbfa254cb01fad"
+	gock.New(dummyChainsURL).
+		Post("").
+		BodyString(expectedRequest).
+		Reply(200).
+		BodyString(expectedResponse)
+	// start the in process rpc server
+	server := httptest.NewServer(Router(GetRoutes()))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+	appPrivateKey, err := kb.ExportPrivateKeyObject(app.Address, "test")
+	assert.Nil(t, err)
+	aat, err := client.NewAAT(appPrivateKey, appPrivateKey.PublicKey())
+	assert.Nil(t, err)
+	c, err := client.NewClient(client.Config{
+		Dispatchers: []string{server.URL},
+		HTTPClient:  &http.Client{Transport: inProcessTransport{url: serverURL, transport: &http.Transport{}}},
+	}, aat, appPrivateKey)
+	assert.Nil(t, err)
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	select {
+	case <-evtChan:
+		session, err := c.Dispatch(dummyChainsHash)
+		assert.Nil(t, err)
+		assert.Len(t, session.Nodes, 5)
+		// only the in process node services its own relays, so the client retries until it reaches it
+		resp, err := c.Relay(dummyChainsHash, pocketTypes.Payload{Data: expectedRequest, Method: "POST"})
+		assert.Nil(t, err)
+		assert.Equal(t, expectedResponse, resp.Response)
+		assert.Equal(t, validators[0].PublicKey.RawString(), resp.Proof.ServicerPubKey)
+		assert.Nil(t, resp.VerifySignature())
+		cleanup()
+		stopCli()
+	}
+}
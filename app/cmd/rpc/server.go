@@ -1,20 +1,33 @@
 package rpc
 
 import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
+	"strings"
 
 	"github.com/julienschmidt/httprouter"
 	"github.com/pokt-network/pocket-core/app"
+	sdk "github.com/pokt-network/posmint/types"
 )
 
 var APIVersion = fmt.Sprintf("%s", app.AppVersion)
 
-func StartRPC(port string, simulation bool) {
+// the rpc servers: the public routes and, with a listener of their own, the admin routes
+type Server struct {
+	servers   []*http.Server
+	listeners []net.Listener
+	errs      chan error
+}
+
+func NewServer(port string, simulation bool) (*Server, error) {
 	routes := GetRoutes()
 	if simulation {
 		var simIdx int
@@ -26,7 +39,60 @@ func StartRPC(port string, simulation bool) {
 		}
 		routes = append(routes[:simIdx], routes[simIdx:]...)
 	}
-	log.Fatal(http.ListenAndServe(":"+port, Router(routes)))
+	tlsConfig, err := NewTLSConfig(app.GlobalConfig.PocketConfig)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{errs: make(chan error, 2)}
+	// the admin routes are served on the rpc port unless they have a listener of their own
+	adminPort := app.GlobalConfig.PocketConfig.RPCAdminPort
+	if adminPort == "" || adminPort == port {
+		routes = append(routes, GetAdminRoutes()...)
+	} else {
+		s.servers = append(s.servers, &http.Server{Addr: ":" + adminPort, Handler: Router(GetAdminRoutes()), TLSConfig: tlsConfig})
+	}
+	s.servers = append(s.servers, &http.Server{Addr: ":" + port, Handler: Router(routes), TLSConfig: tlsConfig})
+	return s, nil
+}
+
+// listens on the ports of the servers and serves them in the background, the errors of the servers are sent to Errors
+func (s *Server) Start() error {
+	for _, server := range s.servers {
+		listener, err := net.Listen("tcp", server.Addr)
+		if err != nil {
+			_ = s.Stop(context.Background())
+			return err
+		}
+		s.listeners = append(s.listeners, listener)
+		if server.TLSConfig != nil {
+			listener = tls.NewListener(listener, server.TLSConfig)
+		}
+		go func(server *http.Server, listener net.Listener) {
+			if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+				s.errs <- err
+			}
+		}(server, listener)
+	}
+	return nil
+}
+
+// the errors of the servers, an error stops serving
+func (s *Server) Errors() <-chan error {
+	return s.errs
+}
+
+// closes the event websockets and stops the servers once the requests in flight (e.g. the relays) are done,
+// or closes the connections left when the context is done
+func (s *Server) Stop(ctx context.Context) error {
+	eventHub.Stop()
+	var err error
+	for _, server := range s.servers {
+		if e := server.Shutdown(ctx); e != nil {
+			_ = server.Close()
+			err = e
+		}
+	}
+	return err
 }
 
 func Router(routes Routes) *httprouter.Router {
@@ -38,7 +104,12 @@ func Router(routes Routes) *httprouter.Router {
 }
 
 func cors(w *http.ResponseWriter, r *http.Request) (isOptions bool) {
-	(*w).Header().Set("Access-Control-Allow-Origin", "*")
+	if origin := allowedOrigin(r); origin != "" {
+		(*w).Header().Set("Access-Control-Allow-Origin", origin)
+		if origin != "*" {
+			(*w).Header().Add("Vary", "Origin")
+		}
+	}
 	(*w).Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
 	(*w).Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization")
 	if (*r).Method == "OPTIONS" {
@@ -47,6 +118,53 @@ func cors(w *http.ResponseWriter, r *http.Request) (isOptions bool) {
 	return true
 }
 
+// returns the allowed origin of the request from the cors origins of the config, empty if the origin isn't allowed
+func allowedOrigin(r *http.Request) string {
+	origin := r.Header.Get("Origin")
+	for _, allowed := range app.GlobalConfig.PocketConfig.RPCCORSAllowedOrigins {
+		if allowed == "*" {
+			return "*"
+		}
+		if origin != "" && strings.EqualFold(allowed, origin) {
+			return origin
+		}
+	}
+	return ""
+}
+
+// protects the admin route with the admin token of the config (a bearer token) or an admin client certificate,
+// without either the admin routes are unauthorized
+func AdminAuth(handler httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		if !isAdmin(r) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			if strings.HasPrefix(r.URL.Path, "/v2") {
+				writeV2Error(w, ToV2Error(sdk.ErrUnauthorized("the admin routes need the admin token or an admin client certificate")))
+				return
+			}
+			WriteErrorResponse(w, http.StatusUnauthorized, "the admin routes need the admin token or an admin client certificate")
+			return
+		}
+		handler(w, r, ps)
+	}
+}
+
+func isAdmin(r *http.Request) bool {
+	c := app.GlobalConfig.PocketConfig
+	// the client certificates are only verified with the admin CA
+	if c.RPCAdminClientCAFile != "" && r.TLS != nil && len(r.TLS.VerifiedChains) != 0 {
+		return true
+	}
+	if c.RPCAdminToken == "" {
+		return false
+	}
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), []byte(c.RPCAdminToken)) == 1
+}
+
 type Route struct {
 	Name        string
 	Method      string
@@ -78,15 +196,29 @@ func GetRoutes() Routes {
 		Route{Name: "QueryApps", Method: "POST", Path: "/v1/query/apps", HandlerFunc: Apps},
 		Route{Name: "QueryApp", Method: "POST", Path: "/v1/query/app", HandlerFunc: App},
 		Route{Name: "QueryAppParams", Method: "POST", Path: "/v1/query/appparams", HandlerFunc: AppParams},
+		Route{Name: "QueryAppRelays", Method: "POST", Path: "/v1/query/apprelays", HandlerFunc: AppRelays},
+		Route{Name: "QueryAppEscrow", Method: "POST", Path: "/v1/query/appescrow", HandlerFunc: AppEscrow},
+		Route{Name: "QueryAppUsage", Method: "POST", Path: "/v1/query/appusage", HandlerFunc: AppUsage},
 		Route{Name: "QueryPocketParams", Method: "POST", Path: "/v1/query/pocketparams", HandlerFunc: PocketParams},
 		Route{Name: "QuerySupportedChains", Method: "POST", Path: "/v1/query/supportedchains", HandlerFunc: SupportedChains},
 		Route{Name: "QuerySupply", Method: "POST", Path: "/v1/query/supply", HandlerFunc: Supply},
 		Route{Name: "QueryDAOOwner", Method: "POST", Path: "/v1/query/daoowner", HandlerFunc: DAOOwner},
 		Route{Name: "QueryUpgrade", Method: "POST", Path: "/v1/query/upgrade", HandlerFunc: Upgrade},
 		Route{Name: "QueryACL", Method: "POST", Path: "/v1/query/acl", HandlerFunc: ACL},
-		Route{Name: "QueryState", Method: "POST", Path: "/v1/query/state", HandlerFunc: State},
 		Route{Name: "SimulateRequest", Method: "POST", Path: "/v1/client/sim", HandlerFunc: SimRequest},
 	}
+	return append(routes, V2Routes()...)
+}
+
+// returns the admin routes, protected by the admin token or an admin client certificate
+func GetAdminRoutes() Routes {
+	routes := Routes{
+		Route{Name: "QueryState", Method: "POST", Path: "/v1/query/state", HandlerFunc: State},
+	}
+	routes = append(routes, V2AdminRoutes()...)
+	for i := range routes {
+		routes[i].HandlerFunc = AdminAuth(routes[i].HandlerFunc)
+	}
 	return routes
 }
 
@@ -115,7 +247,10 @@ func WriteJSONResponse(w http.ResponseWriter, jsn, path, ip string) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
 	var raw map[string]interface{}
-	if err := json.Unmarshal([]byte(jsn), &raw); err != nil {
+	// use numbers to preserve int64 precision (e.g. the entropy of relay proofs)
+	decoder := json.NewDecoder(strings.NewReader(jsn))
+	decoder.UseNumber()
+	if err := decoder.Decode(&raw); err != nil {
 		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 		log.Println(err.Error())
 	}
@@ -0,0 +1,237 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pokt-network/pocket-core/app"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORS(t *testing.T) {
+	defer func(c app.PocketConfig) { app.GlobalConfig.PocketConfig = c }(app.GlobalConfig.PocketConfig)
+	tests := []struct {
+		name    string
+		allowed []string
+		origin  string
+		want    string
+	}{
+		{"every origin", []string{"*"}, "https://a.com", "*"},
+		{"allowed origin", []string{"https://a.com", "https://b.com"}, "https://b.com", "https://b.com"},
+		{"other origin", []string{"https://a.com"}, "https://b.com", ""},
+		{"no origin allowed", nil, "https://a.com", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app.GlobalConfig.PocketConfig.RPCCORSAllowedOrigins = tt.allowed
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/v2", nil)
+			req.Header.Set("Origin", tt.origin)
+			Router(V2Routes()).ServeHTTP(rec, req)
+			assert.Equal(t, tt.want, rec.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tt.want == "" || tt.want == "*", rec.Header().Get("Vary") == "")
+		})
+	}
+}
+
+func TestAdminRoutes(t *testing.T) {
+	defer func(c app.PocketConfig) { app.GlobalConfig.PocketConfig = c }(app.GlobalConfig.PocketConfig)
+	// the admin routes aren't public
+	for _, route := range GetRoutes() {
+		assert.NotContains(t, []string{"/v1/query/state", "/v2/state"}, route.Path)
+	}
+	rec := httptest.NewRecorder()
+	Router(V2Routes()).ServeHTTP(rec, httptest.NewRequest("POST", JSONRPCPath, strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"query_state"}`)))
+	assert.Contains(t, rec.Body.String(), `"code":-32601`)
+	// every admin route is protected
+	for _, route := range GetAdminRoutes() {
+		rec := httptest.NewRecorder()
+		Router(GetAdminRoutes()).ServeHTTP(rec, httptest.NewRequest(route.Method, route.Path, nil))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, route.Path)
+	}
+	handler := AdminAuth(func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+		w.WriteHeader(http.StatusOK)
+	})
+	tests := []struct {
+		name   string
+		token  string
+		auth   string
+		status int
+	}{
+		{"no admin token", "", "Bearer ", http.StatusUnauthorized},
+		{"no authorization", "secret", "", http.StatusUnauthorized},
+		{"wrong token", "secret", "Bearer secreT", http.StatusUnauthorized},
+		{"not a bearer token", "secret", "Basic secret", http.StatusUnauthorized},
+		{"admin token", "secret", "Bearer secret", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app.GlobalConfig.PocketConfig.RPCAdminToken = tt.token
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/v2/state", nil)
+			req.Header.Set("Authorization", tt.auth)
+			handler(rec, req, nil)
+			assert.Equal(t, tt.status, rec.Code)
+			if tt.status == http.StatusUnauthorized {
+				assert.Contains(t, rec.Body.String(), `"error"`)
+			}
+		})
+	}
+}
+
+func TestTLS(t *testing.T) {
+	defer func(c app.PocketConfig, interval time.Duration) {
+		app.GlobalConfig.PocketConfig, certReloadInterval = c, interval
+	}(app.GlobalConfig.PocketConfig, certReloadInterval)
+	dir, err := ioutil.TempDir("", "rpc-tls")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	ca, caKey := newTestCert(t, nil, nil, 1)
+	serverCert, serverKey := newTestCert(t, ca, caKey, 2)
+	clientCert, clientKey := newTestCert(t, ca, caKey, 3)
+	c := &app.GlobalConfig.PocketConfig
+	c.RPCTLSCertFile, c.RPCTLSKeyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	c.RPCAdminClientCAFile = filepath.Join(dir, "ca.pem")
+	c.RPCAdminToken = ""
+	writeTestCert(t, c.RPCAdminClientCAFile, "", ca, nil)
+	writeTestCert(t, c.RPCTLSCertFile, c.RPCTLSKeyFile, serverCert, serverKey)
+	// the invalid configs
+	_, err = NewTLSConfig(app.PocketConfig{RPCTLSCertFile: c.RPCTLSCertFile})
+	assert.NotNil(t, err)
+	_, err = NewTLSConfig(app.PocketConfig{RPCAdminClientCAFile: c.RPCAdminClientCAFile})
+	assert.NotNil(t, err)
+	config, err := NewTLSConfig(*c)
+	assert.Nil(t, err)
+	server := httptest.NewUnstartedServer(Router(Routes{
+		Route{Name: "Admin", Method: "GET", Path: "/admin", HandlerFunc: AdminAuth(func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+			w.WriteHeader(http.StatusOK)
+		})},
+	}))
+	// the server name of the clients selects the certificate of the config over the one of httptest
+	server.TLS = config
+	server.StartTLS()
+	defer server.Close()
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	get := func(certs ...tls.Certificate) (*http.Response, error) {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: certs, ServerName: "localhost"}, DisableKeepAlives: true}}
+		return client.Get(server.URL + "/admin")
+	}
+	// without a client certificate
+	res, err := get()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	assert.Equal(t, serverCert.SerialNumber, res.TLS.PeerCertificates[0].SerialNumber)
+	// with an admin client certificate
+	res, err = get(tls.Certificate{Certificate: [][]byte{clientCert.Raw}, PrivateKey: clientKey})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	// the renewed certificate is served without a restart
+	certReloadInterval = 0
+	renewedCert, renewedKey := newTestCert(t, ca, caKey, 4)
+	writeTestCert(t, c.RPCTLSCertFile, c.RPCTLSKeyFile, renewedCert, renewedKey)
+	future := time.Now().Add(time.Minute)
+	assert.Nil(t, os.Chtimes(c.RPCTLSCertFile, future, future))
+	res, err = get()
+	assert.Nil(t, err)
+	assert.Equal(t, renewedCert.SerialNumber, res.TLS.PeerCertificates[0].SerialNumber)
+}
+
+// returns a certificate signed by the parent, or a self signed CA without a parent
+func newTestCert(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, serial int64) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.Subject = pkix.Name{CommonName: "rpc test CA"}
+		template.IsCA, template.BasicConstraintsValid = true, true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	return cert, key
+}
+
+func writeTestCert(t *testing.T, certFile, keyFile string, cert *x509.Certificate, key *ecdsa.PrivateKey) {
+	assert.Nil(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0600))
+	if keyFile == "" {
+		return
+	}
+	bz, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: bz}), 0600))
+}
+
+func TestServer_Stop(t *testing.T) {
+	defer func(c app.PocketConfig) { app.GlobalConfig.PocketConfig = c }(app.GlobalConfig.PocketConfig)
+	free, err := net.Listen("tcp", ":0")
+	assert.Nil(t, err)
+	app.GlobalConfig.PocketConfig.RPCAdminPort = strconv.Itoa(free.Addr().(*net.TCPAddr).Port)
+	assert.Nil(t, free.Close())
+	s, err := NewServer("0", false)
+	assert.Nil(t, err)
+	// the admin routes have a listener of their own
+	assert.Len(t, s.servers, 2)
+	// a request in flight is done before the server stops
+	inFlight := make(chan struct{})
+	s.servers[1].Handler = Router(Routes{
+		Route{Name: "Relay", Method: "GET", Path: "/relay", HandlerFunc: func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+			close(inFlight)
+			time.Sleep(200 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+		}},
+	})
+	assert.Nil(t, s.Start())
+	url := "http://" + s.listeners[1].Addr().String() + "/relay"
+	status := make(chan int, 1)
+	go func() {
+		res, err := http.Get(url)
+		if err != nil {
+			status <- 0
+			return
+		}
+		status <- res.StatusCode
+	}()
+	<-inFlight
+	assert.Nil(t, s.Stop(context.Background()))
+	assert.Equal(t, http.StatusOK, <-status)
+	_, err = http.Get(url)
+	assert.NotNil(t, err)
+	select {
+	case err := <-s.Errors():
+		t.Fatal(err)
+	default:
+	}
+}
@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/app"
+)
+
+// how often the files of the certificate are checked for changes, at most
+var certReloadInterval = 5 * time.Second
+
+// returns the tls config of the rpc from the pocket config, nil if tls isn't configured:
+// the certificate is reloaded when its files change and the admin client certificates are verified with the admin CA
+func NewTLSConfig(c app.PocketConfig) (*tls.Config, error) {
+	if c.RPCTLSCertFile == "" && c.RPCTLSKeyFile == "" {
+		if c.RPCAdminClientCAFile != "" {
+			return nil, errors.New("the admin client certificates need the tls certificate and key files of the rpc")
+		}
+		return nil, nil
+	}
+	if c.RPCTLSCertFile == "" || c.RPCTLSKeyFile == "" {
+		return nil, errors.New("the tls of the rpc needs both the certificate and key files")
+	}
+	reloader, err := newCertReloader(c.RPCTLSCertFile, c.RPCTLSKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+	if c.RPCAdminClientCAFile != "" {
+		bz, err := ioutil.ReadFile(c.RPCAdminClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bz) {
+			return nil, fmt.Errorf("no certificates found in the admin client CA file %s", c.RPCAdminClientCAFile)
+		}
+		// the clients without a certificate are public or use the admin token
+		config.ClientCAs = pool
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return config, nil
+}
+
+// a certificate reloaded when its files are modified, so it can be renewed without a restart
+type certReloader struct {
+	certFile  string
+	keyFile   string
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	c := &certReloader{certFile: certFile, keyFile: keyFile}
+	modTime, err := c.lastModified()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.load(modTime); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *certReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Since(c.lastCheck) >= certReloadInterval {
+		c.lastCheck = time.Now()
+		modTime, err := c.lastModified()
+		if err == nil && modTime.After(c.modTime) {
+			// a certificate being replaced may not load yet, the current one is kept until it does
+			if err := c.load(modTime); err != nil {
+				log.Printf("unable to reload the tls certificate of the rpc: %s", err.Error())
+			}
+		}
+	}
+	return c.cert, nil
+}
+
+// loads the certificate, the caller holds the lock (or the reloader is new)
+func (c *certReloader) load(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return err
+	}
+	c.cert, c.modTime = &cert, modTime
+	return nil
+}
+
+// returns the latest modification time of the certificate and key files
+func (c *certReloader) lastModified() (time.Time, error) {
+	var modTime time.Time
+	for _, file := range []string{c.certFile, c.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return modTime, err
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+	return modTime, nil
+}
@@ -0,0 +1,623 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pokt-network/pocket-core/app"
+	appTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// the locations of the params of a v2 method
+const (
+	ParamInPath  = "path"
+	ParamInQuery = "query"
+)
+
+// a param of a v2 method, a path or query param of the GET resource and a field of the JSON-RPC params object
+type MethodParam struct {
+	Name        string
+	In          string
+	Type        string // the openapi type: string, integer or boolean
+	Description string
+	Required    bool
+}
+
+// a method of the v2 api, served as a resource and as a JSON-RPC 2.0 method
+// reads are GET resources with path and query params; the client calls are POST resources with a json body,
+// which is the params object of the JSON-RPC method
+type Method struct {
+	Name         string // the name of the JSON-RPC method
+	HTTPMethod   string
+	Path         string // the httprouter path of the resource
+	Tag          string
+	Summary      string
+	Params       []MethodParam
+	BodySchema   string // the schema of the body of POST resources
+	ResultSchema string // the schema of the result, when empty the ResultType is used
+	ResultType   string
+	Admin        bool // an admin resource (see GetAdminRoutes), not a JSON-RPC method
+	Handler      func(args MethodArgs) (json.RawMessage, error)
+}
+
+var (
+	heightParam = MethodParam{Name: "height", In: ParamInQuery, Type: "integer", Description: "The height of the state, the latest when 0"}
+	addrParam   = MethodParam{Name: "address", In: ParamInPath, Type: "string", Description: "The hex address", Required: true}
+	pageParams  = []MethodParam{
+		{Name: "page", In: ParamInQuery, Type: "integer", Description: "The page of the results"},
+		{Name: "per_page", In: ParamInQuery, Type: "integer", Description: "The number of results per page"},
+	}
+	proveParam = MethodParam{Name: "prove", In: ParamInQuery, Type: "boolean", Description: "Include the proofs of the transactions"}
+)
+
+// returns the methods of the v2 api
+func V2Methods() []Method {
+	return []Method{
+		{Name: "version", HTTPMethod: "GET", Path: "/v2", Tag: "version", Summary: "Get the version of the Pocket Network API",
+			ResultType: "string", Handler: v2Version},
+		{Name: "client_dispatch", HTTPMethod: "POST", Path: "/v2/client/dispatch", Tag: "client", Summary: "Dispatch the session of an application",
+			BodySchema: "SessionHeader", ResultSchema: "QueryDispatchResponse", Handler: v2Dispatch},
+		{Name: "client_relay", HTTPMethod: "POST", Path: "/v2/client/relay", Tag: "client", Summary: "Relay a request to a blockchain",
+			BodySchema: "QueryRelayRequest", ResultSchema: "QueryRelayResponse", Handler: v2Relay},
+		{Name: "client_challenge", HTTPMethod: "POST", Path: "/v2/client/challenge", Tag: "client", Summary: "Challenge the relay responses of a session",
+			BodySchema: "QueryChallengeRequest", ResultSchema: "QueryChallengeResponse", Handler: v2Challenge},
+		{Name: "client_rawtx", HTTPMethod: "POST", Path: "/v2/client/rawtx", Tag: "client", Summary: "Broadcast a signed transaction",
+			BodySchema: "QueryRawTXRequest", ResultSchema: "QueryRawTXResponse", Handler: v2SendRawTx},
+		{Name: "query_height", HTTPMethod: "GET", Path: "/v2/height", Tag: "query", Summary: "Get the height of the blockchain",
+			ResultSchema: "QueryHeightResponse", Handler: v2Height},
+		{Name: "query_block", HTTPMethod: "GET", Path: "/v2/block", Tag: "query", Summary: "Get a block",
+			Params: []MethodParam{heightParam}, ResultSchema: "QueryBlockResponse", Handler: v2Block},
+		{Name: "query_block_txs", HTTPMethod: "GET", Path: "/v2/block/txs", Tag: "query", Summary: "Get the transactions of a block",
+			Params: append([]MethodParam{heightParam, proveParam}, pageParams...), ResultSchema: "QueryBlockTXsResponse", Handler: v2BlockTxs},
+		{Name: "query_tx", HTTPMethod: "GET", Path: "/v2/txs/:hash", Tag: "query", Summary: "Get a transaction by hash",
+			Params:       []MethodParam{{Name: "hash", In: ParamInPath, Type: "string", Description: "The hex hash of the transaction", Required: true}},
+			ResultSchema: "QueryTXResponse", Handler: v2Tx},
+		{Name: "query_account", HTTPMethod: "GET", Path: "/v2/accounts/:address", Tag: "query", Summary: "Get an account",
+			Params: []MethodParam{addrParam, heightParam}, ResultSchema: "Account", Handler: v2Account},
+		{Name: "query_balance", HTTPMethod: "GET", Path: "/v2/accounts/:address/balance", Tag: "query", Summary: "Get the balance of an account",
+			Params: []MethodParam{addrParam, heightParam}, ResultSchema: "QueryBalanceResponse", Handler: v2Balance},
+		{Name: "query_account_txs", HTTPMethod: "GET", Path: "/v2/accounts/:address/txs", Tag: "query", Summary: "Get the transactions of an account",
+			Params: append([]MethodParam{addrParam,
+				{Name: "received", In: ParamInQuery, Type: "boolean", Description: "Get the transactions received instead of the ones sent"}, proveParam},
+				pageParams...), ResultSchema: "QueryAccountTXsResponse", Handler: v2AccountTxs},
+		{Name: "query_nodes", HTTPMethod: "GET", Path: "/v2/nodes", Tag: "query", Summary: "Get the nodes",
+			Params: append([]MethodParam{heightParam,
+				{Name: "staking_status", In: ParamInQuery, Type: "integer", Description: "The staking status of the nodes, 1 for unstaking and 2 for staked"},
+				{Name: "jailed_status", In: ParamInQuery, Type: "integer", Description: "The jailed status of the nodes, 1 for jailed and 2 for unjailed"},
+				{Name: "blockchain", In: ParamInQuery, Type: "string", Description: "The blockchain staked for by the nodes"}},
+				pageParams...), ResultSchema: "QueryNodesResponse", Handler: v2Nodes},
+		{Name: "query_node", HTTPMethod: "GET", Path: "/v2/nodes/:address", Tag: "query", Summary: "Get a node",
+			Params: []MethodParam{addrParam, heightParam}, ResultSchema: "Node", Handler: v2Node},
+		{Name: "query_node_receipts", HTTPMethod: "GET", Path: "/v2/nodes/:address/receipts", Tag: "query", Summary: "Get the receipts of a node",
+			Params: []MethodParam{addrParam, heightParam}, ResultSchema: "QueryNodeReceiptsResponse", Handler: v2NodeReceipts},
+		{Name: "query_node_receipt", HTTPMethod: "GET", Path: "/v2/nodes/:address/receipt", Tag: "query", Summary: "Get a receipt of a node",
+			Params: []MethodParam{addrParam, heightParam,
+				{Name: "blockchain", In: ParamInQuery, Type: "string", Description: "The blockchain of the session", Required: true},
+				{Name: "app_pubkey", In: ParamInQuery, Type: "string", Description: "The public key of the application of the session", Required: true},
+				{Name: "session_block_height", In: ParamInQuery, Type: "integer", Description: "The block height of the session", Required: true},
+				{Name: "receipt_type", In: ParamInQuery, Type: "string", Description: "The type of the receipt, relay or challenge", Required: true}},
+			ResultSchema: "StoredReceipt", Handler: v2NodeReceipt},
+		{Name: "query_node_params", HTTPMethod: "GET", Path: "/v2/params/nodes", Tag: "query", Summary: "Get the params of the nodes module",
+			Params: []MethodParam{heightParam}, ResultSchema: "NodeParams", Handler: v2NodeParams},
+		{Name: "query_apps", HTTPMethod: "GET", Path: "/v2/apps", Tag: "query", Summary: "Get the applications",
+			Params: append([]MethodParam{heightParam,
+				{Name: "staking_status", In: ParamInQuery, Type: "integer", Description: "The staking status of the applications, 1 for unstaking and 2 for staked"},
+				{Name: "blockchain", In: ParamInQuery, Type: "string", Description: "The blockchain staked for by the applications"}},
+				pageParams...), ResultSchema: "QueryAppsResponse", Handler: v2Apps},
+		{Name: "query_app", HTTPMethod: "GET", Path: "/v2/apps/:address", Tag: "query", Summary: "Get an application",
+			Params: []MethodParam{addrParam, heightParam}, ResultSchema: "Application", Handler: v2App},
+		{Name: "query_app_escrow", HTTPMethod: "GET", Path: "/v2/apps/:address/escrow", Tag: "query", Summary: "Get the escrow of a prepaid application",
+			Params: []MethodParam{addrParam, heightParam}, ResultSchema: "AppEscrow", Handler: v2AppEscrow},
+		{Name: "query_app_usage", HTTPMethod: "GET", Path: "/v2/apps/:address/usage", Tag: "query", Summary: "Get the relay usage of an application",
+			Params: []MethodParam{addrParam, heightParam,
+				{Name: "session_block_height", In: ParamInQuery, Type: "integer", Description: "The block height of the session, every session when 0"}},
+			ResultType: "array", ResultSchema: "AppUsage", Handler: v2AppUsage},
+		{Name: "query_app_relays", HTTPMethod: "GET", Path: "/v2/app-relays", Tag: "query", Summary: "Get the max relays of an application stake",
+			Params:     []MethodParam{heightParam, {Name: "stake", In: ParamInQuery, Type: "string", Description: "The stake of the application (in uPOKT)", Required: true}},
+			ResultType: "string", Handler: v2AppRelays},
+		{Name: "query_app_params", HTTPMethod: "GET", Path: "/v2/params/apps", Tag: "query", Summary: "Get the params of the applications module",
+			Params: []MethodParam{heightParam}, ResultSchema: "ApplicationParams", Handler: v2AppParams},
+		{Name: "query_pocket_params", HTTPMethod: "GET", Path: "/v2/params/pocket", Tag: "query", Summary: "Get the params of the pocketcore module",
+			Params: []MethodParam{heightParam}, ResultSchema: "PocketParams", Handler: v2PocketParams},
+		{Name: "query_supported_chains", HTTPMethod: "GET", Path: "/v2/supported-chains", Tag: "query", Summary: "Get the supported blockchains",
+			Params: []MethodParam{heightParam}, ResultSchema: "QuerySupportedChainsResponse", Handler: v2SupportedChains},
+		{Name: "query_supply", HTTPMethod: "GET", Path: "/v2/supply", Tag: "query", Summary: "Get the supply of the network",
+			Params: []MethodParam{heightParam}, ResultSchema: "QuerySupplyResponse", Handler: v2Supply},
+		{Name: "query_dao_owner", HTTPMethod: "GET", Path: "/v2/gov/dao-owner", Tag: "query", Summary: "Get the address of the owner of the DAO",
+			Params: []MethodParam{heightParam}, ResultType: "string", Handler: v2DAOOwner},
+		{Name: "query_upgrade", HTTPMethod: "GET", Path: "/v2/gov/upgrade", Tag: "query", Summary: "Get the protocol upgrade",
+			Params: []MethodParam{heightParam}, ResultType: "object", Handler: v2Upgrade},
+		{Name: "query_acl", HTTPMethod: "GET", Path: "/v2/gov/acl", Tag: "query", Summary: "Get the access control list of the params",
+			Params: []MethodParam{heightParam}, ResultType: "object", Handler: v2ACL},
+		{Name: "query_state", HTTPMethod: "GET", Path: "/v2/state", Tag: "admin", Summary: "Export the state of the blockchain",
+			ResultType: "object", Admin: true, Handler: v2State},
+	}
+}
+
+// the args of a call of a v2 method: the path and query params of GET resources, or the json body of POST resources
+type MethodArgs struct {
+	values map[string]string
+	body   json.RawMessage
+}
+
+func (a MethodArgs) String(name string) string {
+	return a.values[name]
+}
+
+func (a MethodArgs) Int64(name string) (int64, error) {
+	v, ok := a.values[name]
+	if !ok || v == "" {
+		return 0, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, NewInvalidParamsError(fmt.Errorf("%s must be an integer", name))
+	}
+	return i, nil
+}
+
+func (a MethodArgs) Int(name string) (int, error) {
+	i, err := a.Int64(name)
+	return int(i), err
+}
+
+func (a MethodArgs) Bool(name string) (bool, error) {
+	v, ok := a.values[name]
+	if !ok || v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, NewInvalidParamsError(fmt.Errorf("%s must be a boolean", name))
+	}
+	return b, nil
+}
+
+// decodes the json body into the model
+func (a MethodArgs) Decode(model interface{}) error {
+	if len(a.body) == 0 {
+		return NewInvalidParamsError(fmt.Errorf("the params are required"))
+	}
+	if err := json.Unmarshal(a.body, model); err != nil {
+		return NewInvalidParamsError(err)
+	}
+	return nil
+}
+
+// validates the args against the params of the method
+func (m Method) validateArgs(args MethodArgs) error {
+	known := make(map[string]struct{}, len(m.Params))
+	for _, p := range m.Params {
+		known[p.Name] = struct{}{}
+		if v, ok := args.values[p.Name]; p.Required && (!ok || v == "") {
+			return NewInvalidParamsError(fmt.Errorf("%s is required", p.Name))
+		}
+	}
+	for name := range args.values {
+		if _, ok := known[name]; !ok {
+			return NewInvalidParamsError(fmt.Errorf("unknown param %s", name))
+		}
+	}
+	return nil
+}
+
+func v2Version(_ MethodArgs) (json.RawMessage, error) {
+	return json.Marshal(APIVersion)
+}
+
+func v2Dispatch(args MethodArgs) (json.RawMessage, error) {
+	var header types.SessionHeader
+	if err := args.Decode(&header); err != nil {
+		return nil, err
+	}
+	res, err := app.QueryDispatch(header)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+func v2Relay(args MethodArgs) (json.RawMessage, error) {
+	var relay types.Relay
+	if err := args.Decode(&relay); err != nil {
+		return nil, err
+	}
+	res, err := app.QueryRelay(relay)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+func v2Challenge(args MethodArgs) (json.RawMessage, error) {
+	var challenge types.ChallengeProofInvalidData
+	if err := args.Decode(&challenge); err != nil {
+		return nil, err
+	}
+	res, err := app.QueryChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+func v2SendRawTx(args MethodArgs) (json.RawMessage, error) {
+	var params sendRawTxParams
+	if err := args.Decode(&params); err != nil {
+		return nil, err
+	}
+	bz, err := hex.DecodeString(params.RawHexBytes)
+	if err != nil {
+		return nil, NewInvalidParamsError(err)
+	}
+	res, err := app.SendRawTx(params.Addr, bz)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2Height(_ MethodArgs) (json.RawMessage, error) {
+	res, err := app.QueryHeight()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&queryHeightResponse{Height: res})
+}
+
+func v2Block(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	return app.QueryBlock(&height)
+}
+
+func v2BlockTxs(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	page, perPage, prove, err := pageArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryBlockTxs(height, page, perPage, prove)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+func v2Tx(args MethodArgs) (json.RawMessage, error) {
+	res, err := app.QueryTx(args.String("hash"))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+func v2Account(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryAccount(args.String("address"), height)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+func v2Balance(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	balance, err := app.QueryBalance(args.String("address"), height)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&queryBalanceResponse{Balance: balance.BigInt()})
+}
+
+func v2AccountTxs(args MethodArgs) (json.RawMessage, error) {
+	received, err := args.Bool("received")
+	if err != nil {
+		return nil, err
+	}
+	page, perPage, prove, err := pageArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	query := app.QueryAccountTxs
+	if received {
+		query = app.QueryRecipientTxs
+	}
+	res, err := query(args.String("address"), page, perPage, prove)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+func v2Nodes(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	var opts nodeTypes.QueryValidatorsParams
+	stakingStatus, err := args.Int("staking_status")
+	if err != nil {
+		return nil, err
+	}
+	opts.StakingStatus = sdk.StakeStatus(stakingStatus)
+	if opts.JailedStatus, err = args.Int("jailed_status"); err != nil {
+		return nil, err
+	}
+	opts.Blockchain = args.String("blockchain")
+	if opts.Page, opts.Limit, _, err = pageArgs(args); err != nil {
+		return nil, err
+	}
+	res, err := app.QueryNodes(height, opts)
+	if err != nil {
+		return nil, err
+	}
+	return res.JSON()
+}
+
+func v2Node(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryNode(args.String("address"), height)
+	if err != nil {
+		return nil, err
+	}
+	return res.MarshalJSON()
+}
+
+func v2NodeReceipts(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryReceipts(args.String("address"), height)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2NodeReceipt(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	sessionBlockHeight, err := args.Int64("session_block_height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryReceipt(args.String("blockchain"), args.String("app_pubkey"), args.String("address"), args.String("receipt_type"), sessionBlockHeight, height)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2NodeParams(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryNodeParams(height)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2Apps(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	var opts appTypes.QueryApplicationsWithOpts
+	stakingStatus, err := args.Int("staking_status")
+	if err != nil {
+		return nil, err
+	}
+	opts.StakingStatus = sdk.StakeStatus(stakingStatus)
+	opts.Blockchain = args.String("blockchain")
+	if opts.Page, opts.Limit, _, err = pageArgs(args); err != nil {
+		return nil, err
+	}
+	res, err := app.QueryApps(height, opts)
+	if err != nil {
+		return nil, err
+	}
+	return res.JSON()
+}
+
+func v2App(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryApp(args.String("address"), height)
+	if err != nil {
+		return nil, err
+	}
+	return res.MarshalJSON()
+}
+
+func v2AppEscrow(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryAppEscrow(args.String("address"), height)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2AppUsage(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	sessionBlockHeight, err := args.Int64("session_block_height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryAppUsage(args.String("address"), sessionBlockHeight, height)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2AppRelays(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	stake, ok := sdk.NewIntFromString(args.String("stake"))
+	if !ok {
+		return nil, NewInvalidParamsError(fmt.Errorf("stake must be an integer"))
+	}
+	res, err := app.QueryAppRelays(stake, height)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2AppParams(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryAppParams(height)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2PocketParams(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryPocketParams(height)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2SupportedChains(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryPocketSupportedBlockchains(height)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2Supply(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	nodesStake, nodesUnstaked, err := app.QueryTotalNodeCoins(height)
+	if err != nil {
+		return nil, err
+	}
+	appsStaked, appsUnstaked, err := app.QueryTotalAppCoins(height)
+	if err != nil {
+		return nil, err
+	}
+	dao, err := app.QueryDaoBalance(height)
+	if err != nil {
+		return nil, err
+	}
+	totalStaked := nodesStake.Add(appsStaked).Add(dao)
+	totalUnstaked := nodesUnstaked.Add(appsUnstaked).Sub(nodesStake).Sub(appsStaked)
+	return json.Marshal(&querySupplyResponse{
+		NodeStaked:    nodesStake.Int64(),
+		AppStaked:     appsStaked.Int64(),
+		Dao:           dao.Int64(),
+		TotalStaked:   totalStaked.BigInt(),
+		TotalUnstaked: totalUnstaked.BigInt(),
+		Total:         totalStaked.Add(totalUnstaked).BigInt(),
+	})
+}
+
+func v2DAOOwner(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryDaoOwner(height)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+func v2Upgrade(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryUpgrade(height)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(res)
+}
+
+func v2ACL(args MethodArgs) (json.RawMessage, error) {
+	height, err := args.Int64("height")
+	if err != nil {
+		return nil, err
+	}
+	res, err := app.QueryACL(height)
+	if err != nil {
+		return nil, err
+	}
+	return app.Codec().MarshalJSON(res)
+}
+
+func v2State(_ MethodArgs) (json.RawMessage, error) {
+	res, err := app.ExportState()
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(res), nil
+}
+
+// returns the pagination args of the method
+func pageArgs(args MethodArgs) (page, perPage int, prove bool, err error) {
+	if page, err = args.Int("page"); err != nil {
+		return
+	}
+	if perPage, err = args.Int("per_page"); err != nil {
+		return
+	}
+	prove, err = args.Bool("prove")
+	return
+}
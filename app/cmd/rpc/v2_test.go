@@ -0,0 +1,230 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	nodeTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"gopkg.in/yaml.v2"
+)
+
+var updateSpec = flag.Bool("update-spec", false, "rewrite the generated v2 blocks of doc/rpc-spec.yaml and regenerate doc/rpc-spec.json")
+
+const (
+	specYAMLPath = "../../../doc/rpc-spec.yaml"
+	specJSONPath = "../../../doc/rpc-spec.json"
+)
+
+func TestV2OpenAPISpec(t *testing.T) {
+	bz, err := ioutil.ReadFile(specYAMLPath)
+	assert.Nil(t, err)
+	spec := string(bz)
+	updated, err := spliceSpec(spec, OpenAPIPathsBegin, OpenAPIPathsEnd, OpenAPIV2Paths())
+	assert.Nil(t, err)
+	updated, err = spliceSpec(updated, OpenAPISchemasBegin, OpenAPISchemasEnd, OpenAPIV2Schemas())
+	assert.Nil(t, err)
+	jsonSpec, err := specToJSON([]byte(updated))
+	assert.Nil(t, err)
+	if *updateSpec {
+		assert.Nil(t, ioutil.WriteFile(specYAMLPath, []byte(updated), 0644))
+		assert.Nil(t, ioutil.WriteFile(specJSONPath, jsonSpec, 0644))
+		return
+	}
+	assert.Equal(t, updated, spec, "doc/rpc-spec.yaml is out of sync with the v2 methods, run: go test ./app/cmd/rpc -run TestV2OpenAPISpec -update-spec")
+	currentJSON, err := ioutil.ReadFile(specJSONPath)
+	assert.Nil(t, err)
+	assert.Equal(t, string(jsonSpec), string(currentJSON), "doc/rpc-spec.json is out of sync with doc/rpc-spec.yaml, run: go test ./app/cmd/rpc -run TestV2OpenAPISpec -update-spec")
+	// every schema referenced is defined
+	var doc struct {
+		Components struct {
+			Schemas map[string]interface{} `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	assert.Nil(t, yaml.Unmarshal(bz, &doc))
+	for _, ref := range regexp.MustCompile(`#/components/schemas/(\w+)`).FindAllStringSubmatch(spec, -1) {
+		assert.Contains(t, doc.Components.Schemas, ref[1])
+	}
+}
+
+func TestToV2Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   int
+		status int
+	}{
+		{"sdk error", pocketTypes.NewInvalidBlockHeightError(pocketTypes.ModuleName), 5000 + int(pocketTypes.CodeInvalidBlockHeightError), http.StatusBadRequest},
+		{"abci log of an sdk error", errors.New(nodeTypes.ErrNoValidatorFound(nodeTypes.DefaultCodespace).ABCILog()), 3000 + int(nodeTypes.CodeInvalidValidator), http.StatusBadRequest},
+		{"not found sdk error", pocketTypes.NewAppNotFoundError(pocketTypes.ModuleName), 5000 + int(pocketTypes.CodeAppNotFoundError), http.StatusNotFound},
+		{"unauthorized sdk error", sdk.ErrUnauthorized("unauthorized"), 1000 + int(sdk.CodeUnauthorized), http.StatusUnauthorized},
+		{"node error", errors.New("height must be less than or equal to the current blockchain height"), CodeServerError, http.StatusBadRequest},
+		{"invalid params", NewInvalidParamsError(errors.New("invalid")), CodeInvalidParams, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ToV2Error(tt.err)
+			assert.Equal(t, tt.code, err.Code)
+			assert.Equal(t, tt.status, err.HTTPStatus())
+			assert.NotEmpty(t, err.Message)
+		})
+	}
+}
+
+func TestV2_JSONRPC(t *testing.T) {
+	router := Router(V2Routes())
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"call", `{"jsonrpc":"2.0","id":1,"method":"version"}`, `{"jsonrpc":"2.0","id":1,"result":"` + APIVersion + `"}`},
+		{"parse error", `{"jsonrpc":"2.0",`, `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"unexpected end of JSON input"}}`},
+		{"invalid request", `{"id":1,"method":"version"}`, `{"jsonrpc":"2.0","id":1,"error":{"code":-32600,"message":"the request must be a JSON-RPC 2.0 request with a method"}}`},
+		{"method not found", `{"jsonrpc":"2.0","id":"a","method":"unknown"}`, `{"jsonrpc":"2.0","id":"a","error":{"code":-32601,"message":"the method unknown was not found"}}`},
+		{"unknown param", `{"jsonrpc":"2.0","id":1,"method":"query_height","params":{"height":1}}`, `{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"unknown param height"}}`},
+		{"missing param", `{"jsonrpc":"2.0","id":1,"method":"query_node","params":{}}`, `{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"address is required"}}`},
+		{"invalid param", `{"jsonrpc":"2.0","id":1,"method":"query_block","params":{"height":"a"}}`, `{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"height must be an integer"}}`},
+		{"batch", `[{"jsonrpc":"2.0","id":1,"method":"version"},{"jsonrpc":"2.0","method":"version"},{"jsonrpc":"2.0","id":2,"method":"unknown"}]`,
+			`[{"jsonrpc":"2.0","id":1,"result":"` + APIVersion + `"},{"jsonrpc":"2.0","id":2,"error":{"code":-32601,"message":"the method unknown was not found"}}]`},
+		{"empty batch", `[]`, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"a batch must have between 1 and 100 calls"}}`},
+		{"notification", `{"jsonrpc":"2.0","method":"version"}`, ``},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest("POST", JSONRPCPath, strings.NewReader(tt.body)))
+			assert.Equal(t, tt.want, strings.TrimSpace(rec.Body.String()))
+		})
+	}
+}
+
+func TestV2_Resources(t *testing.T) {
+	router := Router(V2Routes())
+	_, _, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	_, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	select {
+	case <-evtChan:
+		kb := getInMemoryKeybase()
+		cb, err := kb.GetCoinbase()
+		assert.Nil(t, err)
+		// a GET resource
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", "/v2/accounts/"+cb.GetAddress().String()+"/balance?height=0", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var balance queryBalanceResponse
+		assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &balance))
+		assert.NotZero(t, balance.Balance)
+		// the JSON-RPC method mirrors the resource
+		rec2 := httptest.NewRecorder()
+		router.ServeHTTP(rec2, httptest.NewRequest("POST", JSONRPCPath, strings.NewReader(
+			`{"jsonrpc":"2.0","id":1,"method":"query_balance","params":{"address":"`+cb.GetAddress().String()+`","height":0}}`)))
+		var res JSONRPCResponse
+		assert.Nil(t, json.Unmarshal(rec2.Body.Bytes(), &res))
+		assert.Nil(t, res.Error)
+		assert.JSONEq(t, rec.Body.String(), string(res.Result))
+		// the sdk errors are mapped from their codespace
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("POST", "/v2/client/dispatch", strings.NewReader(`{"app_public_key":"","chain":"0001","session_height":1}`)))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		var resErr struct {
+			Error V2Error `json:"error"`
+		}
+		assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &resErr))
+		if assert.NotNil(t, resErr.Error.Data) {
+			assert.Equal(t, pocketTypes.ModuleName, resErr.Error.Data.Codespace)
+			assert.Equal(t, 5000+int(resErr.Error.Data.Code), resErr.Error.Code)
+		}
+		// the invalid params
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", "/v2/block?height=a", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		// the body of the POST resources
+		rec = httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("POST", "/v2/client/rawtx", bytes.NewBufferString(`{"address":"`+cb.GetAddress().String()+`","raw_hex_bytes":"zz"}`)))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+	cleanup()
+	stopCli()
+}
+
+// replaces the lines between the begin and end markers of the spec with the generated block
+func spliceSpec(spec, begin, end, block string) (string, error) {
+	start := strings.Index(spec, begin+"\n")
+	stop := strings.Index(spec, end+"\n")
+	if start == -1 || stop < start {
+		return "", errors.New("the markers of the generated block were not found: " + begin)
+	}
+	start += len(begin) + 1
+	return spec[:start] + block + spec[stop:], nil
+}
+
+// converts the yaml spec to json, keeping the order of the keys
+func specToJSON(spec []byte) ([]byte, error) {
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal(spec, &doc); err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "\t")
+	if err := enc.Encode(orderedJSON{doc}); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+type orderedJSON struct {
+	value interface{}
+}
+
+func (o orderedJSON) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	switch v := o.value.(type) {
+	case yaml.MapSlice:
+		b.WriteByte('{')
+		for i, item := range v {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := enc.Encode(item.Key.(string)); err != nil {
+				return nil, err
+			}
+			b.WriteByte(':')
+			if err := enc.Encode(orderedJSON{item.Value}); err != nil {
+				return nil, err
+			}
+		}
+		b.WriteByte('}')
+	case []interface{}:
+		b.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := enc.Encode(orderedJSON{item}); err != nil {
+				return nil, err
+			}
+		}
+		b.WriteByte(']')
+	default:
+		if err := enc.Encode(v); err != nil {
+			return nil, err
+		}
+	}
+	return b.Bytes(), nil
+}
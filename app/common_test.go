@@ -94,6 +94,7 @@ var (
 		auth.FeeCollectorName:     nil,
 		nodesTypes.StakedPoolName: {auth.Burner, auth.Minter, auth.Staking},
 		appsTypes.StakedPoolName:  {auth.Burner, auth.Minter, auth.Staking},
+		appsTypes.EscrowPoolName:  nil,
 		nodesTypes.ModuleName:     {auth.Burner, auth.Minter, auth.Staking},
 		govTypes.DAOAccountName:   {auth.Burner, auth.Staking, auth.Minter},
 		appsTypes.ModuleName:      nil,
@@ -132,11 +133,14 @@ func newMemPCApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseA
 		authSubspace,
 		moduleAccountPermissions,
 	)
+	// The chain validator validates the chains of the stakes against the pocket core chain registry
+	chainValidator := pocketKeeper.NewChainValidator(pocketSubspace)
 	// The nodesKeeper keeper handles pocket core nodes
 	app.nodesKeeper = nodesKeeper.NewKeeper(
 		app.cdc,
 		app.keys[nodesTypes.StoreKey],
 		app.accountKeeper,
+		chainValidator,
 		nodesSubspace,
 		nodesTypes.DefaultCodespace,
 	)
@@ -146,6 +150,7 @@ func newMemPCApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseA
 		app.keys[appsTypes.StoreKey],
 		app.nodesKeeper,
 		app.accountKeeper,
+		chainValidator,
 		appsSubspace,
 		appsTypes.DefaultCodespace,
 	)
@@ -187,7 +192,7 @@ func newMemPCApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.BaseA
 	)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 	app.SetInitChainer(app.InitChainer)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper))
+	app.SetAnteHandler(NewAnteHandler(app.accountKeeper, NewMessageFeeKeepers(app.nodesKeeper, app.appsKeeper, app.pocketKeeper)))
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 	app.MountKVStores(app.keys)
@@ -508,7 +513,7 @@ func oneValTwoNodeGenesisState() []byte {
 	authGenState.Accounts = append(authGenState.Accounts, &auth.BaseAccount{
 		Address: sdk.Address(pubKey2.Address()),
 		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(1000000000))),
-		PubKey:  pubKey,
+		PubKey:  pubKey2,
 	})
 	res3 := memCodec().MustMarshalJSON(authGenState)
 	defaultGenesis[auth.ModuleName] = res3
@@ -516,7 +521,7 @@ func oneValTwoNodeGenesisState() []byte {
 	rawPocket := defaultGenesis[pocketTypes.ModuleName]
 	var pocketGenesisState pocketTypes.GenesisState
 	memCodec().MustUnmarshalJSON(rawPocket, &pocketGenesisState)
-	pocketGenesisState.Params.SupportedBlockchains = []string{"00"}
+	pocketGenesisState.Params.ChainRegistry = pocketTypes.NewLegacyChainRegistry([]string{"00"})
 	res4 := memCodec().MustMarshalJSON(pocketGenesisState)
 	defaultGenesis[pocketTypes.ModuleName] = res4
 	// set default governance in genesis
@@ -551,18 +556,22 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("gov/daoOwner", kp.GetAddress())
 		acl.SetOwner("gov/acl", kp.GetAddress())
 		acl.SetOwner("pos/StakeDenom", kp.GetAddress())
-		acl.SetOwner("pocketcore/SupportedBlockchains", kp.GetAddress())
+		acl.SetOwner("pocketcore/ChainRegistry", kp.GetAddress())
 		acl.SetOwner("pos/DowntimeJailDuration", kp.GetAddress())
 		acl.SetOwner("pos/SlashFractionDoubleSign", kp.GetAddress())
 		acl.SetOwner("pos/SlashFractionDowntime", kp.GetAddress())
 		acl.SetOwner("application/ApplicationStakeMinimum", kp.GetAddress())
 		acl.SetOwner("pocketcore/ClaimExpiration", kp.GetAddress())
+		acl.SetOwner("pocketcore/AppUsageRetention", kp.GetAddress())
 		acl.SetOwner("pocketcore/SessionNodeCount", kp.GetAddress())
 		acl.SetOwner("pocketcore/ReplayAttackBurnMultiplier", kp.GetAddress())
 		acl.SetOwner("pos/MaxValidators", kp.GetAddress())
 		acl.SetOwner("pos/ProposerPercentage", kp.GetAddress())
 		acl.SetOwner("application/StabilityAdjustment", kp.GetAddress())
 		acl.SetOwner("application/AppUnstakingTime", kp.GetAddress())
+		acl.SetOwner("application/AppMinimumJailDuration", kp.GetAddress())
+		acl.SetOwner("application/AppMisbehaviorReportThreshold", kp.GetAddress())
+		acl.SetOwner("application/PrepaidRelayPrice", kp.GetAddress())
 		acl.SetOwner("application/ParticipationRateOn", kp.GetAddress())
 		acl.SetOwner("pos/MaxEvidenceAge", kp.GetAddress())
 		acl.SetOwner("pos/MinSignedPerWindow", kp.GetAddress())
@@ -571,9 +580,14 @@ func createTestACL(kp keys.KeyPair) govTypes.ACL {
 		acl.SetOwner("application/BaseRelaysPerPOKT", kp.GetAddress())
 		acl.SetOwner("pocketcore/ClaimSubmissionWindow", kp.GetAddress())
 		acl.SetOwner("pos/DAOAllocation", kp.GetAddress())
+		acl.SetOwner("pos/ServicerLivenessJailThreshold", kp.GetAddress())
+		acl.SetOwner("pos/ServicerLivenessJailDuration", kp.GetAddress())
 		acl.SetOwner("pos/SignedBlocksWindow", kp.GetAddress())
 		acl.SetOwner("pos/BlocksPerSession", kp.GetAddress())
 		acl.SetOwner("application/MaxApplications", kp.GetAddress())
+		acl.SetOwner("pos/MessageFees", kp.GetAddress())
+		acl.SetOwner("application/MessageFees", kp.GetAddress())
+		acl.SetOwner("pocketcore/MessageFees", kp.GetAddress())
 		acl.SetOwner("gov/daoOwner", kp.GetAddress())
 		acl.SetOwner("gov/upgrade", kp.GetAddress())
 		testACL = acl
@@ -635,7 +649,7 @@ func twoValTwoNodeGenesisState() []byte {
 	authGenState.Accounts = append(authGenState.Accounts, &auth.BaseAccount{
 		Address: sdk.Address(pubKey2.Address()),
 		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(1000000000))),
-		PubKey:  pubKey,
+		PubKey:  pubKey2,
 	})
 	res2 := memCodec().MustMarshalJSON(authGenState)
 	defaultGenesis[auth.ModuleName] = res2
@@ -643,7 +657,7 @@ func twoValTwoNodeGenesisState() []byte {
 	rawPocket := defaultGenesis[pocketTypes.ModuleName]
 	var pocketGenesisState pocketTypes.GenesisState
 	memCodec().MustUnmarshalJSON(rawPocket, &pocketGenesisState)
-	pocketGenesisState.Params.SupportedBlockchains = []string{dummyChainsHash}
+	pocketGenesisState.Params.ChainRegistry = pocketTypes.NewLegacyChainRegistry([]string{dummyChainsHash})
 	res3 := memCodec().MustMarshalJSON(pocketGenesisState)
 	defaultGenesis[pocketTypes.ModuleName] = res3
 	// set default governance in genesis
@@ -773,7 +787,7 @@ func fiveValidatorsOneAppGenesis() (genBz []byte, keys []crypto.PrivateKey, vali
 	rawPocket := defaultGenesis[pocketTypes.ModuleName]
 	var pocketGenesisState pocketTypes.GenesisState
 	memCodec().MustUnmarshalJSON(rawPocket, &pocketGenesisState)
-	pocketGenesisState.Params.SupportedBlockchains = []string{dummyChainsHash}
+	pocketGenesisState.Params.ChainRegistry = pocketTypes.NewLegacyChainRegistry([]string{dummyChainsHash})
 	pocketGenesisState.Params.ClaimSubmissionWindow = 10
 	res3 := memCodec().MustMarshalJSON(pocketGenesisState)
 	defaultGenesis[pocketTypes.ModuleName] = res3
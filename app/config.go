@@ -2,6 +2,8 @@ package app
 
 import (
 	"bufio"
+	"bytes"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -50,6 +52,7 @@ const (
 	DefaultPVKName                  = "priv_val_key.json"
 	DefaultPVSName                  = "priv_val_state.json"
 	DefaultNKName                   = "node_key.json"
+	DefaultServicerKeyName          = "servicer_key.json"
 	DefaultChainsName               = "chains.json"
 	DefaultGenesisName              = "genesis.json"
 	DefaultRPCPort                  = "8081"
@@ -63,6 +66,16 @@ const (
 	DefaultListenAddr               = "tcp://0.0.0.0:"
 	DefaultClientBlockSyncAllowance = 10
 	DefaultJSONSortRelayResponses   = true
+	DefaultProberEnabled            = false
+	DefaultProberSampleSize         = 5
+	DefaultProberTimeout            = 5000
+	DefaultRemoteSignerType         = types.FileSignerType
+	DefaultRemoteSignerTimeout      = 5000
+	DefaultMinimumGasPrices         = ""
+	DefaultMaxRPCBodySize           = 4194304
+	DefaultMaxEventConnections      = 100
+	DefaultRPCCORSAllowedOrigin     = "*"
+	DefaultShutdownTimeout          = 30000
 	DefaultDBBackend                = string(dbm.GoLevelDBBackend)
 	DefaultTxIndexer                = "kv"
 	DefaultTxIndexTags              = "tx.hash,tx.height,message.sender,transfer.recipient"
@@ -106,6 +119,26 @@ type PocketConfig struct {
 	MaxEvidenceCacheEntires  int               `json:"max_evidence_cache_entries"`
 	MaxSessionCacheEntries   int               `json:"max_session_cache_entries"`
 	JSONSortRelayResponses   bool              `json:"json_sort_relay_responses"`
+	ProberEnabled            bool              `json:"prober_enabled"`
+	ProberSampleSize         int               `json:"prober_sample_size"`
+	ProberTimeout            int64             `json:"prober_timeout"`
+	RemoteSignerType         string            `json:"remote_signer_type"`
+	RemoteSignerAddress      string            `json:"remote_signer_address"`
+	RemoteSignerTimeout      int64             `json:"remote_signer_timeout"`
+	RemoteSignerTLSCertFile  string            `json:"remote_signer_tls_cert_file"`
+	RemoteSignerTLSKeyFile   string            `json:"remote_signer_tls_key_file"`
+	RemoteSignerTLSCAFile    string            `json:"remote_signer_tls_ca_file"`
+	ServicerKeyName          string            `json:"servicer_key_file"`
+	MinimumGasPrices         string            `json:"minimum_gas_prices"`
+	MaxRPCBodySize           int64             `json:"max_rpc_body_size"`
+	MaxEventConnections      int               `json:"max_event_connections"`
+	RPCTLSCertFile           string            `json:"rpc_tls_cert_file"`
+	RPCTLSKeyFile            string            `json:"rpc_tls_key_file"`
+	RPCCORSAllowedOrigins    []string          `json:"rpc_cors_allowed_origins"`
+	RPCAdminPort             string            `json:"rpc_admin_port"`
+	RPCAdminToken            string            `json:"rpc_admin_token"`
+	RPCAdminClientCAFile     string            `json:"rpc_admin_client_ca_file"`
+	ShutdownTimeout          int64             `json:"shutdown_timeout"`
 }
 
 func DefaultConfig(dataDir string) Config {
@@ -126,6 +159,17 @@ func DefaultConfig(dataDir string) Config {
 			MaxEvidenceCacheEntires:  DefaultMaxEvidenceCacheEntries,
 			MaxSessionCacheEntries:   DefaultMaxSessionCacheEntries,
 			JSONSortRelayResponses:   DefaultJSONSortRelayResponses,
+			ProberEnabled:            DefaultProberEnabled,
+			ProberSampleSize:         DefaultProberSampleSize,
+			ProberTimeout:            DefaultProberTimeout,
+			RemoteSignerType:         DefaultRemoteSignerType,
+			RemoteSignerTimeout:      DefaultRemoteSignerTimeout,
+			ServicerKeyName:          DefaultServicerKeyName,
+			MinimumGasPrices:         DefaultMinimumGasPrices,
+			MaxRPCBodySize:           DefaultMaxRPCBodySize,
+			MaxEventConnections:      DefaultMaxEventConnections,
+			RPCCORSAllowedOrigins:    []string{DefaultRPCCORSAllowedOrigin},
+			ShutdownTimeout:          DefaultShutdownTimeout,
 		},
 	}
 	c.TendermintConfig.SetRoot(dataDir)
@@ -281,19 +325,30 @@ func InitTendermint() *node.Node {
 		TraceWriter: "",
 	}
 	tmNode, app, err := NewClient(config(c), func(logger log.Logger, db dbm.DB, _ io.Writer) *pocketCoreApp {
-		return NewPocketCoreApp(logger, db, baseapp.SetPruning(store.PruneNothing))
+		return NewPocketCoreApp(logger, db, baseapp.SetPruning(store.PruneNothing), baseapp.SetMinGasPrices(GlobalConfig.PocketConfig.MinimumGasPrices))
 	})
 	if err != nil {
 		panic(err)
 	}
-	if err := tmNode.Start(); err != nil {
-		panic(err)
-	}
+	// the node is started by the lifecycle, see NodeComponents
 	app.SetTendermintNode(tmNode)
 	pca = app
 	return tmNode
 }
 
+// the mutual tls config of the http remote signer, nil if the certificate files are not set
+func remoteSignerTLSConfig() *tls.Config {
+	c := GlobalConfig.PocketConfig
+	if c.RemoteSignerTLSCertFile == "" || c.RemoteSignerTLSKeyFile == "" || c.RemoteSignerTLSCAFile == "" {
+		return nil
+	}
+	tlsConfig, err := types.NewSignerTLSConfig(c.RemoteSignerTLSCertFile, c.RemoteSignerTLSKeyFile, c.RemoteSignerTLSCAFile)
+	if err != nil {
+		panic(fmt.Errorf("unable to load the tls files of the remote signer: %s", err.Error()))
+	}
+	return tlsConfig
+}
+
 func InitKeyfiles() string {
 	var password string
 	datadir := GlobalConfig.PocketConfig.DataDir
@@ -306,7 +361,36 @@ func InitKeyfiles() string {
 			//panic on other errors
 			panic(err)
 		}
+	} else if signerType := GlobalConfig.PocketConfig.RemoteSignerType; signerType != "" && signerType != types.FileSignerType {
+		// the servicer key is held by a remote signer, so it's never on disk: the private validator key file only holds
+		// the consensus key, which tendermint signs with, and a servicer key file is refused
+		if servicerKeyFile := datadir + FS + GlobalConfig.PocketConfig.ServicerKeyName; GlobalConfig.PocketConfig.ServicerKeyName != "" && cmn.FileExists(servicerKeyFile) {
+			panic(fmt.Errorf("the servicer key is held by the remote signer, remove the servicer key file %s", servicerKeyFile))
+		}
+		signer, err := types.NewSigner(signerType, GlobalConfig.PocketConfig.RemoteSignerAddress, GlobalConfig.PocketConfig.RemoteSignerTimeout, remoteSignerTLSConfig())
+		if err != nil {
+			panic(fmt.Errorf("unable to connect to the remote signer: %s", err.Error()))
+		}
+		// the consensus key signs votes and proposals, it's never handed to a remote signer of servicer messages
+		if consensusKey, _ := loadPKFromFile(datadir + FS + GlobalConfig.TendermintConfig.PrivValidatorKey); consensusKey.PubKey.Equals(signer.PublicKey().PubKey()) {
+			panic(fmt.Errorf("the remote signer holds the consensus key, register a separate servicer key with rotate-servicer-key"))
+		}
+		types.InitSigner(signer)
+		initRetiredSigners(datadir)
+	} else if servicerKeyFile := datadir + FS + GlobalConfig.PocketConfig.ServicerKeyName; GlobalConfig.PocketConfig.ServicerKeyName != "" && cmn.FileExists(servicerKeyFile) {
+		// the servicer key is separate from the consensus key in the private validator key file
+		file, _ := loadPKFromFile(servicerKeyFile)
+		pk, err := crypto.PrivKeyToPrivateKey(file.PrivKey)
+		if err != nil {
+			panic(err)
+		}
+		types.InitSigner(types.NewFileSigner(pk))
+		initRetiredSigners(datadir)
 	} else {
+		// restrict the permissions of a key file written before the key files were private
+		if err := os.Chmod(datadir+FS+GlobalConfig.TendermintConfig.PrivValidatorKey, 0600); err != nil {
+			panic(err)
+		}
 		// file exist so we can load pk from file.
 		file, _ := loadPKFromFile(datadir + FS + GlobalConfig.TendermintConfig.PrivValidatorKey)
 		types.InitPVKeyFile(file)
@@ -354,6 +438,7 @@ func InitPocketCoreConfig() {
 	types.InitCache(GlobalConfig.PocketConfig.DataDir, GlobalConfig.PocketConfig.DataDir, GlobalConfig.PocketConfig.SessionDBType, GlobalConfig.PocketConfig.EvidenceDBType, GlobalConfig.PocketConfig.MaxEvidenceCacheEntires, GlobalConfig.PocketConfig.MaxSessionCacheEntries)
 	types.InitClientBlockAllowance(GlobalConfig.PocketConfig.ClientBlockSyncAllowance)
 	types.InitJSONSorting(GlobalConfig.PocketConfig.JSONSortRelayResponses)
+	types.InitProber(GlobalConfig.PocketConfig.ProberEnabled, GlobalConfig.PocketConfig.ProberSampleSize, GlobalConfig.PocketConfig.ProberTimeout)
 }
 
 // get the global keybase
@@ -411,7 +496,7 @@ func privValKey(address sdk.Address, password string) string {
 	if err != nil {
 		panic(err)
 	}
-	pvFile, err := os.OpenFile(GlobalConfig.PocketConfig.DataDir+FS+GlobalConfig.TendermintConfig.PrivValidatorKey, os.O_RDWR|os.O_CREATE, 0666)
+	pvFile, err := os.OpenFile(GlobalConfig.PocketConfig.DataDir+FS+GlobalConfig.TendermintConfig.PrivValidatorKey, os.O_RDWR|os.O_CREATE, 0600)
 	if err != nil {
 		panic(err)
 	}
@@ -436,7 +521,7 @@ func nodeKey(address sdk.Address, password string) {
 	if err != nil {
 		panic(err)
 	}
-	pvFile, err := os.OpenFile(GlobalConfig.PocketConfig.DataDir+FS+GlobalConfig.TendermintConfig.NodeKey, os.O_RDWR|os.O_CREATE, 0666)
+	pvFile, err := os.OpenFile(GlobalConfig.PocketConfig.DataDir+FS+GlobalConfig.TendermintConfig.NodeKey, os.O_RDWR|os.O_CREATE, 0600)
 	if err != nil {
 		panic(err)
 	}
@@ -451,7 +536,7 @@ func privValState() {
 	if err != nil {
 		panic(err)
 	}
-	pvFile, err := os.OpenFile(GlobalConfig.PocketConfig.DataDir+FS+GlobalConfig.TendermintConfig.PrivValidatorState, os.O_RDWR|os.O_CREATE, 0666)
+	pvFile, err := os.OpenFile(GlobalConfig.PocketConfig.DataDir+FS+GlobalConfig.TendermintConfig.PrivValidatorState, os.O_RDWR|os.O_CREATE, 0600)
 	if err != nil {
 		panic(err)
 	}
@@ -463,17 +548,25 @@ func privValState() {
 
 func getTMClient() client.Client {
 	if tmClient == nil {
-		if GlobalConfig.PocketConfig.TendermintURI == "" {
-			tmClient = client.NewHTTP(DefaultTMURI, "/websocket")
-		} else {
-			tmClient = client.NewHTTP(GlobalConfig.PocketConfig.TendermintURI, "/websocket")
-		}
+		tmClient = client.NewHTTP(tmURI(), "/websocket")
 	}
 	return tmClient
 }
 
-// get the hosted chains variable
-func NewHostedChains() *types.HostedBlockchains {
+// returns a new tendermint client for the event subscriptions of the rpc, with a websocket of its own
+func NewTMEventClient() client.Client {
+	return client.NewHTTP(tmURI(), "/websocket")
+}
+
+func tmURI() string {
+	if GlobalConfig.PocketConfig.TendermintURI == "" {
+		return DefaultTMURI
+	}
+	return GlobalConfig.PocketConfig.TendermintURI
+}
+
+// get the hosted chains variable (the chain registry is optional and only used to validate newly generated chains)
+func NewHostedChains(registry types.ChainRegistry) *types.HostedBlockchains {
 	// create the chains path
 	var chainsPath = GlobalConfig.PocketConfig.DataDir + FS + ConfigDirName + FS + GlobalConfig.PocketConfig.ChainsName
 	// if file exists open, else create and open
@@ -488,7 +581,7 @@ func NewHostedChains() *types.HostedBlockchains {
 			panic(NewInvalidChainsError(err))
 		}
 		// generate hosted chains from user input
-		c := GenerateHostedChains()
+		c := GenerateHostedChains(registry)
 		// create dummy input for the file
 		res, err := json.MarshalIndent(c, "", "  ")
 		if err != nil {
@@ -537,13 +630,23 @@ func NewHostedChains() *types.HostedBlockchains {
 }
 
 const (
-	enterIDPrompt     = `Enter the ID of the network identifier:`
-	enterURLPrompt    = `Enter the URL of the network identifier:`
-	addNewChainPrompt = `Would you like to enter another network identifier? (y/n)`
-	ReadInError       = `An error occurred reading in the information: `
+	enterIDPrompt        = `Enter the ID of the network identifier:`
+	enterURLPrompt       = `Enter the URL of the network identifier:`
+	addNewChainPrompt    = `Would you like to enter another network identifier? (y/n)`
+	registeredChainsInfo = `The following network identifiers are registered in the pocket chain registry:`
+	ReadInError          = `An error occurred reading in the information: `
 )
 
-func GenerateHostedChains() (chains []types.HostedBlockchain) {
+func GenerateHostedChains(registry types.ChainRegistry) (chains []types.HostedBlockchain) {
+	// display the registered chains if the registry is available
+	if len(registry) != 0 {
+		fmt.Println(registeredChainsInfo)
+		for _, blockchain := range registry {
+			if !blockchain.Deprecated {
+				fmt.Println(blockchain.String())
+			}
+		}
+	}
 	for {
 		var ID, URL, again string
 		fmt.Println(enterIDPrompt)
@@ -559,6 +662,21 @@ func GenerateHostedChains() (chains []types.HostedBlockchain) {
 			fmt.Println("please try again")
 			continue
 		}
+		// validate against the chain registry if available
+		if len(registry) != 0 {
+			blockchain, found := registry.Get(ID)
+			if !found {
+				fmt.Println(ID + " is not registered in the pocket chain registry")
+				fmt.Println("please try again")
+				continue
+			}
+			if blockchain.Deprecated {
+				fmt.Println(blockchain.Name + " is deprecated in the pocket chain registry")
+				fmt.Println("please try again")
+				continue
+			}
+			fmt.Println(ID + " is " + blockchain.Name + " (" + blockchain.Type + ")")
+		}
 		fmt.Println(enterURLPrompt)
 		URL, err = reader.ReadString('\n')
 		if err != nil {
@@ -654,6 +772,60 @@ func SetValidator(address sdk.Address, passphrase string) {
 	nodeKey(address, passphrase)
 }
 
+// write the servicer key file, so the node signs relays, claims and proofs with a key separate from the consensus key
+func SetServicer(address sdk.Address, passphrase string) error {
+	if GlobalConfig.PocketConfig.ServicerKeyName == "" {
+		return fmt.Errorf("the servicer key file is not set in the config")
+	}
+	res, err := MustGetKeybase().ExportPrivateKeyObject(address, passphrase)
+	if err != nil {
+		return err
+	}
+	servicerKey := privval.FilePVKey{
+		Address: res.PubKey().Address(),
+		PubKey:  res.PubKey(),
+		PrivKey: res.PrivKey(),
+	}
+	bz, err := cdc.MarshalJSONIndent(servicerKey, "", "  ")
+	if err != nil {
+		return err
+	}
+	servicerKeyFile := GlobalConfig.PocketConfig.DataDir + FS + GlobalConfig.PocketConfig.ServicerKeyName
+	// keep the replaced servicer key, the relays it served are proven with it after the rotation
+	if cmn.FileExists(servicerKeyFile) {
+		old, _ := loadPKFromFile(servicerKeyFile)
+		if !bytes.Equal(old.Address, servicerKey.Address) {
+			if err := os.Rename(servicerKeyFile, retiredServicerKeyFile(GlobalConfig.PocketConfig.DataDir, old.Address.String())); err != nil {
+				return err
+			}
+		}
+	}
+	return ioutil.WriteFile(servicerKeyFile, bz, 0600)
+}
+
+// the file of a servicer key the node rotated out of
+func retiredServicerKeyFile(datadir, address string) string {
+	return datadir + FS + "retired_" + strings.ToLower(address) + "_" + GlobalConfig.PocketConfig.ServicerKeyName
+}
+
+// load the servicer keys the node rotated out of (and the consensus key, which served before the first rotation),
+// so the claims of the sessions they served are still proven; the retired key files may be removed once the
+// claims of their sessions are settled
+func initRetiredSigners(datadir string) {
+	var signers []types.Signer
+	files, _ := fp.Glob(retiredServicerKeyFile(datadir, "*"))
+	files = append(files, datadir+FS+GlobalConfig.TendermintConfig.PrivValidatorKey)
+	for _, f := range files {
+		file, _ := loadPKFromFile(f)
+		pk, err := crypto.PrivKeyToPrivateKey(file.PrivKey)
+		if err != nil {
+			panic(err)
+		}
+		signers = append(signers, types.NewFileSigner(pk))
+	}
+	types.InitRetiredSigners(signers...)
+}
+
 func GetPrivValFile() (file privval.FilePVKey) {
 	file, _ = loadPKFromFile(GlobalConfig.PocketConfig.DataDir + FS + GlobalConfig.TendermintConfig.PrivValidatorKey)
 	return
@@ -745,18 +917,22 @@ func createDummyACL(kp crypto.PublicKey) govTypes.ACL {
 	acl.SetOwner("gov/daoOwner", addr)
 	acl.SetOwner("gov/acl", addr)
 	acl.SetOwner("pos/StakeDenom", addr)
-	acl.SetOwner("pocketcore/SupportedBlockchains", addr)
+	acl.SetOwner("pocketcore/ChainRegistry", addr)
 	acl.SetOwner("pos/DowntimeJailDuration", addr)
 	acl.SetOwner("pos/SlashFractionDoubleSign", addr)
 	acl.SetOwner("pos/SlashFractionDowntime", addr)
 	acl.SetOwner("application/ApplicationStakeMinimum", addr)
 	acl.SetOwner("pocketcore/ClaimExpiration", addr)
+	acl.SetOwner("pocketcore/AppUsageRetention", addr)
 	acl.SetOwner("pocketcore/SessionNodeCount", addr)
 	acl.SetOwner("pocketcore/ReplayAttackBurnMultiplier", addr)
 	acl.SetOwner("pos/MaxValidators", addr)
 	acl.SetOwner("pos/ProposerPercentage", addr)
 	acl.SetOwner("application/StabilityAdjustment", addr)
 	acl.SetOwner("application/AppUnstakingTime", addr)
+	acl.SetOwner("application/AppMinimumJailDuration", addr)
+	acl.SetOwner("application/AppMisbehaviorReportThreshold", addr)
+	acl.SetOwner("application/PrepaidRelayPrice", addr)
 	acl.SetOwner("application/ParticipationRateOn", addr)
 	acl.SetOwner("pos/MaxEvidenceAge", addr)
 	acl.SetOwner("pos/MinSignedPerWindow", addr)
@@ -765,9 +941,14 @@ func createDummyACL(kp crypto.PublicKey) govTypes.ACL {
 	acl.SetOwner("application/BaseRelaysPerPOKT", addr)
 	acl.SetOwner("pocketcore/ClaimSubmissionWindow", addr)
 	acl.SetOwner("pos/DAOAllocation", addr)
+	acl.SetOwner("pos/ServicerLivenessJailThreshold", addr)
+	acl.SetOwner("pos/ServicerLivenessJailDuration", addr)
 	acl.SetOwner("pos/SignedBlocksWindow", addr)
 	acl.SetOwner("pos/BlocksPerSession", addr)
 	acl.SetOwner("application/MaxApplications", addr)
+	acl.SetOwner("pos/MessageFees", addr)
+	acl.SetOwner("application/MessageFees", addr)
+	acl.SetOwner("pocketcore/MessageFees", addr)
 	acl.SetOwner("gov/daoOwner", addr)
 	acl.SetOwner("gov/upgrade", addr)
 	return acl
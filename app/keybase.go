@@ -0,0 +1,282 @@
+package app
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	bip39 "github.com/cosmos/go-bip39"
+	"github.com/pokt-network/posmint/crypto"
+	kb "github.com/pokt-network/posmint/crypto/keys"
+	"github.com/pokt-network/posmint/crypto/keys/mintkey"
+	sdk "github.com/pokt-network/posmint/types"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	MnemonicEntropySize  = 256                    // the entropy of generated mnemonics in bits (24 words)
+	PocketCoinType       = 635                    // the SLIP-0044 coin type of pocket
+	PocketHDPathFormat   = "m/44'/635'/0'/0'/%d'" // the HD path of the account with the index
+	KeybaseBackupVersion = "1"                    // the version of the keybase backup format
+	KeybaseBackupKDF     = "scrypt"               // the key derivation function of the keybase backup
+)
+
+const (
+	hardenedKeyOffset      = uint32(0x80000000) // the offset of the hardened child indexes
+	ed25519SeedModifier    = "ed25519 seed"     // the hmac key of the SLIP-0010 master key for ed25519
+	keybaseBackupSaltSize  = 16                 // the salt size of the keybase backup in bytes
+	keybaseBackupScryptN   = 1 << 15            // the scrypt cpu/memory cost of the keybase backup
+	keybaseBackupScryptR   = 8                  // the scrypt block size of the keybase backup
+	keybaseBackupScryptP   = 1                  // the scrypt parallelization of the keybase backup
+	keybaseBackupKeyLength = 32                 // the AES-256 key length
+)
+
+// generate a new BIP-0039 mnemonic
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(MnemonicEntropySize)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// derive the private key of the account with the index from the mnemonic and its BIP-0039 password
+// following SLIP-0010 for ed25519 on the path m/44'/635'/0'/0'/index' (every level is hardened)
+func DerivePrivateKey(mnemonic, bip39Password string, index uint32) (crypto.Ed25519PrivateKey, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(normalizeMnemonic(mnemonic), bip39Password)
+	if err != nil {
+		return crypto.Ed25519PrivateKey{}, fmt.Errorf("invalid mnemonic: %s", err.Error())
+	}
+	return derivePrivateKeyFromSeed(seed, []uint32{44, PocketCoinType, 0, 0, index})
+}
+
+// derive the ed25519 private key on the hardened path from the seed (SLIP-0010)
+func derivePrivateKeyFromSeed(seed []byte, path []uint32) (pk crypto.Ed25519PrivateKey, err error) {
+	mac := hmac.New(sha512.New, []byte(ed25519SeedModifier))
+	_, _ = mac.Write(seed)
+	i := mac.Sum(nil)
+	key, chainCode := i[:32], i[32:]
+	for _, index := range path {
+		if index >= hardenedKeyOffset {
+			return pk, fmt.Errorf("the index %d of the HD path is out of range", index)
+		}
+		data := make([]byte, 0, 37)
+		data = append(data, 0x00)
+		data = append(data, key...)
+		data = append(data, make([]byte, 4)...)
+		binary.BigEndian.PutUint32(data[33:], index+hardenedKeyOffset)
+		mac = hmac.New(sha512.New, chainCode)
+		_, _ = mac.Write(data)
+		i = mac.Sum(nil)
+		key, chainCode = i[:32], i[32:]
+	}
+	copy(pk[:], ed25519.NewKeyFromSeed(key))
+	return pk, nil
+}
+
+// import the accounts with the indexes [firstIndex, firstIndex+count) derived from the mnemonic into the keybase,
+// accounts already in the keybase are skipped
+func ImportMnemonic(keybase kb.Keybase, mnemonic, bip39Password, encryptPassphrase string, firstIndex, count uint32) (kps []kb.KeyPair, err error) {
+	if count == 0 {
+		return nil, errors.New("the number of accounts to import must be positive")
+	}
+	for index := firstIndex; index < firstIndex+count; index++ {
+		pk, err := DerivePrivateKey(mnemonic, bip39Password, index)
+		if err != nil {
+			return nil, err
+		}
+		if kp, err := keybase.Get(sdk.Address(pk.PublicKey().Address())); err == nil {
+			kps = append(kps, kp)
+			continue
+		}
+		kp, err := keybase.ImportPrivateKeyObject([64]byte(pk), encryptPassphrase)
+		if err != nil {
+			return nil, err
+		}
+		kps = append(kps, kp)
+	}
+	return kps, nil
+}
+
+// normalize the whitespace between the words of the mnemonic
+func normalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(mnemonic), " ")
+}
+
+// the encrypted backup of every account of the keybase
+type KeybaseBackup struct {
+	Version    string `json:"version"`
+	Kdf        string `json:"kdf"`
+	Salt       string `json:"salt"`
+	SecParam   string `json:"secparam"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// the accounts of the keybase backup, the keypairs stay encrypted with their own passphrases
+type keybaseBackupContent struct {
+	KeyPairs []keybaseBackupKeyPair `json:"keypairs"`
+	Multisig []MultisigAccount      `json:"multisig"`
+}
+
+// a keypair of the keybase backup
+type keybaseBackupKeyPair struct {
+	PublicKey    string `json:"pubkey"`
+	PrivKeyArmor string `json:"privkey.armor"`
+}
+
+// back up every account of the keybase and the multisig accounts of its store, encrypted with the backup passphrase
+func BackupKeybase(keybase kb.Keybase, keybaseName, dir, backupPassphrase string) (backup []byte, count int, err error) {
+	kps, err := keybase.List()
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(kps) == 0 {
+		return nil, 0, UninitializedKeybaseError
+	}
+	var content keybaseBackupContent
+	for _, kp := range kps {
+		content.KeyPairs = append(content.KeyPairs, keybaseBackupKeyPair{PublicKey: kp.PublicKey.RawString(), PrivKeyArmor: kp.PrivKeyArmor})
+	}
+	if content.Multisig, err = ListMultisigAccounts(keybaseName, dir); err != nil {
+		return nil, 0, err
+	}
+	plaintext, err := json.Marshal(content)
+	if err != nil {
+		return nil, 0, err
+	}
+	salt := tmcrypto.CRandBytes(keybaseBackupSaltSize)
+	key, err := scrypt.Key([]byte(backupPassphrase), salt, keybaseBackupScryptN, keybaseBackupScryptR, keybaseBackupScryptP, keybaseBackupKeyLength)
+	if err != nil {
+		return nil, 0, err
+	}
+	ciphertext, err := mintkey.EncryptAESGCM(key, plaintext)
+	if err != nil {
+		return nil, 0, err
+	}
+	backup, err = json.MarshalIndent(KeybaseBackup{
+		Version:    KeybaseBackupVersion,
+		Kdf:        KeybaseBackupKDF,
+		Salt:       hex.EncodeToString(salt),
+		SecParam:   strconv.Itoa(keybaseBackupScryptN),
+		Ciphertext: base64.StdEncoding.EncodeToString(ciphertext),
+	}, "", "  ")
+	return backup, len(content.KeyPairs) + len(content.Multisig), err
+}
+
+// restore every account of the keybase backup into the keybase and the multisig accounts into its store,
+// each keypair is decrypted with the passphrase of its address and keeps it; the accounts already in the keybase are
+// skipped, and a wrong passphrase or a different multisig account under the same name restores nothing
+func RestoreKeybase(keybase kb.Keybase, keybaseName, dir string, backup []byte, backupPassphrase string, passphrase func(addr sdk.Address) string) (restored int, err error) {
+	content, err := decryptKeybaseBackup(backup, backupPassphrase)
+	if err != nil {
+		return 0, err
+	}
+	// check every account before writing any
+	type restoreKeyPair struct {
+		armor, passphrase string
+	}
+	var kps []restoreKeyPair
+	for _, kp := range content.KeyPairs {
+		pubKey, err := crypto.NewPublicKey(kp.PublicKey)
+		if err != nil {
+			return 0, fmt.Errorf("invalid keybase backup: %s", err.Error())
+		}
+		addr := sdk.Address(pubKey.Address())
+		if _, err := keybase.Get(addr); err == nil {
+			continue
+		}
+		pass := passphrase(addr)
+		if _, err := mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, pass); err != nil {
+			return 0, fmt.Errorf("unable to decrypt the account %s of the keybase backup: %s", addr, err.Error())
+		}
+		kps = append(kps, restoreKeyPair{armor: kp.PrivKeyArmor, passphrase: pass})
+	}
+	existing, err := ListMultisigAccounts(keybaseName, dir)
+	if err != nil {
+		return 0, err
+	}
+	var mas []MultisigAccount
+	for _, ma := range content.Multisig {
+		if found, ok := findMultisigAccount(existing, ma.Name); ok {
+			if found.String() != ma.String() {
+				return 0, fmt.Errorf("cannot overwrite the multisig account named %s", ma.Name)
+			}
+			continue
+		}
+		mas = append(mas, ma)
+	}
+	for _, kp := range kps {
+		if _, err := keybase.ImportPrivKey(kp.armor, kp.passphrase, kp.passphrase); err != nil {
+			return restored, err
+		}
+		restored++
+	}
+	for _, ma := range mas {
+		if err := AddMultisigAccount(keybaseName, dir, ma); err != nil {
+			return restored, err
+		}
+		restored++
+	}
+	return restored, nil
+}
+
+// decrypt the accounts of the keybase backup with the backup passphrase
+func decryptKeybaseBackup(backup []byte, backupPassphrase string) (content keybaseBackupContent, err error) {
+	var kbb KeybaseBackup
+	if err := json.Unmarshal(backup, &kbb); err != nil {
+		return content, fmt.Errorf("invalid keybase backup: %s", err.Error())
+	}
+	if kbb.Version != KeybaseBackupVersion {
+		return content, fmt.Errorf("unrecognized keybase backup version: %s", kbb.Version)
+	}
+	if kbb.Kdf != KeybaseBackupKDF {
+		return content, fmt.Errorf("unrecognized KDF type: %s", kbb.Kdf)
+	}
+	n, err := strconv.Atoi(kbb.SecParam)
+	if err != nil {
+		return content, fmt.Errorf("invalid security parameter: %s", err.Error())
+	}
+	// the backup may not be trusted, so its cost can't exceed the one of the backups written by this version
+	if n <= 1 || n > keybaseBackupScryptN {
+		return content, fmt.Errorf("invalid security parameter: %d is out of the range (1, %d]", n, keybaseBackupScryptN)
+	}
+	salt, err := hex.DecodeString(kbb.Salt)
+	if err != nil || len(salt) == 0 {
+		return content, errors.New("missing or invalid salt bytes")
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(kbb.Ciphertext)
+	if err != nil {
+		return content, fmt.Errorf("error decoding ciphertext: %s", err.Error())
+	}
+	key, err := scrypt.Key([]byte(backupPassphrase), salt, n, keybaseBackupScryptR, keybaseBackupScryptP, keybaseBackupKeyLength)
+	if err != nil {
+		return content, err
+	}
+	plaintext, err := mintkey.DecryptAESGCM(key, ciphertext)
+	if err != nil {
+		return content, errors.New("unable to decrypt the keybase backup, the passphrase may be wrong")
+	}
+	if err := json.Unmarshal(plaintext, &content); err != nil {
+		return content, fmt.Errorf("invalid keybase backup: %s", err.Error())
+	}
+	return content, nil
+}
+
+// find the multisig account by name
+func findMultisigAccount(mas []MultisigAccount, name string) (MultisigAccount, bool) {
+	for _, ma := range mas {
+		if ma.Name == name {
+			return ma, true
+		}
+	}
+	return MultisigAccount{}, false
+}
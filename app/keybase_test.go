@@ -0,0 +1,162 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	kb "github.com/pokt-network/posmint/crypto/keys"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDerivePrivateKeyFromSeed(t *testing.T) {
+	// SLIP-0010 ed25519 test vector 1
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	tests := []struct {
+		path []uint32
+		key  string
+	}{
+		{[]uint32{}, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{[]uint32{0}, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{[]uint32{0, 1}, "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+	}
+	for _, tt := range tests {
+		pk, err := derivePrivateKeyFromSeed(seed, tt.path)
+		assert.Nil(t, err)
+		assert.Equal(t, tt.key, hex.EncodeToString(pk[:32]))
+	}
+	// ed25519 only supports hardened derivation
+	_, err := derivePrivateKeyFromSeed(seed, []uint32{hardenedKeyOffset})
+	assert.NotNil(t, err)
+}
+
+func TestDerivePrivateKey(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	assert.Nil(t, err)
+	assert.Len(t, strings.Fields(mnemonic), 24)
+	pk0, err := DerivePrivateKey(mnemonic, "", 0)
+	assert.Nil(t, err)
+	// the derivation is deterministic and ignores extra whitespace
+	again, err := DerivePrivateKey("  "+strings.Replace(mnemonic, " ", "\t ", -1)+"\n", "", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, pk0, again)
+	// the index and the BIP-0039 password derive different accounts
+	pk1, err := DerivePrivateKey(mnemonic, "", 1)
+	assert.Nil(t, err)
+	assert.NotEqual(t, pk0, pk1)
+	withPassword, err := DerivePrivateKey(mnemonic, "password", 0)
+	assert.Nil(t, err)
+	assert.NotEqual(t, pk0, withPassword)
+	// the derived key signs like any ed25519 key
+	sig, err := pk0.Sign([]byte("msg"))
+	assert.Nil(t, err)
+	assert.True(t, pk0.PublicKey().VerifyBytes([]byte("msg"), sig))
+	// an invalid mnemonic is rejected
+	_, err = DerivePrivateKey("not a valid mnemonic", "", 0)
+	assert.NotNil(t, err)
+}
+
+func TestImportMnemonic(t *testing.T) {
+	keybase := kb.NewInMemory()
+	mnemonic, err := NewMnemonic()
+	assert.Nil(t, err)
+	kps, err := ImportMnemonic(keybase, mnemonic, "", "test", 0, 3)
+	assert.Nil(t, err)
+	assert.Len(t, kps, 3)
+	list, err := keybase.List()
+	assert.Nil(t, err)
+	assert.Len(t, list, 3)
+	// recovering the mnemonic keeps the existing accounts and adds the new ones
+	recovered, err := ImportMnemonic(keybase, mnemonic, "", "other", 2, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, kps[2].GetAddress(), recovered[0].GetAddress())
+	list, err = keybase.List()
+	assert.Nil(t, err)
+	assert.Len(t, list, 4)
+	pk, err := DerivePrivateKey(mnemonic, "", 1)
+	assert.Nil(t, err)
+	exported, err := keybase.ExportPrivateKeyObject(kps[1].GetAddress(), "test")
+	assert.Nil(t, err)
+	assert.Equal(t, pk.RawBytes(), exported.RawBytes())
+	_, err = ImportMnemonic(keybase, mnemonic, "", "test", 0, 0)
+	assert.NotNil(t, err)
+}
+
+func TestBackupRestoreKeybase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keybase")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	src := kb.New("pocket-keybase", dir+"/src")
+	kp1, err := src.Create("pass1")
+	assert.Nil(t, err)
+	kp2, err := src.Create("pass2")
+	assert.Nil(t, err)
+	ma, err := NewMultisigAccount("dao", []string{kp1.PublicKey.RawString(), kp2.PublicKey.RawString()})
+	assert.Nil(t, err)
+	assert.Nil(t, AddMultisigAccount("pocket-keybase", dir+"/src", ma))
+	backup, n, err := BackupKeybase(src, "pocket-keybase", dir+"/src", "backup")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, n)
+	passphrases := map[string]string{kp1.GetAddress().String(): "pass1", kp2.GetAddress().String(): "pass2"}
+	passphrase := func(addr sdk.Address) string { return passphrases[addr.String()] }
+	dst := kb.New("pocket-keybase", dir+"/dst")
+	// a wrong backup passphrase doesn't restore anything
+	_, err = RestoreKeybase(dst, "pocket-keybase", dir+"/dst", backup, "wrong", passphrase)
+	assert.NotNil(t, err)
+	// nor does a wrong passphrase of an account
+	_, err = RestoreKeybase(dst, "pocket-keybase", dir+"/dst", backup, "backup", func(addr sdk.Address) string { return "pass1" })
+	assert.NotNil(t, err)
+	kps, err := dst.List()
+	assert.Nil(t, err)
+	assert.Empty(t, kps)
+	restored, err := RestoreKeybase(dst, "pocket-keybase", dir+"/dst", backup, "backup", passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, restored)
+	// every key round-trips with its own passphrase
+	for _, kp := range []struct {
+		kp   kb.KeyPair
+		pass string
+	}{{kp1, "pass1"}, {kp2, "pass2"}} {
+		want, err := src.ExportPrivateKeyObject(kp.kp.GetAddress(), kp.pass)
+		assert.Nil(t, err)
+		got, err := dst.ExportPrivateKeyObject(kp.kp.GetAddress(), kp.pass)
+		assert.Nil(t, err)
+		assert.Equal(t, want.RawBytes(), got.RawBytes())
+	}
+	// and so does the multisig account
+	got, err := GetMultisigAccount("pocket-keybase", dir+"/dst", "dao")
+	assert.Nil(t, err)
+	assert.Equal(t, ma, got)
+	// restoring again is a no-op
+	restored, err = RestoreKeybase(dst, "pocket-keybase", dir+"/dst", backup, "backup", passphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, restored)
+	// a different multisig account under the same name is never overwritten
+	assert.Nil(t, DeleteMultisigAccount("pocket-keybase", dir+"/src", "dao"))
+	other, err := NewMultisigAccount("dao", []string{kp2.PublicKey.RawString(), kp1.PublicKey.RawString()})
+	assert.Nil(t, err)
+	assert.Nil(t, AddMultisigAccount("pocket-keybase", dir+"/src", other))
+	changed, _, err := BackupKeybase(src, "pocket-keybase", dir+"/src", "backup")
+	assert.Nil(t, err)
+	_, err = RestoreKeybase(dst, "pocket-keybase", dir+"/dst", changed, "backup", passphrase)
+	assert.NotNil(t, err)
+	got, err = GetMultisigAccount("pocket-keybase", dir+"/dst", "dao")
+	assert.Nil(t, err)
+	assert.Equal(t, ma, got)
+	// the cost of the key derivation of an untrusted backup is capped
+	var kbb KeybaseBackup
+	assert.Nil(t, json.Unmarshal(backup, &kbb))
+	kbb.SecParam = strconv.Itoa(1 << 30)
+	costly, err := json.Marshal(kbb)
+	assert.Nil(t, err)
+	_, err = RestoreKeybase(dst, "pocket-keybase", dir+"/dst", costly, "backup", passphrase)
+	assert.NotNil(t, err)
+	// an empty keybase can't be backed up
+	_, _, err = BackupKeybase(kb.New("pocket-keybase", dir+"/empty"), "pocket-keybase", dir+"/empty", "backup")
+	assert.Equal(t, UninitializedKeybaseError, err)
+}
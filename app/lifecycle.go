@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/tendermint/tendermint/node"
+)
+
+// a part of the node started and stopped by the lifecycle, without a start (or stop) if nil
+type Component struct {
+	Name  string
+	Start func() error
+	Stop  func(ctx context.Context) error
+}
+
+// starts the components of the node in order and stops them in the reverse order, each stop within the stop timeout
+type Lifecycle struct {
+	components  []Component
+	started     int
+	stopTimeout time.Duration
+}
+
+func NewLifecycle(stopTimeout time.Duration, components ...Component) *Lifecycle {
+	if stopTimeout <= 0 {
+		stopTimeout = DefaultShutdownTimeout * time.Millisecond
+	}
+	return &Lifecycle{components: components, stopTimeout: stopTimeout}
+}
+
+// returns the components of the node, in their start order: the cache dbs, the tendermint node and the claim and proof
+// workers (stopped before the tendermint node they send their transactions to)
+func NodeComponents(tmNode *node.Node) []Component {
+	return []Component{
+		{Name: "cache", Stop: func(_ context.Context) error {
+			types.CloseCache()
+			return nil
+		}},
+		{Name: "tendermint", Start: tmNode.Start, Stop: func(_ context.Context) error {
+			return tmNode.Stop()
+		}},
+		{Name: "workers", Stop: pocketcore.StopWorkers},
+	}
+}
+
+// starts the components in order, the components already started are stopped if one fails to start
+func (l *Lifecycle) Start() error {
+	for _, c := range l.components {
+		if c.Start != nil {
+			if err := c.Start(); err != nil {
+				err = fmt.Errorf("unable to start the %s: %s", c.Name, err.Error())
+				if stopErr := l.Stop(); stopErr != nil {
+					return fmt.Errorf("%s; %s", err.Error(), stopErr.Error())
+				}
+				return err
+			}
+		}
+		l.started++
+	}
+	return nil
+}
+
+// stops the started components in the reverse order, a component that fails (or times out) doesn't stop the others
+func (l *Lifecycle) Stop() error {
+	var errs []string
+	for ; l.started > 0; l.started-- {
+		c := l.components[l.started-1]
+		if c.Stop == nil {
+			continue
+		}
+		if err := l.stop(c); err != nil {
+			errs = append(errs, fmt.Sprintf("unable to stop the %s: %s", c.Name, err.Error()))
+		}
+	}
+	if len(errs) != 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (l *Lifecycle) stop(c Component) error {
+	ctx, cancel := context.WithTimeout(context.Background(), l.stopTimeout)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Stop(ctx)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("timed out after %s", l.stopTimeout)
+	}
+}
+
+// starts the components and runs until a signal or an error of a component, then stops them:
+// returns nil if stopped by a signal without errors, a second signal returns before the stop is done
+func (l *Lifecycle) Run(signals <-chan os.Signal, errs <-chan error) error {
+	if err := l.Start(); err != nil {
+		return err
+	}
+	var runErr error
+	select {
+	case sig := <-signals:
+		fmt.Printf("Exit signal %s received, stopping\n", sig)
+	case err := <-errs:
+		runErr = err
+	}
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- l.Stop()
+	}()
+	select {
+	case err := <-stopped:
+		if runErr != nil && err != nil {
+			return fmt.Errorf("%s; %s", runErr.Error(), err.Error())
+		}
+		if runErr != nil {
+			return runErr
+		}
+		return err
+	case sig := <-signals:
+		return fmt.Errorf("exit signal %s received while stopping", sig)
+	}
+}
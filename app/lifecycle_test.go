@@ -0,0 +1,79 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// returns a component recording its start and stop in the calls
+func recordedComponent(name string, calls *[]string, startErr error) Component {
+	return Component{
+		Name: name,
+		Start: func() error {
+			*calls = append(*calls, "start "+name)
+			return startErr
+		},
+		Stop: func(_ context.Context) error {
+			*calls = append(*calls, "stop "+name)
+			return nil
+		},
+	}
+}
+
+func TestLifecycle_StartStop(t *testing.T) {
+	var calls []string
+	l := NewLifecycle(time.Second, recordedComponent("a", &calls, nil), recordedComponent("b", &calls, nil), recordedComponent("c", &calls, nil))
+	assert.Nil(t, l.Start())
+	assert.Nil(t, l.Stop())
+	assert.Equal(t, []string{"start a", "start b", "start c", "stop c", "stop b", "stop a"}, calls)
+	// the components are only stopped once
+	assert.Nil(t, l.Stop())
+	assert.Len(t, calls, 6)
+}
+
+func TestLifecycle_StartError(t *testing.T) {
+	var calls []string
+	l := NewLifecycle(time.Second, recordedComponent("a", &calls, nil), recordedComponent("b", &calls, errors.New("in use")), recordedComponent("c", &calls, nil))
+	err := l.Start()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unable to start the b: in use")
+	assert.Equal(t, []string{"start a", "start b", "stop a"}, calls)
+}
+
+func TestLifecycle_StopTimeout(t *testing.T) {
+	var calls []string
+	release := make(chan struct{})
+	defer close(release)
+	stuck := Component{Name: "stuck", Stop: func(_ context.Context) error {
+		<-release
+		return nil
+	}}
+	l := NewLifecycle(50*time.Millisecond, recordedComponent("a", &calls, nil), stuck)
+	assert.Nil(t, l.Start())
+	err := l.Stop()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unable to stop the stuck: timed out")
+	// the next components are stopped anyway
+	assert.Equal(t, []string{"start a", "stop a"}, calls)
+}
+
+func TestLifecycle_Run(t *testing.T) {
+	var calls []string
+	l := NewLifecycle(time.Second, recordedComponent("a", &calls, nil))
+	signals := make(chan os.Signal, 1)
+	signals <- syscall.SIGTERM
+	assert.Nil(t, l.Run(signals, nil))
+	assert.Equal(t, []string{"start a", "stop a"}, calls)
+	// an error of a component stops the others
+	calls = nil
+	errs := make(chan error, 1)
+	errs <- errors.New("closed")
+	assert.Equal(t, errors.New("closed"), NewLifecycle(time.Second, recordedComponent("a", &calls, nil)).Run(nil, errs))
+	assert.Equal(t, []string{"start a", "stop a"}, calls)
+}
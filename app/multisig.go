@@ -0,0 +1,383 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/pokt-network/posmint/crypto/keys"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+)
+
+const (
+	MultisigKeybaseSuffix = "-multisig" // the suffix of the store of the multisig accounts, next to the keybase store
+	MultisigMemberSigned  = "signed"    // the status of a member that signed the multisig transaction
+	MultisigMemberPending = "pending"   // the status of a member that didn't sign the multisig transaction yet
+	MultisigMemberInvalid = "invalid"   // the status of a member whose signature doesn't verify
+)
+
+// a named multisig account of the keybase
+type MultisigAccount struct {
+	Name       string   `json:"name"`
+	PublicKeys []string `json:"public_keys"` // the ordered hex public keys of the members
+}
+
+// create a multisig account from the ordered hex public keys of its members;
+// the multisig public keys of the protocol verify the signature of every member, so every member signs
+func NewMultisigAccount(name string, publicKeys []string) (MultisigAccount, error) {
+	ma := MultisigAccount{Name: strings.TrimSpace(name), PublicKeys: publicKeys}
+	return ma, ma.Validate()
+}
+
+// validate the name and the members of the multisig account
+func (ma MultisigAccount) Validate() error {
+	if ma.Name == "" {
+		return errors.New("the name of the multisig account is empty")
+	}
+	if _, err := sdk.AddressFromHex(ma.Name); err == nil {
+		return errors.New("the name of the multisig account can't be an address")
+	}
+	if len(ma.PublicKeys) < 2 {
+		return errors.New("a multisig account must have at least two members")
+	}
+	seen := make(map[string]struct{}, len(ma.PublicKeys))
+	for _, pk := range ma.PublicKeys {
+		p, err := crypto.NewPublicKey(pk)
+		if err != nil {
+			return fmt.Errorf("invalid member public key %s: %s", pk, err.Error())
+		}
+		if _, ok := seen[p.RawString()]; ok {
+			return fmt.Errorf("duplicate member public key %s", pk)
+		}
+		seen[p.RawString()] = struct{}{}
+	}
+	return nil
+}
+
+// the multisig public key of the account
+func (ma MultisigAccount) PublicKey() (crypto.PublicKeyMultiSignature, error) {
+	pks := make([]crypto.PublicKey, 0, len(ma.PublicKeys))
+	for _, pk := range ma.PublicKeys {
+		p, err := crypto.NewPublicKey(pk)
+		if err != nil {
+			return crypto.PublicKeyMultiSignature{}, err
+		}
+		pks = append(pks, p)
+	}
+	return crypto.PublicKeyMultiSignature{PublicKeys: pks}, nil
+}
+
+// the address of the multisig account
+func (ma MultisigAccount) Address() (sdk.Address, error) {
+	pk, err := ma.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return sdk.Address(pk.Address()), nil
+}
+
+func (ma MultisigAccount) String() string {
+	addr, _ := ma.Address()
+	return fmt.Sprintf("Name: %s\nAddress: %s\nMembers:\n  %s\n",
+		ma.Name, addr, strings.Join(ma.PublicKeys, "\n  "))
+}
+
+// add the multisig account to the store of the keybase, names are unique
+func AddMultisigAccount(keybaseName, dir string, ma MultisigAccount) error {
+	if err := ma.Validate(); err != nil {
+		return err
+	}
+	db, err := sdk.NewLevelDB(keybaseName+MultisigKeybaseSuffix, dir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if db.Has([]byte(ma.Name)) {
+		return fmt.Errorf("a multisig account named %s already exists", ma.Name)
+	}
+	bz, err := json.Marshal(ma)
+	if err != nil {
+		return err
+	}
+	db.SetSync([]byte(ma.Name), bz)
+	return nil
+}
+
+// get the multisig account by name or by address from the store of the keybase
+func GetMultisigAccount(keybaseName, dir, nameOrAddress string) (MultisigAccount, error) {
+	mas, err := ListMultisigAccounts(keybaseName, dir)
+	if err != nil {
+		return MultisigAccount{}, err
+	}
+	for _, ma := range mas {
+		if ma.Name == nameOrAddress {
+			return ma, nil
+		}
+		if addr, err := ma.Address(); err == nil && addr.String() == strings.ToLower(nameOrAddress) {
+			return ma, nil
+		}
+	}
+	return MultisigAccount{}, fmt.Errorf("the multisig account %s is not in the keybase", nameOrAddress)
+}
+
+// list the multisig accounts of the store of the keybase by name
+func ListMultisigAccounts(keybaseName, dir string) (mas []MultisigAccount, err error) {
+	db, err := sdk.NewLevelDB(keybaseName+MultisigKeybaseSuffix, dir)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	iter := db.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var ma MultisigAccount
+		if err := json.Unmarshal(iter.Value(), &ma); err != nil {
+			return nil, err
+		}
+		mas = append(mas, ma)
+	}
+	return mas, nil
+}
+
+// delete the multisig account from the store of the keybase, the keys of its members are untouched
+func DeleteMultisigAccount(keybaseName, dir, name string) error {
+	db, err := sdk.NewLevelDB(keybaseName+MultisigKeybaseSuffix, dir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if !db.Has([]byte(name)) {
+		return fmt.Errorf("the multisig account %s is not in the keybase", name)
+	}
+	db.DeleteSync([]byte(name))
+	return nil
+}
+
+// the partial signature file of a multisig transaction, exchanged between the members until every member signed
+type MultisigTx struct {
+	Account    MultisigAccount `json:"account"`
+	ChainID    string          `json:"chain_id"`
+	Tx         auth.StdTx      `json:"tx"`
+	Signatures []string        `json:"signatures"` // the hex signatures by member index, empty until the member signs
+}
+
+// the status of a member of a multisig transaction
+type MultisigMemberStatus struct {
+	PublicKey string `json:"public_key"`
+	Address   string `json:"address"`
+	Status    string `json:"status"`
+}
+
+// the collected signatures of a multisig transaction
+type MultisigTxStatus struct {
+	Account  string                 `json:"account"`
+	Address  string                 `json:"address"`
+	Signed   int                    `json:"signed"`
+	Members  []MultisigMemberStatus `json:"members"`
+	Complete bool                   `json:"complete"`
+}
+
+func (s MultisigTxStatus) String() string {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "Account: %s (%s)\nSignatures: %d of %d\n", s.Account, s.Address, s.Signed, len(s.Members))
+	for i, m := range s.Members {
+		_, _ = fmt.Fprintf(&b, "  %d. %s %s\n", i, m.Address, m.Status)
+	}
+	if s.Complete {
+		b.WriteString("The transaction is ready to broadcast\n")
+	}
+	return b.String()
+}
+
+// build the unsigned multisig transaction of the amino json message, the multisig account must be its only signer;
+// the chain id, entropy and fee follow BuildUnsignedTx
+func BuildMultisigTx(ma MultisigAccount, jsonMessage, chainID string, entropy, fee int64, memo string, offline bool) ([]byte, error) {
+	pk, err := ma.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	tx, chainID, err := newUnsignedTx(jsonMessage, chainID, entropy, fee, memo, offline)
+	if err != nil {
+		return nil, err
+	}
+	if signers := tx.GetSigners(); len(signers) != 1 || !signers[0].Equals(sdk.Address(pk.Address())) {
+		return nil, fmt.Errorf("the multisig account %s must be the only signer of the message", ma.Name)
+	}
+	tx.Signatures = []auth.StdSignature{{PublicKey: pk}}
+	return Codec().MarshalJSONIndent(MultisigTx{Account: ma, ChainID: chainID, Tx: tx, Signatures: make([]string, len(ma.PublicKeys))}, "", "  ")
+}
+
+// sign the multisig transaction with the key of the member address from the keybase, without network access;
+// returns the partial signature file and, once every member signed, the hex encoded transaction bytes to broadcast
+func SignMultisigTx(fromAddr, passphrase string, multisigTx []byte) (signedTx []byte, txHex string, err error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, "", err
+	}
+	keybase, err := GetKeybase()
+	if err != nil {
+		return nil, "", err
+	}
+	return signMultisigTx(keybase, fa, passphrase, multisigTx)
+}
+
+func signMultisigTx(keybase keys.Keybase, fa sdk.Address, passphrase string, multisigTx []byte) (signedTx []byte, txHex string, err error) {
+	mtx, pk, err := decodeMultisigTx(multisigTx)
+	if err != nil {
+		return nil, "", err
+	}
+	index := -1
+	for i, member := range pk.Keys() {
+		if sdk.Address(member.Address()).Equals(fa) {
+			index = i
+		}
+	}
+	if index == -1 {
+		return nil, "", fmt.Errorf("the address %s is not a member of the multisig account %s", fa, mtx.Account.Name)
+	}
+	sig, _, err := keybase.Sign(fa, passphrase, mtx.signBytes())
+	if err != nil {
+		return nil, "", err
+	}
+	mtx.Signatures[index] = hex.EncodeToString(sig)
+	return mtx.encode()
+}
+
+// merge the signatures of the partial signature files of the same multisig transaction
+// returns the merged file and, once every member signed, the hex encoded transaction bytes to broadcast
+func MergeMultisigTxs(multisigTxs ...[]byte) (mergedTx []byte, txHex string, err error) {
+	if len(multisigTxs) == 0 {
+		return nil, "", errors.New("no multisig transaction to merge")
+	}
+	merged, _, err := decodeMultisigTx(multisigTxs[0])
+	if err != nil {
+		return nil, "", err
+	}
+	for _, bz := range multisigTxs[1:] {
+		mtx, _, err := decodeMultisigTx(bz)
+		if err != nil {
+			return nil, "", err
+		}
+		if mtx.ChainID != merged.ChainID || string(mtx.signBytes()) != string(merged.signBytes()) ||
+			!mtx.Tx.Signatures[0].PublicKey.Equals(merged.Tx.Signatures[0].PublicKey) {
+			return nil, "", errors.New("the multisig transactions to merge are different transactions")
+		}
+		for i, sig := range mtx.Signatures {
+			if sig == "" {
+				continue
+			}
+			if merged.Signatures[i] != "" && merged.Signatures[i] != sig {
+				return nil, "", fmt.Errorf("conflicting signatures of the member %d", i)
+			}
+			merged.Signatures[i] = sig
+		}
+	}
+	return merged.encode()
+}
+
+// report the members that signed the multisig transaction and the members it's waiting on
+func GetMultisigTxStatus(multisigTx []byte) (MultisigTxStatus, error) {
+	mtx, pk, err := decodeMultisigTx(multisigTx)
+	if err != nil {
+		return MultisigTxStatus{}, err
+	}
+	return mtx.status(pk), nil
+}
+
+// decode the partial signature file, checking its signatures match the members of the multisig account
+func decodeMultisigTx(multisigTx []byte) (mtx MultisigTx, pk crypto.PublicKeyMultiSignature, err error) {
+	if err := Codec().UnmarshalJSON(multisigTx, &mtx); err != nil {
+		return mtx, pk, err
+	}
+	if err := mtx.Account.Validate(); err != nil {
+		return mtx, pk, err
+	}
+	pk, err = mtx.Account.PublicKey()
+	if err != nil {
+		return mtx, pk, err
+	}
+	if len(mtx.Tx.Signatures) != 1 || mtx.Tx.Signatures[0].PublicKey == nil || !pk.Equals(mtx.Tx.Signatures[0].PublicKey) {
+		return mtx, pk, fmt.Errorf("the transaction isn't signed by the multisig account %s", mtx.Account.Name)
+	}
+	if len(mtx.Signatures) != len(pk.Keys()) {
+		return mtx, pk, fmt.Errorf("the transaction has %d signatures for %d members", len(mtx.Signatures), len(pk.Keys()))
+	}
+	return mtx, pk, nil
+}
+
+// the bytes every member signs
+func (mtx MultisigTx) signBytes() []byte {
+	return auth.StdSignBytes(mtx.ChainID, mtx.Tx.Entropy, mtx.Tx.Fee, mtx.Tx.Msgs, mtx.Tx.Memo)
+}
+
+func (mtx MultisigTx) status(pk crypto.PublicKeyMultiSignature) MultisigTxStatus {
+	s := MultisigTxStatus{
+		Account: mtx.Account.Name,
+		Address: sdk.Address(pk.Address()).String(),
+	}
+	signBytes := mtx.signBytes()
+	for i, member := range pk.Keys() {
+		ms := MultisigMemberStatus{PublicKey: member.RawString(), Address: sdk.Address(member.Address()).String(), Status: MultisigMemberPending}
+		if mtx.Signatures[i] != "" {
+			ms.Status = MultisigMemberInvalid
+			if sig, err := hex.DecodeString(mtx.Signatures[i]); err == nil && member.VerifyBytes(signBytes, sig) {
+				ms.Status = MultisigMemberSigned
+				s.Signed++
+			}
+		}
+		s.Members = append(s.Members, ms)
+	}
+	// every member signs the transactions of a multisig account
+	s.Complete = s.Signed == len(s.Members)
+	return s
+}
+
+// encode the partial signature file and, once complete, the transaction bytes with the multi signature
+func (mtx MultisigTx) encode() (bz []byte, txHex string, err error) {
+	bz, err = Codec().MarshalJSONIndent(mtx, "", "  ")
+	if err != nil {
+		return nil, "", err
+	}
+	pk, err := mtx.Account.PublicKey()
+	if err != nil {
+		return nil, "", err
+	}
+	status := mtx.status(pk)
+	for i, m := range status.Members {
+		if m.Status == MultisigMemberInvalid {
+			return nil, "", fmt.Errorf("the signature of the member %d doesn't verify", i)
+		}
+	}
+	if !status.Complete {
+		// waiting on the other members
+		return bz, "", nil
+	}
+	txHex, err = mtx.txHex()
+	return bz, txHex, err
+}
+
+// the hex encoded transaction bytes with the multi signature of every member
+func (mtx MultisigTx) txHex() (string, error) {
+	ms := crypto.MultiSignature{Sigs: make([][]byte, 0, len(mtx.Signatures))}
+	for _, s := range mtx.Signatures {
+		sig, err := hex.DecodeString(s)
+		if err != nil {
+			return "", err
+		}
+		ms.Sigs = append(ms.Sigs, sig)
+	}
+	tx := mtx.Tx
+	tx.Signatures = []auth.StdSignature{{PublicKey: tx.Signatures[0].PublicKey, Signature: ms.Marshal()}}
+	if err := tx.ValidateBasic(); err != nil {
+		return "", err
+	}
+	txBytes, err := auth.DefaultTxEncoder(Codec())(tx)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(txBytes), nil
+}
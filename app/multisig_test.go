@@ -0,0 +1,161 @@
+package app
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/nodes"
+	types2 "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/posmint/crypto"
+	kb "github.com/pokt-network/posmint/crypto/keys"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+func TestMultisigAccounts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keybase")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	pks := []string{crypto.GenerateEd25519PrivKey().PublicKey().RawString(), crypto.GenerateEd25519PrivKey().PublicKey().RawString()}
+	// a multisig account has distinct members, at least two
+	_, err = NewMultisigAccount("dao", pks[:1])
+	assert.NotNil(t, err)
+	_, err = NewMultisigAccount("dao", []string{pks[0], pks[0]})
+	assert.NotNil(t, err)
+	ma, err := NewMultisigAccount("dao", pks)
+	assert.Nil(t, err)
+	assert.Nil(t, AddMultisigAccount("pocket-keybase", dir, ma))
+	assert.NotNil(t, AddMultisigAccount("pocket-keybase", dir, ma))
+	// found by name and by address
+	got, err := GetMultisigAccount("pocket-keybase", dir, "dao")
+	assert.Nil(t, err)
+	assert.Equal(t, ma, got)
+	addr, err := ma.Address()
+	assert.Nil(t, err)
+	got, err = GetMultisigAccount("pocket-keybase", dir, addr.String())
+	assert.Nil(t, err)
+	assert.Equal(t, ma, got)
+	mas, err := ListMultisigAccounts("pocket-keybase", dir)
+	assert.Nil(t, err)
+	assert.Len(t, mas, 1)
+	assert.Nil(t, DeleteMultisigAccount("pocket-keybase", dir, "dao"))
+	_, err = GetMultisigAccount("pocket-keybase", dir, "dao")
+	assert.NotNil(t, err)
+	assert.NotNil(t, DeleteMultisigAccount("pocket-keybase", dir, "dao"))
+}
+
+func TestMultisigTx(t *testing.T) {
+	keybase := kb.NewInMemory()
+	var members []kb.KeyPair
+	var pks []string
+	for i := 0; i < 3; i++ {
+		kp, err := keybase.Create("test")
+		assert.Nil(t, err)
+		members = append(members, kp)
+		pks = append(pks, kp.PublicKey.RawString())
+	}
+	ma, err := NewMultisigAccount("dao", pks)
+	assert.Nil(t, err)
+	pk, err := ma.PublicKey()
+	assert.Nil(t, err)
+	addr := sdk.Address(pk.Address())
+	// the multisig account must be the signer of the message
+	msg, err := Codec().MarshalJSON(types2.MsgSend{FromAddress: members[0].GetAddress(), ToAddress: addr, Amount: sdk.NewInt(1)})
+	assert.Nil(t, err)
+	_, err = BuildMultisigTx(ma, string(msg), "pocket-test", 0, 0, "", true)
+	assert.NotNil(t, err)
+	msg, err = Codec().MarshalJSON(types2.MsgSend{FromAddress: addr, ToAddress: members[0].GetAddress(), Amount: sdk.NewInt(1)})
+	assert.Nil(t, err)
+	unsigned, err := BuildMultisigTx(ma, string(msg), "pocket-test", 0, 0, "", true)
+	assert.Nil(t, err)
+	status, err := GetMultisigTxStatus(unsigned)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, status.Signed)
+	assert.False(t, status.Complete)
+	// only the members sign
+	other, err := keybase.Create("test")
+	assert.Nil(t, err)
+	_, _, err = signMultisigTx(keybase, other.GetAddress(), "test", unsigned)
+	assert.NotNil(t, err)
+	// the members sign copies of the file out of order, then the copies are merged
+	last, txHex, err := signMultisigTx(keybase, members[2].GetAddress(), "test", unsigned)
+	assert.Nil(t, err)
+	assert.Empty(t, txHex)
+	first, _, err := signMultisigTx(keybase, members[0].GetAddress(), "test", unsigned)
+	assert.Nil(t, err)
+	merged, txHex, err := MergeMultisigTxs(last, first)
+	assert.Nil(t, err)
+	assert.Empty(t, txHex)
+	status, err = GetMultisigTxStatus(merged)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, status.Signed)
+	assert.Equal(t, MultisigMemberSigned, status.Members[0].Status)
+	assert.Equal(t, MultisigMemberPending, status.Members[1].Status)
+	assert.Equal(t, MultisigMemberSigned, status.Members[2].Status)
+	// a different transaction isn't merged
+	different, err := BuildMultisigTx(ma, string(msg), "pocket-test", 0, 0, "", true)
+	assert.Nil(t, err)
+	_, _, err = MergeMultisigTxs(merged, different)
+	assert.NotNil(t, err)
+	// the last signature completes the transaction
+	complete, txHex, err := signMultisigTx(keybase, members[1].GetAddress(), "test", merged)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, txHex)
+	status, err = GetMultisigTxStatus(complete)
+	assert.Nil(t, err)
+	assert.True(t, status.Complete)
+	txBz, err := hex.DecodeString(txHex)
+	assert.Nil(t, err)
+	tx, err := types.DefaultTxDecoder(Codec())(txBz)
+	assert.Nil(t, err)
+	stdTx := tx.(types.StdTx)
+	signBytes := types.StdSignBytes("pocket-test", stdTx.Entropy, stdTx.Fee, stdTx.Msgs, stdTx.Memo)
+	assert.True(t, pk.VerifyBytes(signBytes, stdTx.Signatures[0].Signature))
+}
+
+func TestSendMultisigTx(t *testing.T) {
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	ma, err := NewMultisigAccount("dao", []string{cb.PublicKey.RawString(), kp.PublicKey.RawString()})
+	assert.Nil(t, err)
+	addr, err := ma.Address()
+	assert.Nil(t, err)
+	memCli, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	select {
+	case <-evtChan:
+		memCli, stopCli, evtChan = subscribeTo(t, tmTypes.EventTx)
+		_, err := nodes.Send(memCodec(), memCli, kb, cb.GetAddress(), addr, "test", sdk.NewInt(1000000))
+		assert.Nil(t, err)
+	}
+	select {
+	case <-evtChan:
+		msg, err := Codec().MarshalJSON(types2.MsgSend{FromAddress: addr, ToAddress: kp.GetAddress(), Amount: sdk.NewInt(1000)})
+		assert.Nil(t, err)
+		unsigned, err := BuildMultisigTx(ma, string(msg), "pocket-test", 0, 0, "", true)
+		assert.Nil(t, err)
+		partial, _, err := signMultisigTx(kb, kp.GetAddress(), "test", unsigned)
+		assert.Nil(t, err)
+		_, txHex, err := signMultisigTx(kb, cb.GetAddress(), "test", partial)
+		assert.Nil(t, err)
+		txBz, err := hex.DecodeString(txHex)
+		assert.Nil(t, err)
+		txResp, err := nodes.RawTx(memCodec(), memCli, addr, txBz)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(0), txResp.Code)
+	}
+	select {
+	case <-evtChan:
+		balance, err := nodes.QueryAccountBalance(memCodec(), memCli, kp.GetAddress(), 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1000), balance.Int64())
+	}
+	cleanup()
+	stopCli()
+}
@@ -110,6 +110,7 @@ var (
 		auth.FeeCollectorName:     {auth.Burner, auth.Minter, auth.Staking},
 		nodesTypes.StakedPoolName: {auth.Burner, auth.Minter, auth.Staking},
 		appsTypes.StakedPoolName:  {auth.Burner, auth.Minter, auth.Staking},
+		appsTypes.EscrowPoolName:  nil,
 		govTypes.DAOAccountName:   {auth.Burner, auth.Minter, auth.Staking},
 		nodesTypes.ModuleName:     {auth.Burner, auth.Minter, auth.Staking},
 		appsTypes.ModuleName:      nil,
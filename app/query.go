@@ -2,6 +2,8 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	apps "github.com/pokt-network/pocket-core/x/apps"
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
@@ -73,6 +75,30 @@ func QueryNode(addr string, height int64) (validator nodesTypes.Validator, err e
 	return nodes.QueryValidator(Codec(), getTMClient(), a, height)
 }
 
+func QueryNodeDelegations(addr string, height int64) (nodesTypes.Delegations, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.QueryValidatorDelegations(Codec(), getTMClient(), a, height)
+}
+
+func QueryDelegations(addr string, height int64) (nodesTypes.Delegations, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.QueryDelegatorDelegations(Codec(), getTMClient(), a, height)
+}
+
+func QueryUnbondingDelegations(addr string, height int64) (nodesTypes.UnbondingDelegations, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.QueryUnbondingDelegations(Codec(), getTMClient(), a, height)
+}
+
 func QueryNodeParams(height int64) (params nodesTypes.Params, err error) {
 	return nodes.QueryPOSParams(Codec(), getTMClient(), height)
 }
@@ -125,6 +151,44 @@ func QueryAppParams(height int64) (params appsTypes.Params, err error) {
 	return apps.QueryPOSParams(Codec(), getTMClient(), height)
 }
 
+func QueryAppRelays(stake sdk.Int, height int64) (maxRelays sdk.Int, err error) {
+	return apps.QueryAppRelays(Codec(), getTMClient(), height, stake)
+}
+
+func QueryAppEscrow(addr string, height int64) (escrow appsTypes.AppEscrow, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return escrow, err
+	}
+	return apps.QueryAppEscrow(Codec(), getTMClient(), a, height)
+}
+
+func QueryAppUsage(addr string, sessionBlockHeight, height int64) (usages []pocketTypes.AppUsage, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	return pocket.QueryAppUsage(Codec(), getTMClient(), a, sessionBlockHeight, height)
+}
+
+// simulates a param change against the state at height without committing it, reporting the affected nodes and apps
+func QueryParamChange(paramACLKey string, paramValue []byte, height int64) (report fmt.Stringer, err error) {
+	if !strings.Contains(paramACLKey, types.ACLKeySep) {
+		return nil, fmt.Errorf("invalid param key %s, must be module/param", paramACLKey)
+	}
+	module, key := types.SplitACLKey(paramACLKey)
+	switch module {
+	case nodesTypes.ModuleName:
+		return nodes.QueryParamChange(Codec(), getTMClient(), height, key, paramValue)
+	case appsTypes.ModuleName:
+		return apps.QueryParamChange(Codec(), getTMClient(), height, key, paramValue)
+	case pocketTypes.ModuleName:
+		return pocket.QueryParamChange(Codec(), getTMClient(), height, key, paramValue)
+	default:
+		return nil, fmt.Errorf("the params of module %s can't be simulated", module)
+	}
+}
+
 func QueryReceipts(addr string, height int64) (proofs []pocketTypes.Receipt, err error) {
 	a, err := sdk.AddressFromHex(addr)
 	if err != nil {
@@ -145,10 +209,48 @@ func QueryPocketSupportedBlockchains(height int64) ([]string, error) {
 	return pocket.QueryPocketSupportedBlockchains(Codec(), getTMClient(), height)
 }
 
+func QueryChainRegistry(height int64) (pocketTypes.ChainRegistry, error) {
+	return pocket.QueryChainRegistry(Codec(), getTMClient(), height)
+}
+
 func QueryPocketParams(height int64) (pocketTypes.Params, error) {
 	return pocket.QueryParams(Codec(), getTMClient(), height)
 }
 
+// returns the fee of the message from the params of its module, or the fee of the message for modules without fee params
+func QueryMessageFee(msg sdk.Msg, height int64) (sdk.Int, error) {
+	var fees interface {
+		Fee(msgType string) (int64, bool)
+	}
+	switch msg.Route() {
+	case nodesTypes.RouterKey:
+		params, err := QueryNodeParams(height)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		fees = params.MessageFees
+	case appsTypes.RouterKey:
+		params, err := QueryAppParams(height)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		fees = params.MessageFees
+	case pocketTypes.RouterKey:
+		params, err := QueryPocketParams(height)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		fees = params.MessageFees
+	default:
+		return msg.GetFee(), nil
+	}
+	fee, ok := fees.Fee(msg.Type())
+	if !ok {
+		return msg.GetFee(), nil
+	}
+	return sdk.NewInt(fee), nil
+}
+
 func QueryRelay(r pocketTypes.Relay) (*pocketTypes.RelayResponse, error) {
 	return pocket.QueryRelay(Codec(), getTMClient(), r)
 }
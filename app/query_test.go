@@ -321,7 +321,8 @@ func TestQueryPocketParams(t *testing.T) {
 		assert.Equal(t, int64(5), got.SessionNodeCount)
 		assert.Equal(t, int64(3), got.ClaimSubmissionWindow)
 		assert.Equal(t, int64(100), got.ClaimExpiration)
-		assert.Contains(t, got.SupportedBlockchains, PlaceholderHash)
+		assert.Equal(t, int64(100), got.AppUsageRetention)
+		assert.Contains(t, got.ChainRegistry.SupportedBlockchains(), PlaceholderHash)
 	}
 	cleanup()
 	stopCli()
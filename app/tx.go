@@ -1,13 +1,24 @@
 package app
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
 	apps "github.com/pokt-network/pocket-core/x/apps"
+	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/nodes"
 	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto/keys"
 	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	authTypes "github.com/pokt-network/posmint/x/auth/types"
 	"github.com/pokt-network/posmint/x/gov"
 	"github.com/pokt-network/posmint/x/gov/types"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 func SendTransaction(fromAddr, toAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
@@ -33,7 +44,49 @@ func SendRawTx(fromAddr string, txBytes []byte) (sdk.TxResponse, error) {
 	return nodes.RawTx(Codec(), getTMClient(), fa, txBytes)
 }
 
-func StakeNode(chains []string, serviceUrl, fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+func StakeNode(chains []string, serviceUrl, fromAddr, passphrase string, amount sdk.Int, commissionRate int64, outputAddr, outputPassphrase, currentOutputAddr, currentOutputPassphrase string) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kp, err := (MustGetKeybase()).Get(fa)
+	if err != nil {
+		return nil, err
+	}
+	for _, chain := range chains {
+		err := pocketTypes.NetworkIdentifierVerification(chain)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if amount.LTE(sdk.NewInt(0)) {
+		return nil, sdk.ErrInternal("must stake above zero")
+	}
+	err = nodesTypes.ValidateServiceURL(serviceUrl)
+	if err != nil {
+		return nil, err
+	}
+	if commissionRate < 0 || commissionRate > 100 {
+		return nil, nodesTypes.ErrInvalidCommissionRate(nodesTypes.ModuleName)
+	}
+	var output sdk.Address
+	if outputAddr != "" {
+		output, err = sdk.AddressFromHex(outputAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var currentOutput sdk.Address
+	if currentOutputAddr != "" {
+		currentOutput, err = sdk.AddressFromHex(currentOutputAddr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nodes.StakeWithOutputTx(Codec(), getTMClient(), MustGetKeybase(), chains, serviceUrl, amount, commissionRate, kp, passphrase, output, outputPassphrase, currentOutput, currentOutputPassphrase)
+}
+
+func EditStakeNode(chains []string, serviceUrl, fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
@@ -55,7 +108,23 @@ func StakeNode(chains []string, serviceUrl, fromAddr, passphrase string, amount
 	if err != nil {
 		return nil, err
 	}
-	return nodes.StakeTx(Codec(), getTMClient(), MustGetKeybase(), chains, serviceUrl, amount, kp, passphrase)
+	return nodes.EditStakeTx(Codec(), getTMClient(), MustGetKeybase(), chains, serviceUrl, amount, kp, passphrase)
+}
+
+func RotateServicerKey(fromAddr, passphrase, servicerAddr, servicerPassphrase string) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	sa, err := sdk.AddressFromHex(servicerAddr)
+	if err != nil {
+		return nil, err
+	}
+	servicer, err := (MustGetKeybase()).Get(sa)
+	if err != nil {
+		return nil, err
+	}
+	return nodes.RotateServicerKeyTx(Codec(), getTMClient(), MustGetKeybase(), fa, passphrase, servicer, servicerPassphrase)
 }
 
 func UnstakeNode(fromAddr, passphrase string) (*sdk.TxResponse, error) {
@@ -66,6 +135,17 @@ func UnstakeNode(fromAddr, passphrase string) (*sdk.TxResponse, error) {
 	return nodes.UnstakeTx(Codec(), getTMClient(), MustGetKeybase(), fa, passphrase)
 }
 
+func PartialUnstakeNode(fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	if amount.LTE(sdk.ZeroInt()) {
+		return nil, sdk.ErrInternal("must unstake above zero")
+	}
+	return nodes.PartialUnstakeTx(Codec(), getTMClient(), MustGetKeybase(), fa, passphrase, amount)
+}
+
 func UnjailNode(fromAddr, passphrase string) (*sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -74,6 +154,36 @@ func UnjailNode(fromAddr, passphrase string) (*sdk.TxResponse, error) {
 	return nodes.UnjailTx(Codec(), getTMClient(), MustGetKeybase(), fa, passphrase)
 }
 
+func DelegateToNode(fromAddr, validatorAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	va, err := sdk.AddressFromHex(validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	if amount.LTE(sdk.ZeroInt()) {
+		return nil, sdk.ErrInternal("must delegate above zero")
+	}
+	return nodes.DelegateTx(Codec(), getTMClient(), MustGetKeybase(), fa, va, passphrase, amount)
+}
+
+func UndelegateFromNode(fromAddr, validatorAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	va, err := sdk.AddressFromHex(validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	if amount.LTE(sdk.ZeroInt()) {
+		return nil, sdk.ErrInternal("must undelegate above zero")
+	}
+	return nodes.UndelegateTx(Codec(), getTMClient(), MustGetKeybase(), fa, va, passphrase, amount)
+}
+
 func StakeApp(chains []string, fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -95,6 +205,48 @@ func StakeApp(chains []string, fromAddr, passphrase string, amount sdk.Int) (*sd
 	return apps.StakeTx(Codec(), getTMClient(), MustGetKeybase(), chains, amount, kp, passphrase)
 }
 
+func EditStakeApp(chains []string, fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kp, err := (MustGetKeybase()).Get(fa)
+	if err != nil {
+		return nil, err
+	}
+	for _, chain := range chains {
+		err := pocketTypes.NetworkIdentifierVerification(chain)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if amount.LTE(sdk.NewInt(0)) {
+		return nil, sdk.ErrInternal("must stake above zero")
+	}
+	return apps.EditStakeTx(Codec(), getTMClient(), MustGetKeybase(), chains, amount, kp, passphrase)
+}
+
+func DepositApp(chains []string, fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kp, err := (MustGetKeybase()).Get(fa)
+	if err != nil {
+		return nil, err
+	}
+	for _, chain := range chains {
+		err := pocketTypes.NetworkIdentifierVerification(chain)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if amount.LTE(sdk.NewInt(0)) {
+		return nil, sdk.ErrInternal("must deposit above zero")
+	}
+	return apps.DepositTx(Codec(), getTMClient(), MustGetKeybase(), chains, amount, kp, passphrase)
+}
+
 func UnstakeApp(fromAddr, passphrase string) (*sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -103,6 +255,25 @@ func UnstakeApp(fromAddr, passphrase string) (*sdk.TxResponse, error) {
 	return apps.UnstakeTx(Codec(), getTMClient(), MustGetKeybase(), fa, passphrase)
 }
 
+func PartialUnstakeApp(fromAddr, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	if amount.LTE(sdk.ZeroInt()) {
+		return nil, sdk.ErrInternal("must unstake above zero")
+	}
+	return apps.PartialUnstakeTx(Codec(), getTMClient(), MustGetKeybase(), fa, passphrase, amount)
+}
+
+func RevokeAAT(fromAddr, clientPubKey, passphrase string) (*sdk.TxResponse, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	return apps.RevokeAATTx(Codec(), getTMClient(), MustGetKeybase(), fa, clientPubKey, passphrase)
+}
+
 func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.Int, action string) (*sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -120,9 +291,58 @@ func ChangeParam(fromAddr, paramACLKey string, paramValue interface{}, passphras
 	if err != nil {
 		return nil, err
 	}
+	// the param value must be encodable by the gov codec, which signs the change
+	if _, err := types.ModuleCdc.MarshalJSON(types.MsgChangeParam{FromAddress: fa, ParamKey: paramACLKey, ParamVal: paramValue}); err != nil {
+		return nil, fmt.Errorf("the value of %s can't be encoded in a param change: %s", paramACLKey, err.Error())
+	}
 	return gov.ChangeParamsTx(Codec(), getTMClient(), MustGetKeybase(), fa, paramACLKey, paramValue, passphrase)
 }
 
+// builds a typed param change for the latest params of the module, the amino json value is decoded into the type of
+// the param and the resulting params are validated before broadcast
+func NewParamChange(paramACLKey string, paramValue []byte) (interface{}, error) {
+	if !strings.Contains(paramACLKey, types.ACLKeySep) {
+		return nil, fmt.Errorf("invalid param key %s, must be module/param", paramACLKey)
+	}
+	module, key := types.SplitACLKey(paramACLKey)
+	var value interface{}
+	switch module {
+	case nodesTypes.ModuleName:
+		params, err := QueryNodeParams(0)
+		if err != nil {
+			return nil, err
+		}
+		_, value, err = params.ChangeParam(key, paramValue)
+		if err != nil {
+			return nil, err
+		}
+	case appsTypes.ModuleName:
+		params, err := QueryAppParams(0)
+		if err != nil {
+			return nil, err
+		}
+		_, value, err = params.ChangeParam(key, paramValue)
+		if err != nil {
+			return nil, err
+		}
+	case pocketTypes.ModuleName:
+		params, err := QueryPocketParams(0)
+		if err != nil {
+			return nil, err
+		}
+		_, value, err = params.ChangeParam(key, paramValue)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		// the params of the other modules are passed through untyped
+		if err := json.Unmarshal(paramValue, &value); err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
 func Upgrade(fromAddr string, upgrade types.Upgrade, passphrase string) (*sdk.TxResponse, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -130,3 +350,115 @@ func Upgrade(fromAddr string, upgrade types.Upgrade, passphrase string) (*sdk.Tx
 	}
 	return gov.UpgradeTx(Codec(), getTMClient(), MustGetKeybase(), fa, upgrade, passphrase)
 }
+
+// "OfflineTx" - An unsigned or partially signed transaction of the offline signing workflow
+// The chain id is part of the signed bytes, so it travels with the transaction; the signatures
+// are in the order of the signers of the transaction and empty until signed
+type OfflineTx struct {
+	ChainID string     `json:"chain_id"`
+	Tx      auth.StdTx `json:"tx"`
+}
+
+// "BuildUnsignedTx" - Builds the unsigned transaction of the amino json message
+// Offline, the chain id must be provided; otherwise it's fetched from the node when empty.
+// A zero entropy is randomized and a zero fee defaults to the fee of the message in state, or its default fee offline
+func BuildUnsignedTx(jsonMessage, chainID string, entropy, fee int64, memo string, offline bool) ([]byte, error) {
+	tx, chainID, err := newUnsignedTx(jsonMessage, chainID, entropy, fee, memo, offline)
+	if err != nil {
+		return nil, err
+	}
+	return Codec().MarshalJSONIndent(OfflineTx{ChainID: chainID, Tx: tx}, "", "  ")
+}
+
+// "newUnsignedTx" - Builds the unsigned transaction of the amino json message, with an empty signature per signer
+func newUnsignedTx(jsonMessage, chainID string, entropy, fee int64, memo string, offline bool) (auth.StdTx, string, error) {
+	var m sdk.Msg
+	if err := Codec().UnmarshalJSON([]byte(jsonMessage), &m); err != nil {
+		return auth.StdTx{}, "", err
+	}
+	if err := m.ValidateBasic(); err != nil {
+		return auth.StdTx{}, "", err
+	}
+	if chainID == "" {
+		if offline {
+			return auth.StdTx{}, "", errors.New("the chain id is required to build an offline transaction")
+		}
+		genDoc, err := getTMClient().Genesis()
+		if err != nil {
+			return auth.StdTx{}, "", err
+		}
+		chainID = genDoc.Genesis.ChainID
+	}
+	if entropy == 0 {
+		entropy = common.RandInt64()
+	}
+	feeAmount := m.GetFee()
+	if fee != 0 {
+		feeAmount = sdk.NewInt(fee)
+	} else if !offline {
+		var err error
+		if feeAmount, err = QueryMessageFee(m, 0); err != nil {
+			return auth.StdTx{}, "", err
+		}
+	}
+	signatures := make([]auth.StdSignature, len(m.GetSigners()))
+	return authTypes.NewStdTx([]sdk.Msg{m}, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, feeAmount)), signatures, memo, entropy), chainID, nil
+}
+
+// "SignOfflineTx" - Signs the offline transaction with the key of the address from the keybase, without network access
+// Returns the transaction with the signature and, once every signer signed, the hex encoded transaction bytes to broadcast
+func SignOfflineTx(fromAddr, passphrase string, offlineTx []byte) (signedTx []byte, txHex string, err error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, "", err
+	}
+	keybase, err := GetKeybase()
+	if err != nil {
+		return nil, "", err
+	}
+	return signOfflineTx(keybase, fa, passphrase, offlineTx)
+}
+
+func signOfflineTx(keybase keys.Keybase, fa sdk.Address, passphrase string, offlineTx []byte) (signedTx []byte, txHex string, err error) {
+	var otx OfflineTx
+	if err := Codec().UnmarshalJSON(offlineTx, &otx); err != nil {
+		return nil, "", err
+	}
+	signers := otx.Tx.GetSigners()
+	if len(otx.Tx.Signatures) != len(signers) {
+		return nil, "", fmt.Errorf("the transaction has %d signature slots for %d signers", len(otx.Tx.Signatures), len(signers))
+	}
+	index := -1
+	for i, signer := range signers {
+		if signer.Equals(fa) {
+			index = i
+		}
+	}
+	if index == -1 {
+		return nil, "", fmt.Errorf("the address %s is not a signer of the transaction", fa)
+	}
+	bytesToSign := auth.StdSignBytes(otx.ChainID, otx.Tx.Entropy, otx.Tx.Fee, otx.Tx.Msgs, otx.Tx.Memo)
+	sig, pubKey, err := keybase.Sign(fa, passphrase, bytesToSign)
+	if err != nil {
+		return nil, "", err
+	}
+	otx.Tx.Signatures[index] = auth.StdSignature{PublicKey: pubKey, Signature: sig}
+	signedTx, err = Codec().MarshalJSONIndent(otx, "", "  ")
+	if err != nil {
+		return nil, "", err
+	}
+	for _, s := range otx.Tx.Signatures {
+		if len(s.Signature) == 0 {
+			// waiting on the other signers
+			return signedTx, "", nil
+		}
+	}
+	if err := otx.Tx.ValidateBasic(); err != nil {
+		return nil, "", err
+	}
+	txBytes, err := auth.DefaultTxEncoder(Codec())(otx.Tx)
+	if err != nil {
+		return nil, "", err
+	}
+	return signedTx, hex.EncodeToString(txBytes), nil
+}
@@ -13,6 +13,7 @@ import (
 	types2 "github.com/pokt-network/pocket-core/x/nodes/types"
 	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
+	"github.com/pokt-network/posmint/crypto/keys"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/x/auth/types"
 	"github.com/pokt-network/posmint/x/gov"
@@ -108,7 +109,7 @@ func TestUnstakeNode(t *testing.T) {
 							addr := got.Result[0].Address
 							balance, err := nodes.QueryAccountBalance(memCodec(), memCli, addr, 0)
 							assert.NotZero(t, balance.Int64())
-							tx, err = nodes.StakeTx(memCodec(), memCli, kb, chains, "https://myPocketNode.com:8080", sdk.NewInt(10000000), kp, "test")
+							tx, err = nodes.StakeTx(memCodec(), memCli, kb, chains, "https://myPocketNode.com:8080", sdk.NewInt(10000000), 0, kp, "test")
 							assert.Nil(t, err)
 							assert.NotNil(t, tx)
 							assert.True(t, strings.Contains(tx.Logs.String(), `"success":true`))
@@ -136,7 +137,33 @@ func TestStakeNode(t *testing.T) {
 	case <-evtChan:
 		var err error
 		memCli, stopCli, evtChan = subscribeTo(t, tmTypes.EventTx)
-		tx, err = nodes.StakeTx(memCodec(), memCli, kb, chains, "https://myPocketNode.com:8080", sdk.NewInt(10000000), kp, "test")
+		tx, err = nodes.StakeTx(memCodec(), memCli, kb, chains, "https://myPocketNode.com:8080", sdk.NewInt(10000000), 0, kp, "test")
+		assert.Nil(t, err)
+		assert.NotNil(t, tx)
+		assert.True(t, strings.Contains(tx.Logs.String(), `"success":true`))
+		cleanup()
+		stopCli()
+	}
+}
+
+func TestStakeNodeWithOutputAddress(t *testing.T) {
+	_, kb, cleanup := NewInMemoryTendermintNode(t, twoValTwoNodeGenesisState())
+	kp, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kps, err := kb.List()
+	assert.Nil(t, err)
+	output := kps[1]
+	if output.GetAddress().Equals(kp.GetAddress()) {
+		output = kps[0]
+	}
+	memCli, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	var tx *sdk.TxResponse
+	var chains = []string{"00"}
+	select {
+	case <-evtChan:
+		var err error
+		memCli, stopCli, evtChan = subscribeTo(t, tmTypes.EventTx)
+		tx, err = nodes.StakeWithOutputTx(memCodec(), memCli, kb, chains, "https://myPocketNode.com:8080", sdk.NewInt(10000000), 0, kp, "test", output.GetAddress(), "test", nil, "")
 		assert.Nil(t, err)
 		assert.NotNil(t, tx)
 		assert.True(t, strings.Contains(tx.Logs.String(), `"success":true`))
@@ -616,3 +643,77 @@ func NewValidChallengeProof(t *testing.T, privateKeys []crypto.PrivateKey, numOf
 	}
 	return proofs
 }
+
+func TestOfflineTx(t *testing.T) {
+	keybase := keys.NewInMemory()
+	validator, err := keybase.Create("test")
+	assert.Nil(t, err)
+	servicer, err := keybase.Create("test")
+	assert.Nil(t, err)
+	// the rotation is co-signed by the validator and the servicer key
+	msg, err := Codec().MarshalJSON(types2.MsgRotateServicerKey{Address: validator.GetAddress(), ServicerPublicKey: servicer.PublicKey})
+	assert.Nil(t, err)
+	// the chain id can't be fetched offline
+	_, err = BuildUnsignedTx(string(msg), "", 0, 0, "", true)
+	assert.NotNil(t, err)
+	unsigned, err := BuildUnsignedTx(string(msg), "pocket-test", 7, 0, "offline", true)
+	assert.Nil(t, err)
+	// only the signers of the transaction sign it
+	other, err := keybase.Create("test")
+	assert.Nil(t, err)
+	_, _, err = signOfflineTx(keybase, other.GetAddress(), "test", unsigned)
+	assert.NotNil(t, err)
+	// the signers sign in any order, the transaction is complete with the last signature
+	partial, txHex, err := signOfflineTx(keybase, servicer.GetAddress(), "test", unsigned)
+	assert.Nil(t, err)
+	assert.Empty(t, txHex)
+	_, txHex, err = signOfflineTx(keybase, validator.GetAddress(), "test", partial)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, txHex)
+	txBz, err := hex.DecodeString(txHex)
+	assert.Nil(t, err)
+	tx, err := types.DefaultTxDecoder(Codec())(txBz)
+	assert.Nil(t, err)
+	stdTx := tx.(types.StdTx)
+	assert.Equal(t, int64(7), stdTx.Entropy)
+	assert.Equal(t, "offline", stdTx.Memo)
+	assert.Equal(t, sdk.NewInt(types2.RotateServicerKeyFee), stdTx.Fee.AmountOf(sdk.DefaultStakeDenom))
+	signBytes := types.StdSignBytes("pocket-test", stdTx.Entropy, stdTx.Fee, stdTx.Msgs, stdTx.Memo)
+	for i, signer := range stdTx.GetSigners() {
+		assert.Equal(t, signer, sdk.Address(stdTx.Signatures[i].PublicKey.Address()))
+		assert.True(t, stdTx.Signatures[i].PublicKey.VerifyBytes(signBytes, stdTx.Signatures[i].Signature))
+	}
+}
+
+func TestSendOfflineTx(t *testing.T) {
+	_, kb, cleanup := NewInMemoryTendermintNode(t, oneValTwoNodeGenesisState())
+	cb, err := kb.GetCoinbase()
+	assert.Nil(t, err)
+	kp, err := kb.Create("test")
+	assert.Nil(t, err)
+	memCli, stopCli, evtChan := subscribeTo(t, tmTypes.EventNewBlock)
+	// build and sign the transaction without the node
+	msg, err := Codec().MarshalJSON(types2.MsgSend{FromAddress: cb.GetAddress(), ToAddress: kp.GetAddress(), Amount: sdk.NewInt(1)})
+	assert.Nil(t, err)
+	unsigned, err := BuildUnsignedTx(string(msg), "pocket-test", 0, 0, "", true)
+	assert.Nil(t, err)
+	_, txHex, err := signOfflineTx(kb, cb.GetAddress(), "test", unsigned)
+	assert.Nil(t, err)
+	txBz, err := hex.DecodeString(txHex)
+	assert.Nil(t, err)
+	select {
+	case <-evtChan:
+		memCli, stopCli, evtChan = subscribeTo(t, tmTypes.EventTx)
+		txResp, err := nodes.RawTx(memCodec(), memCli, cb.GetAddress(), txBz)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(0), txResp.Code)
+	}
+	select {
+	case <-evtChan:
+		res, err := nodes.QueryAccountBalance(memCodec(), memCli, kp.GetAddress(), 0)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.Int64())
+	}
+	cleanup()
+	stopCli()
+}
@@ -3,11 +3,17 @@ package app
 import (
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	pocket "github.com/pokt-network/pocket-core/x/pocketcore"
+	pocketTypes "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/x/gov"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/types"
+	"net/http"
 	"time"
 )
 
@@ -16,6 +22,14 @@ func GenerateAAT(appPubKey, clientPubKey, passphrase string) (aatjson []byte, er
 	return json.MarshalIndent(aat, "", "  ")
 }
 
+func GenerateScopedAAT(appPubKey, clientPubKey string, expirationHeight int64, chains []string, maxRelays int64, passphrase string) (aatjson []byte, err error) {
+	aat, err := pocket.GenerateScopedAAT(MustGetKeybase(), appPubKey, clientPubKey, expirationHeight, chains, maxRelays, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(aat, "", "  ")
+}
+
 func BuildMultisig(fromAddr, jsonMessage, passphrase string, pk crypto.PublicKeyMultiSig) ([]byte, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -92,3 +106,62 @@ func SortJSON(toSortJSON []byte) string {
 	}
 	return string(js)
 }
+
+// serve a stand-in remote signer for the servicer key of a node (see the remote signer config),
+// the http signer listens on the address with mutual tls and the privval signer dials the node at the address
+func ServeRemoteSigner(fromAddr, passphrase, signerType, address string) error {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return err
+	}
+	pk, err := MustGetKeybase().ExportPrivateKeyObject(fa, passphrase)
+	if err != nil {
+		return err
+	}
+	// the consensus key is never served by a remote signer of servicer messages
+	if pvFile := GlobalConfig.PocketConfig.DataDir + FS + GlobalConfig.TendermintConfig.PrivValidatorKey; cmn.FileExists(pvFile) {
+		if consensusKey, _ := loadPKFromFile(pvFile); consensusKey.PubKey.Equals(pk.PubKey()) {
+			return fmt.Errorf("%s is the consensus key of the node, the remote signer only holds a separate servicer key", fromAddr)
+		}
+	}
+	switch signerType {
+	case pocketTypes.HTTPSignerType:
+		tlsConfig := remoteSignerTLSConfig()
+		if tlsConfig == nil {
+			return fmt.Errorf("the http remote signer requires the remote_signer_tls_cert_file, remote_signer_tls_key_file and remote_signer_tls_ca_file of the config")
+		}
+		server := &http.Server{Addr: address, Handler: pocketTypes.NewHTTPSignerHandler(pk), TLSConfig: tlsConfig}
+		return server.ListenAndServeTLS("", "")
+	case pocketTypes.PrivValSignerType:
+		protocol, addr := cmn.ProtocolAndAddress(address)
+		var dial privval.SocketDialer
+		switch protocol {
+		case "unix":
+			dial = privval.DialUnixFn(addr)
+		case "tcp":
+			dial = privval.DialTCPFn(addr, time.Duration(GlobalConfig.PocketConfig.RemoteSignerTimeout)*time.Millisecond, ed25519.GenPrivKey())
+		default:
+			return fmt.Errorf("wrong signer address: expected either 'tcp' or 'unix' protocols, got %s", protocol)
+		}
+		// the relay responses are signed with their payload, up to the max rpc body size
+		maxPayloadSize := GlobalConfig.PocketConfig.MaxRPCBodySize
+		if maxPayloadSize <= 0 {
+			maxPayloadSize = DefaultMaxRPCBodySize
+		}
+		// redial the node whenever the connection is lost (the node restarts)
+		for {
+			conn, err := dial()
+			if err != nil {
+				fmt.Printf("unable to dial the node at %s: %s\n", address, err.Error())
+				time.Sleep(time.Second)
+				continue
+			}
+			fmt.Printf("connected to the node at %s\n", address)
+			err = pocketTypes.ServePrivValSigner(conn, pk, maxPayloadSize)
+			_ = conn.Close()
+			fmt.Printf("lost the connection to the node at %s: %s\n", address, err.Error())
+		}
+	default:
+		return fmt.Errorf("unsupported remote signer type %q, supported types are %q and %q", signerType, pocketTypes.PrivValSignerType, pocketTypes.HTTPSignerType)
+	}
+}
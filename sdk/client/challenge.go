@@ -0,0 +1,121 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// "ConsensusResult" - The result of a relay sent to multiple session nodes
+type ConsensusResult struct {
+	Response   types.RelayResponse               // a response of the majority
+	Majority   []types.RelayResponse             // the responses that agree with the majority
+	Minority   []types.RelayResponse             // the responses that disagree with the majority
+	Challenges []types.ChallengeProofInvalidData // the challenges submitted for the minority responses
+	Errors     []error                           // the errors of failed relays and challenge submissions
+}
+
+// "ConsensusRelay" - Sends the same request to multiple session nodes and returns the majority response
+// if auto challenge is enabled, every minority response is challenged with two majority responses
+func (c *Client) ConsensusRelay(chain string, payload types.Payload) (*ConsensusResult, error) {
+	height, err := c.Height()
+	if err != nil {
+		return nil, err
+	}
+	session, err := c.Session(chain, height)
+	if err != nil {
+		return nil, err
+	}
+	c.l.Lock()
+	nodes := session.nextNodes(c.config.ConsensusNodes)
+	c.l.Unlock()
+	// the same request (payload and meta) results in the same request hash for every node
+	responses := make([]*types.RelayResponse, len(nodes))
+	errs := make([]error, len(nodes))
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i int, node nodesTypes.Validator) {
+			defer wg.Done()
+			responses[i], errs[i] = c.RelayTo(node, session, payload, height)
+		}(i, node)
+	}
+	wg.Wait()
+	result := &ConsensusResult{}
+	var verified []types.RelayResponse
+	var verifiedNodes []nodesTypes.Validator
+	for i := range nodes {
+		if errs[i] != nil {
+			result.Errors = append(result.Errors, errs[i])
+			continue
+		}
+		verified = append(verified, *responses[i])
+		verifiedNodes = append(verifiedNodes, nodes[i])
+	}
+	// group the responses by the comparator, the one of the chain registry entry unless configured
+	comparator := c.config.Comparator
+	if comparator == nil {
+		comparator = types.GetResponseComparator(session.Blockchain)
+	}
+	majority, majorityNodes, minority := groupResponses(comparator, payload, verified, verifiedNodes)
+	if len(majority) < 2 {
+		return result, ErrNoMajority
+	}
+	result.Response, result.Majority, result.Minority = majority[0], majority, minority
+	if c.config.AutoChallenge {
+		for _, m := range minority {
+			challenge, err := c.Challenge(majorityNodes[0], [2]types.RelayResponse{majority[0], majority[1]}, m)
+			if err != nil {
+				result.Errors = append(result.Errors, err)
+				continue
+			}
+			result.Challenges = append(result.Challenges, challenge)
+		}
+	}
+	return result, nil
+}
+
+// "Challenge" - Submits a challenge of the minority response to a majority node, the reporter of the challenge
+func (c *Client) Challenge(reporter nodesTypes.Validator, majority [2]types.RelayResponse, minority types.RelayResponse) (types.ChallengeProofInvalidData, error) {
+	challenge := types.ChallengeProofInvalidData{
+		MajorityResponses: majority,
+		MinorityResponse:  minority,
+		ReporterAddress:   reporter.Address,
+	}
+	// the challenge is validated by the reporter, against the session of the responses
+	if err := c.post(reporter.ServiceURL, ChallengePath, challenge, nil); err != nil {
+		return challenge, fmt.Errorf("unable to submit the challenge to %s: %s", reporter.Address.String(), err.Error())
+	}
+	return challenge, nil
+}
+
+// "groupResponses" - Splits the responses into the largest group of equal responses and the rest
+func groupResponses(comparator types.ResponseComparator, request types.Payload, responses []types.RelayResponse, nodes []nodesTypes.Validator) (majority []types.RelayResponse, majorityNodes []nodesTypes.Validator, minority []types.RelayResponse) {
+	best := -1
+	bestCount := 0
+	for i := range responses {
+		count := 0
+		for j := range responses {
+			if comparator.Equal(request, responses[i].Response, responses[j].Response) {
+				count++
+			}
+		}
+		if count > bestCount {
+			best, bestCount = i, count
+		}
+	}
+	if best == -1 {
+		return
+	}
+	for i := range responses {
+		if comparator.Equal(request, responses[best].Response, responses[i].Response) {
+			majority = append(majority, responses[i])
+			majorityNodes = append(majorityNodes, nodes[i])
+			continue
+		}
+		minority = append(minority, responses[i])
+	}
+	return
+}
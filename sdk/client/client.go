@@ -0,0 +1,168 @@
+// Package client is a go client for pocket network applications.
+// It handles dispatching sessions, signing and sending relays to session nodes,
+// verifying the servicer signatures of the relay responses and submitting challenges
+// for responses that disagree with the majority.
+package client
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+)
+
+const (
+	DispatchPath          = "/v1/client/dispatch"
+	RelayPath             = "/v1/client/relay"
+	ChallengePath         = "/v1/client/challenge"
+	HeightPath            = "/v1/query/height"
+	NodeParamsPath        = "/v1/query/nodeparams"
+	PocketParamsPath      = "/v1/query/pocketparams"
+	DefaultTimeout        = 30 * time.Second
+	DefaultConsensusNodes = 3
+)
+
+var (
+	ErrNoDispatchers        = errors.New("at least one dispatcher url is required")
+	ErrClientKeyNotAAT      = errors.New("the client key does not match the client public key of the aat")
+	ErrNoSessionNodes       = errors.New("the session does not contain any nodes")
+	ErrNoMajority           = errors.New("no majority response was found")
+	ErrTokenRelaysExhausted = errors.New("the max relays of the aat have been sent to the session node")
+)
+
+// "Config" - The configuration of the pocket client
+type Config struct {
+	Dispatchers    []string                 // the urls of the pocket nodes used for dispatching sessions
+	MaxRetries     int                      // the max # of session nodes tried for a single relay, defaults to every session node
+	ConsensusNodes int                      // the # of session nodes used in consensus relays, defaults to 3
+	AutoChallenge  bool                     // if true, consensus relays challenge the minority responses
+	Comparator     types.ResponseComparator // the comparator for consensus relays, defaults to the comparator of the chain registry entry of the chain (as challenges are compared on chain)
+	Timeout        time.Duration            // the timeout of each http request, defaults to 30 seconds
+	HTTPClient     *http.Client             // an optional http client, overrides the timeout
+}
+
+// "Client" - A pocket network client, authorized by an application through the aat
+type Client struct {
+	config    Config
+	http      *http.Client
+	aat       types.AAT
+	clientKey crypto.PrivateKey
+	// the dispatched sessions keyed by chain
+	sessions         map[string]*Session
+	blocksPerSession int64
+	l                sync.Mutex
+}
+
+// "NewClient" - Returns a new pocket client using the aat and the private key of the client
+func NewClient(config Config, aat types.AAT, clientKey crypto.PrivateKey) (*Client, error) {
+	if len(config.Dispatchers) == 0 {
+		return nil, ErrNoDispatchers
+	}
+	if err := aat.Validate(); err != nil {
+		return nil, err
+	}
+	if clientKey.PublicKey().RawString() != aat.ClientPublicKey {
+		return nil, ErrClientKeyNotAAT
+	}
+	if config.Timeout == 0 {
+		config.Timeout = DefaultTimeout
+	}
+	if config.ConsensusNodes == 0 {
+		config.ConsensusNodes = DefaultConsensusNodes
+	}
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: config.Timeout}
+	}
+	return &Client{
+		config:    config,
+		http:      httpClient,
+		aat:       aat,
+		clientKey: clientKey,
+		sessions:  make(map[string]*Session),
+	}, nil
+}
+
+// "NewAAT" - Returns a new application authentication token, signed by the application for the client
+func NewAAT(appKey crypto.PrivateKey, clientPubKey crypto.PublicKey) (types.AAT, error) {
+	aat := types.AAT{
+		Version:              types.SupportedTokenVersions[0],
+		ApplicationPublicKey: appKey.PublicKey().RawString(),
+		ClientPublicKey:      clientPubKey.RawString(),
+	}
+	sig, err := appKey.Sign(aat.Hash())
+	if err != nil {
+		return types.AAT{}, err
+	}
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	return aat, nil
+}
+
+// "AAT" - Returns the application authentication token of the client
+func (c *Client) AAT() types.AAT {
+	return c.aat
+}
+
+// "Height" - Returns the latest block height from the dispatchers
+func (c *Client) Height() (int64, error) {
+	var res struct {
+		Height int64 `json:"height"`
+	}
+	if err := c.postDispatchers(HeightPath, struct{}{}, &res); err != nil {
+		return 0, err
+	}
+	return res.Height, nil
+}
+
+// "postDispatchers" - Sends the request to each dispatcher until one succeeds
+func (c *Client) postDispatchers(path string, body interface{}, out interface{}) (err error) {
+	for _, dispatcher := range c.config.Dispatchers {
+		if err = c.post(dispatcher, path, body, out); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// "rpcError" - The error returned by the pocket rpc
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// "post" - Sends a json post request to the pocket rpc and decodes the response into out
+func (c *Client) post(url, path string, body interface{}, out interface{}) error {
+	bz, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	resp, err := c.http.Post(strings.TrimRight(url, "/")+path, "application/json", bytes.NewBuffer(bz))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	res, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		var rpcErr rpcError
+		if err := json.Unmarshal(res, &rpcErr); err != nil || rpcErr.Message == "" {
+			return fmt.Errorf("%s%s returned status code %d", url, path, resp.StatusCode)
+		}
+		return fmt.Errorf("%s%s returned an error: %s", url, path, rpcErr.Message)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(res, out)
+}
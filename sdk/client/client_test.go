@@ -0,0 +1,310 @@
+package client
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testChain = hex.EncodeToString([]byte{01})
+
+// "mockNode" - A mock pocket node servicing relays and receiving challenges
+type mockNode struct {
+	key        crypto.PrivateKey
+	server     *httptest.Server
+	response   string
+	fail       bool
+	badSig     bool
+	challenges []types.ChallengeProofInvalidData
+	l          sync.Mutex
+}
+
+func newMockNode(response string) *mockNode {
+	n := &mockNode{key: crypto.GenerateEd25519PrivKey(), response: response}
+	mux := http.NewServeMux()
+	mux.HandleFunc(RelayPath, func(w http.ResponseWriter, r *http.Request) {
+		var relay types.Relay
+		if err := json.NewDecoder(r.Body).Decode(&relay); err != nil || n.fail {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(rpcError{Code: 400, Message: "relay failed"})
+			return
+		}
+		resp := types.RelayResponse{Response: n.response, Proof: relay.Proof}
+		sig, _ := n.key.Sign(resp.Hash())
+		if n.badSig {
+			sig, _ = crypto.GenerateEd25519PrivKey().Sign(resp.Hash())
+		}
+		resp.Signature = hex.EncodeToString(sig)
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc(ChallengePath, func(w http.ResponseWriter, r *http.Request) {
+		var challenge types.ChallengeProofInvalidData
+		if err := json.NewDecoder(r.Body).Decode(&challenge); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		n.l.Lock()
+		n.challenges = append(n.challenges, challenge)
+		n.l.Unlock()
+		_, _ = w.Write([]byte("null"))
+	})
+	n.server = httptest.NewServer(mux)
+	return n
+}
+
+func (n *mockNode) validator() nodesTypes.Validator {
+	return nodesTypes.Validator{
+		Address:      sdk.Address(n.key.PublicKey().Address()),
+		PublicKey:    n.key.PublicKey(),
+		Status:       sdk.Staked,
+		Chains:       []string{testChain},
+		ServiceURL:   n.server.URL,
+		StakedTokens: sdk.NewInt(1000000),
+	}
+}
+
+// "mockDispatcher" - A mock pocket node dispatching sessions of the mock nodes
+type mockDispatcher struct {
+	server     *httptest.Server
+	height     int64
+	dispatches int
+	registry   types.ChainRegistry
+	l          sync.Mutex
+}
+
+func newMockDispatcher(nodes []*mockNode) *mockDispatcher {
+	d := &mockDispatcher{height: 2}
+	mux := http.NewServeMux()
+	mux.HandleFunc(HeightPath, func(w http.ResponseWriter, r *http.Request) {
+		d.l.Lock()
+		defer d.l.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]int64{"height": d.height})
+	})
+	mux.HandleFunc(NodeParamsPath, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"session_block_frequency":"4"}`))
+	})
+	mux.HandleFunc(PocketParamsPath, func(w http.ResponseWriter, r *http.Request) {
+		d.l.Lock()
+		defer d.l.Unlock()
+		_ = json.NewEncoder(w).Encode(map[string]types.ChainRegistry{"chain_registry": d.registry})
+	})
+	mux.HandleFunc(DispatchPath, func(w http.ResponseWriter, r *http.Request) {
+		var header types.SessionHeader
+		if err := json.NewDecoder(r.Body).Decode(&header); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		d.l.Lock()
+		defer d.l.Unlock()
+		d.dispatches++
+		header.SessionBlockHeight = ((d.height-1)/4)*4 + 1
+		var validators []nodesTypes.Validator
+		for _, n := range nodes {
+			validators = append(validators, n.validator())
+		}
+		_ = json.NewEncoder(w).Encode(struct {
+			Session     Session `json:"session"`
+			BlockHeight int64   `json:"block_height"`
+		}{Session{Header: header, Nodes: validators}, d.height})
+	})
+	d.server = httptest.NewServer(mux)
+	return d
+}
+
+func newTestClient(t *testing.T, config Config, dispatcher *mockDispatcher) *Client {
+	appKey := crypto.GenerateEd25519PrivKey()
+	clientKey := crypto.GenerateEd25519PrivKey()
+	aat, err := NewAAT(appKey, clientKey.PublicKey())
+	assert.Nil(t, err)
+	config.Dispatchers = []string{dispatcher.server.URL}
+	c, err := NewClient(config, aat, clientKey)
+	assert.Nil(t, err)
+	return c
+}
+
+func TestNewClient(t *testing.T) {
+	appKey := crypto.GenerateEd25519PrivKey()
+	clientKey := crypto.GenerateEd25519PrivKey()
+	aat, err := NewAAT(appKey, clientKey.PublicKey())
+	assert.Nil(t, err)
+	assert.Nil(t, aat.Validate())
+	_, err = NewClient(Config{}, aat, clientKey)
+	assert.Equal(t, ErrNoDispatchers, err)
+	_, err = NewClient(Config{Dispatchers: []string{"http://localhost:8081"}}, aat, appKey)
+	assert.Equal(t, ErrClientKeyNotAAT, err)
+	invalidAAT := aat
+	invalidAAT.ApplicationSignature = ""
+	_, err = NewClient(Config{Dispatchers: []string{"http://localhost:8081"}}, invalidAAT, clientKey)
+	assert.NotNil(t, err)
+	c, err := NewClient(Config{Dispatchers: []string{"http://localhost:8081"}}, aat, clientKey)
+	assert.Nil(t, err)
+	assert.Equal(t, aat, c.AAT())
+}
+
+func TestClient_Session(t *testing.T) {
+	node := newMockNode("0x1")
+	defer node.server.Close()
+	dispatcher := newMockDispatcher([]*mockNode{node})
+	defer dispatcher.server.Close()
+	c := newTestClient(t, Config{}, dispatcher)
+	session, err := c.Session(testChain, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), session.Header.SessionBlockHeight)
+	assert.Len(t, session.Nodes, 1)
+	assert.Equal(t, node.key.PublicKey().RawString(), session.Nodes[0].PublicKey.RawString())
+	// the cached session is used within the session
+	_, err = c.Session(testChain, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, dispatcher.dispatches)
+	// a new session is dispatched once the session expires
+	dispatcher.height = 5
+	session, err = c.Session(testChain, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, dispatcher.dispatches)
+	assert.Equal(t, int64(5), session.Header.SessionBlockHeight)
+}
+
+func TestClient_Relay(t *testing.T) {
+	failing := newMockNode("0x1")
+	failing.fail = true
+	defer failing.server.Close()
+	working := newMockNode("0x1")
+	defer working.server.Close()
+	dispatcher := newMockDispatcher([]*mockNode{failing, working})
+	defer dispatcher.server.Close()
+	c := newTestClient(t, Config{}, dispatcher)
+	payload := types.Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, Method: "POST"}
+	// the client retries with the working node
+	resp, err := c.Relay(testChain, payload)
+	assert.Nil(t, err)
+	assert.Equal(t, "0x1", resp.Response)
+	assert.Equal(t, working.key.PublicKey().RawString(), resp.Proof.ServicerPubKey)
+	// no retries results in an error when the failing node is selected
+	c.config.MaxRetries = 1
+	_, err1 := c.Relay(testChain, payload)
+	_, err2 := c.Relay(testChain, payload)
+	assert.True(t, err1 != nil || err2 != nil)
+}
+
+func TestClient_RelayTokenCap(t *testing.T) {
+	node := newMockNode("0x1")
+	defer node.server.Close()
+	dispatcher := newMockDispatcher([]*mockNode{node})
+	defer dispatcher.server.Close()
+	c := newTestClient(t, Config{}, dispatcher)
+	c.aat.MaxRelays = 2
+	payload := types.Payload{Data: `{}`, Method: "POST"}
+	// the relays of a capped token are indexed per servicer
+	for i := int64(0); i < 2; i++ {
+		resp, err := c.Relay(testChain, payload)
+		assert.Nil(t, err)
+		assert.Equal(t, i, resp.Proof.Entropy)
+	}
+	_, err := c.Relay(testChain, payload)
+	assert.Equal(t, ErrTokenRelaysExhausted, err)
+	// the index restarts with the next session
+	dispatcher.height = 5
+	resp, err := c.Relay(testChain, payload)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), resp.Proof.Entropy)
+}
+
+func TestClient_RelayInvalidSignature(t *testing.T) {
+	node := newMockNode("0x1")
+	node.badSig = true
+	defer node.server.Close()
+	dispatcher := newMockDispatcher([]*mockNode{node})
+	defer dispatcher.server.Close()
+	c := newTestClient(t, Config{}, dispatcher)
+	_, err := c.Relay(testChain, types.Payload{Data: `{}`, Method: "POST"})
+	assert.NotNil(t, err)
+}
+
+func TestClient_ConsensusRelay(t *testing.T) {
+	node1 := newMockNode(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	defer node1.server.Close()
+	node2 := newMockNode(`{"result":"0x1","jsonrpc":"2.0","id":1}`)
+	defer node2.server.Close()
+	node3 := newMockNode(`{"jsonrpc":"2.0","id":1,"result":"0x2"}`)
+	defer node3.server.Close()
+	nodes := []*mockNode{node1, node2, node3}
+	dispatcher := newMockDispatcher(nodes)
+	defer dispatcher.server.Close()
+	c := newTestClient(t, Config{AutoChallenge: true}, dispatcher)
+	payload := types.Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, Method: "POST"}
+	result, err := c.ConsensusRelay(testChain, payload)
+	assert.Nil(t, err)
+	assert.Len(t, result.Majority, 2)
+	assert.Len(t, result.Minority, 1)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, node3.key.PublicKey().RawString(), result.Minority[0].Proof.ServicerPubKey)
+	// the challenge is submitted to a majority node
+	assert.Len(t, result.Challenges, 1)
+	received := append(node1.challenges, node2.challenges...)
+	assert.Len(t, received, 1)
+	assert.Empty(t, node3.challenges)
+	assert.Equal(t, result.Minority[0].Signature, received[0].MinorityResponse.Signature)
+	assert.Equal(t, result.Majority[0].Proof.RequestHash, received[0].MinorityResponse.Proof.RequestHash)
+	// no majority
+	node2.response = `{"jsonrpc":"2.0","id":1,"result":"0x3"}`
+	_, err = c.ConsensusRelay(testChain, payload)
+	assert.Equal(t, ErrNoMajority, err)
+}
+
+func TestClient_ConsensusRelayChainComparator(t *testing.T) {
+	node1 := newMockNode(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	defer node1.server.Close()
+	// differs in the id only
+	node2 := newMockNode(`{"jsonrpc":"2.0","id":2,"result":"0x1"}`)
+	defer node2.server.Close()
+	node3 := newMockNode(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+	defer node3.server.Close()
+	dispatcher := newMockDispatcher([]*mockNode{node1, node2, node3})
+	defer dispatcher.server.Close()
+	dispatcher.registry = types.ChainRegistry{{ID: testChain, Name: "eth", Type: types.JSONRPCBlockchainType,
+		FieldMasks: types.FieldMasks{{Method: "eth_getBlockByNumber", Fields: []string{"result.timestamp"}}}}}
+	c := newTestClient(t, Config{AutoChallenge: true}, dispatcher)
+	// the responses agree by the comparator of the chain registry entry, so nothing is challenged
+	payload := types.Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, Method: "POST"}
+	result, err := c.ConsensusRelay(testChain, payload)
+	assert.Nil(t, err)
+	assert.Len(t, result.Majority, 3)
+	assert.Empty(t, result.Minority)
+	assert.Empty(t, result.Challenges)
+	session, err := c.Session(testChain, dispatcher.height)
+	assert.Nil(t, err)
+	assert.Equal(t, types.JSONRPCBlockchainType, session.Blockchain.Type)
+	// differs in a masked field only
+	node1.response = `{"jsonrpc":"2.0","id":1,"result":{"number":"0x1","timestamp":"0x10"}}`
+	node2.response = `{"jsonrpc":"2.0","id":1,"result":{"number":"0x1","timestamp":"0x10"}}`
+	node3.response = `{"jsonrpc":"2.0","id":1,"result":{"number":"0x1","timestamp":"0x11"}}`
+	payload = types.Payload{Data: `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x1",false],"id":1}`, Method: "POST"}
+	result, err = c.ConsensusRelay(testChain, payload)
+	assert.Nil(t, err)
+	assert.Len(t, result.Majority, 3)
+	assert.Empty(t, result.Challenges)
+	// a configured comparator overrides the one of the chain
+	c = newTestClient(t, Config{AutoChallenge: true, Comparator: types.RawComparator{}}, dispatcher)
+	result, err = c.ConsensusRelay(testChain, payload)
+	assert.Nil(t, err)
+	assert.Len(t, result.Minority, 1)
+	assert.Len(t, result.Challenges, 1)
+}
+
+func TestNewEntropy(t *testing.T) {
+	e1, err := newEntropy()
+	assert.Nil(t, err)
+	e2, err := newEntropy()
+	assert.Nil(t, err)
+	assert.True(t, e1 >= 0 && e2 >= 0)
+	assert.NotEqual(t, e1, e2)
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// "Relay" - Sends the payload to a node of the current session, retrying with the other session nodes on failure
+// the returned response is verified against the signature of the servicer
+func (c *Client) Relay(chain string, payload types.Payload) (*types.RelayResponse, error) {
+	height, err := c.Height()
+	if err != nil {
+		return nil, err
+	}
+	session, err := c.Session(chain, height)
+	if err != nil {
+		return nil, err
+	}
+	c.l.Lock()
+	nodes := session.nextNodes(len(session.Nodes))
+	c.l.Unlock()
+	if c.config.MaxRetries > 0 && c.config.MaxRetries < len(nodes) {
+		nodes = nodes[:c.config.MaxRetries]
+	}
+	for _, node := range nodes {
+		var resp *types.RelayResponse
+		resp, err = c.RelayTo(node, session, payload, height)
+		if err == nil {
+			return resp, nil
+		}
+	}
+	return nil, err
+}
+
+// "RelayTo" - Sends the payload to a specific session node and verifies the response
+func (c *Client) RelayTo(node nodesTypes.Validator, session *Session, payload types.Payload, height int64) (*types.RelayResponse, error) {
+	relay, err := c.NewRelay(node, session, payload, height)
+	if err != nil {
+		return nil, err
+	}
+	var resp types.RelayResponse
+	if err := c.post(node.ServiceURL, RelayPath, relay, &resp); err != nil {
+		return nil, err
+	}
+	if err := VerifyRelayResponse(relay, &resp); err != nil {
+		return nil, fmt.Errorf("invalid relay response from %s: %s", node.Address.String(), err.Error())
+	}
+	return &resp, nil
+}
+
+// "NewRelay" - Returns a new relay for the session node, signed by the client
+func (c *Client) NewRelay(node nodesTypes.Validator, session *Session, payload types.Payload, height int64) (types.Relay, error) {
+	entropy, err := c.relayEntropy(node, session)
+	if err != nil {
+		return types.Relay{}, err
+	}
+	relay := types.Relay{
+		Payload: payload,
+		Meta:    types.RelayMeta{BlockHeight: height},
+		Proof: types.RelayProof{
+			Entropy:            entropy,
+			SessionBlockHeight: session.Header.SessionBlockHeight,
+			ServicerPubKey:     node.GetServicerPublicKey().RawString(),
+			Blockchain:         session.Header.Chain,
+			Token:              c.aat,
+		},
+	}
+	relay.Proof.RequestHash = relay.RequestHashString()
+	sig, err := c.clientKey.Sign(relay.Proof.Hash())
+	if err != nil {
+		return types.Relay{}, err
+	}
+	relay.Proof.Signature = hex.EncodeToString(sig)
+	return relay, nil
+}
+
+// "VerifyRelayResponse" - Verifies the response is the servicer's signed response for the relay
+func VerifyRelayResponse(relay types.Relay, resp *types.RelayResponse) error {
+	if err := resp.Verify(relay); err != nil {
+		return err
+	}
+	return nil
+}
+
+// "relayEntropy" - Returns the entropy of the next relay to the session node, a token with a relay cap
+// uses the index of the relay to the servicer in the session, which is verified against the cap on chain
+func (c *Client) relayEntropy(node nodesTypes.Validator, session *Session) (int64, error) {
+	if c.aat.MaxRelays == 0 {
+		return newEntropy()
+	}
+	c.l.Lock()
+	defer c.l.Unlock()
+	index := session.nextRelayIndex(node.GetServicerPublicKey().RawString())
+	if index >= c.aat.MaxRelays {
+		return 0, ErrTokenRelaysExhausted
+	}
+	return index, nil
+}
+
+// "newEntropy" - Returns a random non negative int64 from a cryptographically secure source, which makes the relay
+// proofs of identical payloads unique
+func newEntropy() (int64, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		return 0, fmt.Errorf("unable to generate the relay entropy: %s", err.Error())
+	}
+	return n.Int64(), nil
+}
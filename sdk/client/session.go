@@ -0,0 +1,123 @@
+package client
+
+import (
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+)
+
+// "Session" - A dispatched pocket session of the application for a non-native chain
+type Session struct {
+	Header      types.SessionHeader    `json:"header"` // the header of the session
+	Nodes       []nodesTypes.Validator `json:"nodes"`  // the nodes servicing the session
+	BlockHeight int64                  `json:"-"`      // the block height when the session was dispatched
+	Blockchain  types.Blockchain       `json:"-"`      // the chain registry entry of the chain of the session
+	next        int                    // the index of the next node used for relaying
+	relays      map[string]int64       // the # of relays sent to each node, keyed by the servicer public key
+}
+
+// "dispatchResponse" - A structure used to decode the dispatch response
+type dispatchResponse struct {
+	Session     Session `json:"session"`
+	BlockHeight int64   `json:"block_height"`
+}
+
+// "IsExpired" - Returns true if the session has ended at the block height
+func (s *Session) IsExpired(height, blocksPerSession int64) bool {
+	return height >= s.Header.SessionBlockHeight+blocksPerSession
+}
+
+// "nextNodes" - Returns up to count session nodes, rotating through the nodes of the session
+func (s *Session) nextNodes(count int) []nodesTypes.Validator {
+	if count > len(s.Nodes) {
+		count = len(s.Nodes)
+	}
+	nodes := make([]nodesTypes.Validator, 0, count)
+	for i := 0; i < count; i++ {
+		nodes = append(nodes, s.Nodes[(s.next+i)%len(s.Nodes)])
+	}
+	s.next = (s.next + 1) % len(s.Nodes)
+	return nodes
+}
+
+// "nextRelayIndex" - Returns the index of the next relay to the servicer in the session
+func (s *Session) nextRelayIndex(servicerPubKey string) int64 {
+	if s.relays == nil {
+		s.relays = make(map[string]int64)
+	}
+	index := s.relays[servicerPubKey]
+	s.relays[servicerPubKey] = index + 1
+	return index
+}
+
+// "Dispatch" - Retrieves a new session for the chain from the dispatchers
+func (c *Client) Dispatch(chain string) (*Session, error) {
+	header := types.SessionHeader{
+		ApplicationPubKey: c.aat.ApplicationPublicKey,
+		Chain:             chain,
+	}
+	var res dispatchResponse
+	if err := c.postDispatchers(DispatchPath, header, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Session.Nodes) == 0 {
+		return nil, ErrNoSessionNodes
+	}
+	session := res.Session
+	session.BlockHeight = res.BlockHeight
+	registry, err := c.ChainRegistry(res.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	session.Blockchain, _ = registry.Get(chain)
+	c.l.Lock()
+	defer c.l.Unlock()
+	c.sessions[chain] = &session
+	return &session, nil
+}
+
+// "Session" - Returns the current session for the chain, dispatching a new session if the cached one expired
+func (c *Client) Session(chain string, height int64) (*Session, error) {
+	blocksPerSession, err := c.BlocksPerSession()
+	if err != nil {
+		return nil, err
+	}
+	c.l.Lock()
+	session, found := c.sessions[chain]
+	c.l.Unlock()
+	if found && !session.IsExpired(height, blocksPerSession) {
+		return session, nil
+	}
+	return c.Dispatch(chain)
+}
+
+// "BlocksPerSession" - Returns the number of blocks in a session (cached after the first query)
+func (c *Client) BlocksPerSession() (int64, error) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.blocksPerSession != 0 {
+		return c.blocksPerSession, nil
+	}
+	var res struct {
+		SessionBlockFrequency int64 `json:"session_block_frequency,string"`
+	}
+	if err := c.postDispatchers(NodeParamsPath, struct {
+		Height int64 `json:"height"`
+	}{}, &res); err != nil {
+		return 0, err
+	}
+	c.blocksPerSession = res.SessionBlockFrequency
+	return c.blocksPerSession, nil
+}
+
+// "ChainRegistry" - Returns the chain registry of the pocket params at the block height
+func (c *Client) ChainRegistry(height int64) (types.ChainRegistry, error) {
+	var res struct {
+		ChainRegistry types.ChainRegistry `json:"chain_registry"`
+	}
+	if err := c.postDispatchers(PocketParamsPath, struct {
+		Height int64 `json:"height"`
+	}{height}, &res); err != nil {
+		return nil, err
+	}
+	return res.ChainRegistry, nil
+}
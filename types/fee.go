@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// MessageFee is the fee of a message type (in uPOKT)
+type MessageFee struct {
+	MsgType string `json:"msg_type" yaml:"msg_type"`
+	Fee     int64  `json:"fee" yaml:"fee"`
+}
+
+// MessageFees is the fees of the messages of a module, a list as amino can't encode maps
+type MessageFees []MessageFee
+
+// Fee returns the fee of the message type
+func (mf MessageFees) Fee(msgType string) (fee int64, found bool) {
+	for _, f := range mf {
+		if f.MsgType == msgType {
+			return f.Fee, true
+		}
+	}
+	return 0, false
+}
+
+// Amount returns the fee of the message type as an amount, zero when the message type has no fee
+func (mf MessageFees) Amount(msgType string) sdk.Int {
+	fee, _ := mf.Fee(msgType)
+	return sdk.NewInt(fee)
+}
+
+// Validate validates the fees cover every message of the module (the message types of its default fees) once
+// and aren't negative
+func (mf MessageFees) Validate(defaults MessageFees) error {
+	if len(mf) != len(defaults) {
+		return fmt.Errorf("the message fees must cover the %d messages of the module", len(defaults))
+	}
+	seen := make(map[string]struct{}, len(mf))
+	for _, f := range mf {
+		if _, found := defaults.Fee(f.MsgType); !found {
+			return fmt.Errorf("unknown message type %s in the message fees", f.MsgType)
+		}
+		if _, ok := seen[f.MsgType]; ok {
+			return fmt.Errorf("duplicate message type %s in the message fees", f.MsgType)
+		}
+		seen[f.MsgType] = struct{}{}
+		if f.Fee < 0 {
+			return fmt.Errorf("the fee of %s must not be negative", f.MsgType)
+		}
+	}
+	return nil
+}
+
+func (mf MessageFees) String() string {
+	fees := make([]string, 0, len(mf))
+	for _, f := range mf {
+		fees = append(fees, fmt.Sprintf("%s: %d", f.MsgType, f.Fee))
+	}
+	return strings.Join(fees, ", ")
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testMessageFees = MessageFees{
+	{MsgType: "stake", Fee: 100000},
+	{MsgType: "send", Fee: 10000},
+	{MsgType: "unjail", Fee: 0},
+}
+
+func TestMessageFees_Fee(t *testing.T) {
+	fee, found := testMessageFees.Fee("send")
+	assert.True(t, found)
+	assert.Equal(t, int64(10000), fee)
+	assert.Equal(t, sdk.NewInt(10000), testMessageFees.Amount("send"))
+	_, found = testMessageFees.Fee("unknown")
+	assert.False(t, found)
+	assert.True(t, testMessageFees.Amount("unknown").IsZero())
+}
+
+func TestMessageFees_Validate(t *testing.T) {
+	changed := append(MessageFees(nil), testMessageFees...)
+	changed[0].Fee = 1
+	negative := append(MessageFees(nil), testMessageFees...)
+	negative[0].Fee = -1
+	unknown := append(MessageFees(nil), testMessageFees...)
+	unknown[0].MsgType = "unknown"
+	duplicate := append(MessageFees(nil), testMessageFees...)
+	duplicate[0].MsgType = duplicate[1].MsgType
+	tests := []struct {
+		name    string
+		fees    MessageFees
+		wantErr bool
+	}{
+		{"default fees", testMessageFees, false},
+		{"changed fee", changed, false},
+		{"missing message", testMessageFees[1:], true},
+		{"negative fee", negative, true},
+		{"unknown message", unknown, true},
+		{"duplicate message", duplicate, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.fees.Validate(testMessageFees) != nil)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// ParamSet is the params of a module that can be changed one param at a time
+type ParamSet interface {
+	ParamSetPairs() sdk.ParamSetPairs
+	Validate() error
+}
+
+// ChangeParam changes the param of the key in the param set to the amino json value and returns the typed value
+// the value is decoded into the type of the param and the resulting param set must be valid
+func ChangeParam(cdc *codec.Codec, params ParamSet, key string, value []byte) (interface{}, error) {
+	for _, pair := range params.ParamSetPairs() {
+		if string(pair.Key) != key {
+			continue
+		}
+		if err := cdc.UnmarshalJSON(value, pair.Value); err != nil {
+			return nil, fmt.Errorf("unable to decode the value of %s: %s", key, err.Error())
+		}
+		if err := params.Validate(); err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(pair.Value).Elem().Interface(), nil
+	}
+	return nil, fmt.Errorf("unknown param %s", key)
+}
+
+// ParamChangeEffect is the effect of a param change on a single node or application
+type ParamChangeEffect struct {
+	Address sdk.Address `json:"address" yaml:"address"`
+	Effect  string      `json:"effect" yaml:"effect"`
+}
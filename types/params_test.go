@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pokt-network/posmint/codec"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testParams struct {
+	MaxCount int64
+}
+
+func (p *testParams) ParamSetPairs() sdk.ParamSetPairs {
+	return sdk.ParamSetPairs{
+		{Key: []byte("MaxCount"), Value: &p.MaxCount},
+	}
+}
+
+func (p testParams) Validate() error {
+	if p.MaxCount < 0 {
+		return fmt.Errorf("max count must not be negative")
+	}
+	return nil
+}
+
+func TestChangeParam(t *testing.T) {
+	cdc := codec.New()
+	params := testParams{MaxCount: 1}
+	value, err := ChangeParam(cdc, &params, "MaxCount", []byte(`"5"`))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), value)
+	assert.Equal(t, int64(5), params.MaxCount)
+	_, err = ChangeParam(cdc, &params, "MaxCount", []byte(`"-1"`))
+	assert.NotNil(t, err)
+	_, err = ChangeParam(cdc, &params, "MaxCount", []byte(`"five"`))
+	assert.NotNil(t, err)
+	_, err = ChangeParam(cdc, &params, "UnknownParam", []byte(`"5"`))
+	assert.NotNil(t, err)
+}
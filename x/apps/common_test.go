@@ -77,6 +77,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Ctx, keeper.Keeper, type
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName:     nil,
 		types.StakedPoolName:      {auth.Burner, auth.Staking, auth.Minter},
+		types.EscrowPoolName:      nil,
 		nodestypes.StakedPoolName: {auth.Burner, auth.Staking},
 		govTypes.DAOAccountName:   {auth.Burner, auth.Staking},
 	}
@@ -89,7 +90,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Ctx, keeper.Keeper, type
 	nodesSubspace := sdk.NewSubspace(nodestypes.DefaultParamspace)
 	appSubspace := sdk.NewSubspace(types.DefaultParamspace)
 	ak := auth.NewKeeper(cdc, keyAcc, accSubspace, maccPerms)
-	nk := nodeskeeper.NewKeeper(cdc, nodesKey, ak, nodesSubspace, "pos")
+	nk := nodeskeeper.NewKeeper(cdc, nodesKey, ak, testPocketKeeper{}, nodesSubspace, "pos")
 	moduleManager := module.NewManager(
 		auth.NewAppModule(ak),
 		nodes.NewAppModule(nk),
@@ -99,7 +100,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Ctx, keeper.Keeper, type
 
 	initialCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, valTokens))
 	_ = createTestAccs(ctx, int(nAccs), initialCoins, &ak)
-	keeper := keeper.NewKeeper(cdc, appsKey, nk, ak, appSubspace, "apps")
+	keeper := keeper.NewKeeper(cdc, appsKey, nk, ak, testPocketKeeper{}, appSubspace, "apps")
 	p := types.DefaultParams()
 	keeper.SetParams(ctx, p)
 	return ctx, keeper, ak, nk
@@ -138,3 +139,18 @@ func getApplication() types.Application {
 		Chains:       []string{"00"},
 	}
 }
+
+// a pocket keeper supporting every chain, the chain registry is tested by the pocketcore module
+type testPocketKeeper struct{}
+
+func (testPocketKeeper) ValidateStakingChains(_ sdk.Ctx, _ []string) sdk.Error {
+	return nil
+}
+
+func (testPocketKeeper) ValidateLivenessProbe(_ sdk.Ctx, _ []byte, _ sdk.Address, _ string, _ []string, _ int64) sdk.Error {
+	return nil
+}
+
+func (testPocketKeeper) ClaimSessions(_ sdk.Ctx) int64 {
+	return 4
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/pokt-network/pocket-core/x/apps/keeper"
 	"github.com/pokt-network/pocket-core/x/apps/types"
+	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 )
 
@@ -15,6 +16,12 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
 	// set the parameters from the data
 	keeper.SetParams(ctx, data.Params)
+	// set the escrows of the prepaid applications before their relays are calculated
+	escrowedTokens := sdk.ZeroInt()
+	for _, escrow := range data.Escrows {
+		keeper.SetAppEscrow(ctx, escrow)
+		escrowedTokens = escrowedTokens.Add(escrow.Balance)
+	}
 	for _, application := range data.Applications {
 		if application.IsUnstaked() || application.IsUnstaking() {
 			panic(fmt.Sprintf("%v the applications must be staked at genesis", application))
@@ -28,6 +35,19 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			stakedTokens = stakedTokens.Add(application.GetTokens())
 		}
 	}
+	// set the aat revocations from the data
+	for _, revocation := range data.AATRevocations {
+		keeper.SetAATRevocation(ctx, revocation)
+	}
+	// the partial unstakes remain in the staked pool until released
+	for _, pu := range data.PartialUnstakes {
+		keeper.SetPartialUnstake(ctx, pu)
+		stakedTokens = stakedTokens.Add(pu.Amount)
+	}
+	// set the signing infos from the data
+	for _, info := range data.SigningInfos {
+		keeper.SetAppSigningInfo(ctx, info.Address, info)
+	}
 	stakedCoins := sdk.NewCoins(sdk.NewCoin(posKeeper.StakeDenom(ctx), stakedTokens))
 	// check if the staked pool accounts exists
 	stakedPool := keeper.GetStakedPool(ctx)
@@ -45,6 +65,23 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			panic(fmt.Sprintf("%s module account total does not equal the amount in each application account", types.StakedPoolName))
 		}
 	}
+	escrowedCoins := sdk.NewCoins(sdk.NewCoin(posKeeper.StakeDenom(ctx), escrowedTokens))
+	// check if the escrow pool account exists
+	escrowPool := keeper.GetEscrowPool(ctx)
+	if escrowPool == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.EscrowPoolName))
+	}
+	// add coins if not provided on genesis
+	if escrowPool.GetCoins().IsZero() {
+		if err := escrowPool.SetCoins(escrowedCoins); err != nil {
+			panic(err)
+		}
+		supplyKeeper.SetModuleAccount(ctx, escrowPool)
+	} else {
+		if !escrowPool.GetCoins().IsEqual(escrowedCoins) {
+			panic(fmt.Sprintf("%s module account total does not equal the amount in each application escrow", types.EscrowPoolName))
+		}
+	}
 	// set the params set in the keeper
 	keeper.Paramstore.SetParamSet(ctx, &data.Params)
 }
@@ -53,17 +90,29 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 	applications := keeper.GetAllApplications(ctx)
+	revocations := keeper.GetAllAATRevocations(ctx)
 	return types.GenesisState{
-		Params:       params,
-		Applications: applications,
-		Exported:     true,
+		Params:          params,
+		Applications:    applications,
+		AATRevocations:  revocations,
+		PartialUnstakes: keeper.GetAllPartialUnstakes(ctx),
+		SigningInfos:    keeper.GetAllAppSigningInfos(ctx),
+		Escrows:         keeper.GetAllAppEscrows(ctx),
+		Exported:        true,
 	}
 }
 
 // ValidateGenesis validates the provided staking genesis state to ensure the
 // expected invariants holds. (i.e. params in correct bounds, no duplicate applications)
 func ValidateGenesis(data types.GenesisState) error {
-	err := validateGenesisStateApplications(data.Applications, sdk.NewInt(data.Params.AppStakeMin))
+	prepaid := make(map[string]bool, len(data.Escrows))
+	for _, escrow := range data.Escrows {
+		if escrow.Address.Empty() || escrow.Balance.BigInt() == nil || escrow.Balance.IsNegative() {
+			return fmt.Errorf("escrow in genesis state is not valid: %v", escrow)
+		}
+		prepaid[escrow.Address.String()] = true
+	}
+	err := validateGenesisStateApplications(data.Applications, sdk.NewInt(data.Params.AppStakeMin), prepaid)
 	if err != nil {
 		return err
 	}
@@ -71,10 +120,28 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	for _, revocation := range data.AATRevocations {
+		if revocation.AppAddr.Empty() {
+			return fmt.Errorf("aat revocation in genesis state has an empty application address: %v", revocation)
+		}
+		if _, err := crypto.NewPublicKey(revocation.ClientPubKey); err != nil {
+			return fmt.Errorf("aat revocation in genesis state has an invalid client public key: %v", revocation)
+		}
+	}
+	for _, pu := range data.PartialUnstakes {
+		if pu.Address.Empty() || !pu.Amount.IsPositive() {
+			return fmt.Errorf("partial unstake in genesis state is not valid: %v", pu)
+		}
+	}
+	for _, info := range data.SigningInfos {
+		if info.Address.Empty() || info.MisbehaviorCounter < 0 || info.JailedCounter < 0 {
+			return fmt.Errorf("signing info in genesis state is not valid: %v", info)
+		}
+	}
 	return nil
 }
 
-func validateGenesisStateApplications(applications []types.Application, minimumStake sdk.Int) (err error) {
+func validateGenesisStateApplications(applications []types.Application, minimumStake sdk.Int, prepaid map[string]bool) (err error) {
 	addrMap := make(map[string]bool, len(applications))
 	for i := 0; i < len(applications); i++ {
 		app := applications[i]
@@ -82,16 +149,19 @@ func validateGenesisStateApplications(applications []types.Application, minimumS
 		if _, ok := addrMap[strKey]; ok {
 			return fmt.Errorf("duplicate application in genesis state: address %v", app.GetAddress())
 		}
-		if app.Jailed && app.IsStaked() {
-			return fmt.Errorf("application is staked and jailed in genesis state: address %v", app.GetAddress())
-		}
-		if app.StakedTokens.IsZero() && !app.IsUnstaked() {
-			return fmt.Errorf("staked/unstaked genesis application cannot have zero stake, application: %v", app)
+		// prepaid applications are staked without a stake and are jailed while paused
+		if !prepaid[app.Address.String()] {
+			if app.Jailed && app.IsStaked() {
+				return fmt.Errorf("application is staked and jailed in genesis state: address %v", app.GetAddress())
+			}
+			if app.StakedTokens.IsZero() && !app.IsUnstaked() {
+				return fmt.Errorf("staked/unstaked genesis application cannot have zero stake, application: %v", app)
+			}
+			if !app.IsUnstaked() && app.StakedTokens.LTE(minimumStake) {
+				return fmt.Errorf("application has less than minimum stake: %v", app)
+			}
 		}
 		addrMap[strKey] = true
-		if !app.IsUnstaked() && app.StakedTokens.LTE(minimumStake) {
-			return fmt.Errorf("application has less than minimum stake: %v", app)
-		}
 		for _, chain := range app.Chains {
 			err := types.ValidateNetworkIdentifier(chain)
 			if err != nil {
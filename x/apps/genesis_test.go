@@ -19,7 +19,7 @@ func TestPos_InitGenesis(t *testing.T) {
 			context, keeper, supplyKeeper, posKeeper := createTestInput(t, true)
 			state := types.DefaultGenesisState()
 			InitGenesis(context, keeper, supplyKeeper, posKeeper, state)
-			if got := keeper.GetParams(context); got != state.Params {
+			if got := keeper.GetParams(context); !got.Equal(state.Params) {
 				t.Errorf("InitGenesis()= got %v, want %v", got, state.Params)
 			}
 		})
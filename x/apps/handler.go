@@ -17,6 +17,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBeginUnstake(ctx, msg, k)
 		case types.MsgAppUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case types.MsgAppRevokeAAT:
+			return handleMsgRevokeAAT(ctx, msg, k)
+		case types.MsgAppEditStake:
+			return handleMsgEditStake(ctx, msg, k)
+		case types.MsgAppPartialUnstake:
+			return handleMsgPartialUnstake(ctx, msg, k)
+		case types.MsgAppDeposit:
+			return handleMsgDeposit(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -61,6 +69,34 @@ func handleStake(ctx sdk.Ctx, msg types.MsgAppStake, k keeper.Keeper) sdk.Result
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgEditStake(ctx sdk.Ctx, msg types.MsgAppEditStake, k keeper.Keeper) sdk.Result {
+	address := sdk.Address(msg.PubKey.Address())
+	// create the edited application object using the message fields
+	application := types.NewApplication(address, msg.PubKey, msg.Chains, sdk.ZeroInt())
+	// check if they can edit the stake
+	if err := k.ValidateApplicationEditStake(ctx, application, msg.Value); err != nil {
+		return err.Result()
+	}
+	if err := k.EditStakeApplication(ctx, application, msg.Value); err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWaitingToEdit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Value.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginAppUnstake, k keeper.Keeper) sdk.Result {
 	ctx.Logger().Info("Begin Unstaking App Message received from " + msg.Address.String())
 	// move coins from the msg.Address account to a (self-delegation) delegator account
@@ -93,6 +129,63 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginAppUnstake, k keeper.K
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgPartialUnstake(ctx sdk.Ctx, msg types.MsgAppPartialUnstake, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Partial Unstake App Message received from " + msg.Address.String())
+	application, found := k.GetApplication(ctx, msg.Address)
+	if !found {
+		return types.ErrNoApplicationFound(k.Codespace()).Result()
+	}
+	if err := k.ValidateApplicationPartialUnstake(ctx, application, msg.Amount); err != nil {
+		return err.Result()
+	}
+	completionTime := k.PartialUnstakeApplication(ctx, application, msg.Amount)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePartialUnstake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgDeposit(ctx sdk.Ctx, msg types.MsgAppDeposit, k keeper.Keeper) sdk.Result {
+	address := sdk.Address(msg.PubKey.Address())
+	ctx.Logger().Info("Deposit App Message received from " + address.String())
+	// create the prepaid application object using the message fields
+	application := types.NewApplication(address, msg.PubKey, msg.Chains, sdk.ZeroInt())
+	// check if they can deposit
+	if err := k.ValidateApplicationDeposit(ctx, application, msg.Amount); err != nil {
+		return err.Result()
+	}
+	if err := k.DepositApplication(ctx, application, msg.Amount); err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 // Applications must submit a transaction to unjail itself after todo
 // having been jailed (and thus unstaked) for downtime
 func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgAppUnjail, k keeper.Keeper) sdk.Result {
@@ -110,3 +203,24 @@ func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgAppUnjail, k keeper.Keeper) sdk.R
 	)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Applications submit a transaction to revoke every aat issued to a (leaked) client public key
+func handleMsgRevokeAAT(ctx sdk.Ctx, msg types.MsgAppRevokeAAT, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Revoke AAT Message received from " + msg.AppAddr.String())
+	if err := k.RevokeAAT(ctx, msg.AppAddr, msg.ClientPubKey); err != nil {
+		return err.Result()
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeAAT,
+			sdk.NewAttribute(types.AttributeKeyApplication, msg.AppAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyClientPubKey, msg.ClientPubKey),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.AppAddr.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
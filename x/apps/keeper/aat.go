@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// get the revocation of the client public key by the application
+func (k Keeper) GetAATRevocation(ctx sdk.Ctx, appAddr sdk.Address, clientPubKey string) (revocation types.AATRevocation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.KeyForAATRevocation(appAddr, clientPubKey))
+	if value == nil {
+		return revocation, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &revocation)
+	return revocation, true
+}
+
+// set the revocation of the client public key by the application
+func (k Keeper) SetAATRevocation(ctx sdk.Ctx, revocation types.AATRevocation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(revocation)
+	store.Set(types.KeyForAATRevocation(revocation.AppAddr, revocation.ClientPubKey), bz)
+}
+
+// get the set of all aat revocations from the main store
+func (k Keeper) GetAllAATRevocations(ctx sdk.Ctx) (revocations types.AATRevocations) {
+	revocations = make(types.AATRevocations, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.AATRevocationKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var revocation types.AATRevocation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &revocation)
+		revocations = append(revocations, revocation)
+	}
+	return revocations
+}
+
+// revoke every application authentication token issued by the application to the client public key
+func (k Keeper) RevokeAAT(ctx sdk.Ctx, appAddr sdk.Address, clientPubKey string) sdk.Error {
+	if _, found := k.GetApplication(ctx, appAddr); !found {
+		return types.ErrNoApplicationFound(k.codespace)
+	}
+	if k.IsAATRevoked(ctx, appAddr, clientPubKey) {
+		return types.ErrAATAlreadyRevoked(k.codespace)
+	}
+	k.SetAATRevocation(ctx, types.AATRevocation{
+		AppAddr:      appAddr,
+		ClientPubKey: clientPubKey,
+		Height:       ctx.BlockHeight(),
+	})
+	return nil
+}
+
+// returns true if the application revoked the tokens of the client public key
+// the state of the context determines whether the revocation is in effect, so the
+// revocation applies to relays from the next block and to the proofs of sessions started after it
+func (k Keeper) IsAATRevoked(ctx sdk.Ctx, appAddr sdk.Address, clientPubKey string) bool {
+	_, found := k.GetAATRevocation(ctx, appAddr, clientPubKey)
+	return found
+}
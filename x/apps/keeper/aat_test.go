@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAAT_RevokeAAT(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	clientPubKey := crypto.GenerateEd25519PrivKey().PublicKey().RawString()
+	// the application must exist
+	assert.NotNil(t, keeper.RevokeAAT(context, application.Address, clientPubKey))
+	keeper.SetApplication(context, application)
+	assert.False(t, keeper.IsAATRevoked(context, application.Address, clientPubKey))
+	assert.Nil(t, keeper.RevokeAAT(context, application.Address, clientPubKey))
+	assert.True(t, keeper.IsAATRevoked(context, application.Address, clientPubKey))
+	// the revocation is only for the client public key
+	assert.False(t, keeper.IsAATRevoked(context, application.Address, crypto.GenerateEd25519PrivKey().PublicKey().RawString()))
+	// can't revoke twice
+	assert.NotNil(t, keeper.RevokeAAT(context, application.Address, clientPubKey))
+	revocation, found := keeper.GetAATRevocation(context, application.Address, clientPubKey)
+	assert.True(t, found)
+	assert.Equal(t, context.BlockHeight(), revocation.Height)
+	revocations := keeper.GetAllAATRevocations(context)
+	assert.Len(t, revocations, 1)
+	assert.Equal(t, revocation, revocations[0])
+}
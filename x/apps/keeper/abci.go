@@ -9,10 +9,18 @@ import (
 func BeginBlocker(ctx sdk.Ctx, _ abci.RequestBeginBlock, k Keeper) {
 	// burn applications triggered by the custom burning interface
 	k.burnApplications(ctx)
+	// recalculate the relays of the staked applications at the start of every session
+	if ctx.BlockHeight()%k.POSKeeper.BlocksPerSession(ctx) == 1 {
+		k.UpdateAppRelays(ctx)
+	}
 }
 
 // Called every block, update application set
 func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
+	// apply the edited chains one block before the new session
+	if ctx.BlockHeight()%k.POSKeeper.BlocksPerSession(ctx) == 0 {
+		k.ReleaseWaitingEditStakes(ctx)
+	}
 	matureApplications := k.getMatureApplications(ctx)
 	// Unstake all mature applications from the unstakeing queue.
 	k.unstakeAllMatureApplications(ctx)
@@ -24,5 +32,7 @@ func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 			),
 		)
 	}
+	// Release all mature partial unstakes from the partial unstakes queue.
+	k.releaseAllMaturePartialUnstakes(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -77,3 +77,32 @@ func (k Keeper) IterateAndExecuteOverStakedApps(
 		}
 	}
 }
+
+// store the edited chains of an application until the end of the session
+func (k Keeper) SetWaitingEditStake(ctx sdk.Ctx, application types.Application) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForAppWaitingToEdit(application.Address), types.MustMarshalApplication(k.cdc, application))
+}
+
+// is the application waiting to edit its chains
+func (k Keeper) IsWaitingEditStake(ctx sdk.Ctx, address sdk.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyForAppWaitingToEdit(address))
+}
+
+// get all of the applications waiting to edit their chains
+func (k Keeper) GetWaitingEditStakes(ctx sdk.Ctx) (applications []types.Application) {
+	applications = make([]types.Application, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.WaitingToEditKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		applications = append(applications, types.MustUnmarshalApplication(k.cdc, iterator.Value()))
+	}
+	return applications
+}
+
+func (k Keeper) DeleteWaitingEditStake(ctx sdk.Ctx, address sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForAppWaitingToEdit(address))
+}
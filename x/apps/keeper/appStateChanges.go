@@ -6,6 +6,7 @@ import (
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/tendermint/tendermint/libs/common"
+	"time"
 )
 
 // validate check called before staking
@@ -23,32 +24,41 @@ func (k Keeper) ValidateApplicationStaking(ctx sdk.Ctx, application types.Applic
 		if app.IsJailed() {
 			return types.ErrApplicationJailed(k.codespace)
 		}
-	} else {
-		// ensure public key type is supported
-		if ctx.ConsensusParams() != nil {
-			tmPubKey, err := crypto.CheckConsensusPubKey(application.PublicKey.PubKey())
-			if err != nil {
-				return types.ErrApplicationPubKeyTypeNotSupported(k.Codespace(),
-					err.Error(),
-					ctx.ConsensusParams().Validator.PubKeyTypes)
-			}
-			if !common.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
-				return types.ErrApplicationPubKeyTypeNotSupported(k.Codespace(),
-					tmPubKey.Type,
-					ctx.ConsensusParams().Validator.PubKeyTypes)
-			}
-		}
+	} else if err := k.validateApplicationPubKeyType(ctx, application); err != nil {
+		return err
 	}
 	// ensure the amount they are staking is < the minimum stake amount
 	if amount.LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		return types.ErrMinimumStake(k.codespace)
 	}
+	// ensure the chains are supported and not deprecated in the chain registry
+	if err := k.PocketKeeper.ValidateStakingChains(ctx, application.Chains); err != nil {
+		return err
+	}
 	if !k.AccountsKeeper.HasCoins(ctx, application.Address, coin) {
 		return types.ErrNotEnoughCoins(k.codespace)
 	}
 	return nil
 }
 
+// ensure the public key type of a new application is supported
+func (k Keeper) validateApplicationPubKeyType(ctx sdk.Ctx, application types.Application) sdk.Error {
+	if ctx.ConsensusParams() != nil {
+		tmPubKey, err := crypto.CheckConsensusPubKey(application.PublicKey.PubKey())
+		if err != nil {
+			return types.ErrApplicationPubKeyTypeNotSupported(k.Codespace(),
+				err.Error(),
+				ctx.ConsensusParams().Validator.PubKeyTypes)
+		}
+		if !common.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
+			return types.ErrApplicationPubKeyTypeNotSupported(k.Codespace(),
+				tmPubKey.Type,
+				ctx.ConsensusParams().Validator.PubKeyTypes)
+		}
+	}
+	return nil
+}
+
 // store ops when a application stakes
 func (k Keeper) StakeApplication(ctx sdk.Ctx, application types.Application, amount sdk.Int) sdk.Error {
 	// send the coins from address to staked module account
@@ -66,19 +76,122 @@ func (k Keeper) StakeApplication(ctx sdk.Ctx, application types.Application, amo
 	k.SetApplication(ctx, application)
 	// save in the staked store
 	k.SetStakedApplication(ctx, application)
+	// ensure there's a signing info entry for the application (used in jailing)
+	if _, found := k.GetAppSigningInfo(ctx, application.Address); !found {
+		k.SetAppSigningInfo(ctx, application.Address, types.AppSigningInfo{
+			Address:     application.Address,
+			StartHeight: ctx.BlockHeight(),
+			JailedUntil: time.Unix(0, 0),
+		})
+	}
 	return nil
 }
 
+// validates an edit of the stake of an application
+// the stake can only be increased and the application must be staked and not jailed
+func (k Keeper) ValidateApplicationEditStake(ctx sdk.Ctx, application types.Application, amount sdk.Int) sdk.Error {
+	app, found := k.GetApplication(ctx, application.Address)
+	if !found {
+		return types.ErrNoApplicationFound(k.codespace)
+	}
+	if !app.IsStaked() {
+		return types.ErrApplicationStatus(k.codespace)
+	}
+	if app.IsJailed() {
+		return types.ErrApplicationJailed(k.codespace)
+	}
+	// prepaid applications edit their chains with a deposit
+	if k.IsPrepaid(ctx, app.Address) {
+		return types.ErrPrepaidApplication(k.codespace)
+	}
+	if amount.LT(app.StakedTokens) {
+		return types.ErrStakeDecrease(k.codespace)
+	}
+	// ensure the chains are supported and not deprecated in the chain registry
+	if err := k.PocketKeeper.ValidateStakingChains(ctx, application.Chains); err != nil {
+		return err
+	}
+	if diff := amount.Sub(app.StakedTokens); diff.IsPositive() {
+		coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), diff))
+		if !k.AccountsKeeper.HasCoins(ctx, application.Address, coin) {
+			return types.ErrNotEnoughCoins(k.codespace)
+		}
+	}
+	return nil
+}
+
+// store ops when an application edits its stake
+// the added stake and relays are applied immediately while the chains wait until the end of the session,
+// so an application can't leave a session in progress
+func (k Keeper) EditStakeApplication(ctx sdk.Ctx, application types.Application, amount sdk.Int) sdk.Error {
+	app, found := k.GetApplication(ctx, application.Address)
+	if !found {
+		return types.ErrNoApplicationFound(k.codespace)
+	}
+	if diff := amount.Sub(app.StakedTokens); diff.IsPositive() {
+		// send the added coins from address to staked module account
+		if err := k.coinsFromUnstakedToStaked(ctx, app, diff); err != nil {
+			return sdk.ErrInternal(err.Error())
+		}
+		k.deleteApplicationFromStakingSet(ctx, app)
+		app = app.AddStakedTokens(diff)
+		// recalculate relays
+		app.MaxRelays = k.CalculateAppRelays(ctx, app)
+		k.SetApplication(ctx, app)
+		k.SetStakedApplication(ctx, app)
+	}
+	if !sameChains(app.Chains, application.Chains) {
+		k.SetWaitingEditStake(ctx, application)
+		ctx.Logger().Info("Application " + application.Address.String() + " is waiting to edit its chains until session is over")
+	}
+	return nil
+}
+
+// applies the edited chains of the applications at the end of the session
+func (k Keeper) ReleaseWaitingEditStakes(ctx sdk.Ctx) {
+	for _, edit := range k.GetWaitingEditStakes(ctx) {
+		k.DeleteWaitingEditStake(ctx, edit.Address)
+		app, found := k.GetApplication(ctx, edit.Address)
+		// the edit is dropped if the application left the staked set in the meantime
+		if !found || !app.IsStaked() {
+			ctx.Logger().Error("Unable to edit the stake of application " + edit.Address.String() + ": the application is no longer staked")
+			continue
+		}
+		app.Chains = edit.Chains
+		k.SetApplication(ctx, app)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEditStake,
+				sdk.NewAttribute(types.AttributeKeyApplication, app.Address.String()),
+			),
+		)
+	}
+}
+
+// returns true if both lists contain the same chains in the same order
+func sameChains(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (k Keeper) ValidateApplicationBeginUnstaking(ctx sdk.Ctx, application types.Application) sdk.Error {
 	// must be staked to begin unstaking
 	if !application.IsStaked() {
 		return types.ErrApplicationStatus(k.codespace)
 	}
-	if application.IsJailed() {
+	// a prepaid application paused for running out of balance can still unstake, unless jailed for misbehavior
+	if application.IsJailed() && !k.isPausedOnly(ctx, application.Address) {
 		return types.ErrApplicationJailed(k.codespace)
 	}
-	// sanity check
-	if application.StakedTokens.LT(sdk.NewInt(k.MinimumStake(ctx))) {
+	// sanity check, prepaid applications have no stake
+	if !k.IsPrepaid(ctx, application.Address) && application.StakedTokens.LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		panic("should not happen: application trying to begin unstaking has less than the minimum stake")
 	}
 	return nil
@@ -90,6 +203,10 @@ func (k Keeper) BeginUnstakingApplication(ctx sdk.Ctx, application types.Applica
 	params := k.GetParams(ctx)
 	// delete the application from the staking set, as it is technically staked but not going to participate
 	k.deleteApplicationFromStakingSet(ctx, application)
+	// a paused prepaid application is released from jail so it can finish unstaking
+	if application.IsJailed() && k.isPausedOnly(ctx, application.Address) {
+		application.Jailed = false
+	}
 	// set the status
 	application = application.UpdateStatus(sdk.Unstaking)
 	// set the unstaking completion time and completion height appropriately
@@ -103,6 +220,47 @@ func (k Keeper) BeginUnstakingApplication(ctx sdk.Ctx, application types.Applica
 	ctx.Logger().Info("Began unstaking App " + application.Address.String())
 }
 
+// validates the partial unstake of an application
+// the stake left after the unstake must stay above the minimum
+func (k Keeper) ValidateApplicationPartialUnstake(ctx sdk.Ctx, application types.Application, amount sdk.Int) sdk.Error {
+	if !application.IsStaked() {
+		return types.ErrApplicationStatus(k.codespace)
+	}
+	if application.IsJailed() {
+		return types.ErrApplicationJailed(k.codespace)
+	}
+	if k.IsPrepaid(ctx, application.Address) {
+		return types.ErrPrepaidApplication(k.codespace)
+	}
+	if amount.GT(application.StakedTokens) {
+		return types.ErrNotEnoughStake(k.codespace)
+	}
+	if application.StakedTokens.Sub(amount).LT(sdk.NewInt(k.MinimumStake(ctx))) {
+		return types.ErrMinimumStake(k.codespace)
+	}
+	return nil
+}
+
+// store ops when an application partially unstakes -> starts the unstaking timer for the amount
+func (k Keeper) PartialUnstakeApplication(ctx sdk.Ctx, application types.Application, amount sdk.Int) (completionTime time.Time) {
+	// update the staking set with the lowered stake
+	k.deleteApplicationFromStakingSet(ctx, application)
+	application = application.RemoveStakedTokens(amount)
+	// recalculate relays
+	application.MaxRelays = k.CalculateAppRelays(ctx, application)
+	k.SetApplication(ctx, application)
+	k.SetStakedApplication(ctx, application)
+	// the tokens remain in the staked pool until the unstaking time is up
+	completionTime = ctx.BlockHeader().Time.Add(k.GetParams(ctx).UnstakingTime)
+	k.SetPartialUnstake(ctx, types.PartialUnstake{
+		Address:        application.Address,
+		Amount:         amount,
+		CompletionTime: completionTime,
+	})
+	ctx.Logger().Info("Began partially unstaking " + amount.String() + " of application " + application.Address.String())
+	return completionTime
+}
+
 func (k Keeper) ValidateApplicationFinishUnstaking(ctx sdk.Ctx, application types.Application) sdk.Error {
 	if !application.IsUnstaking() {
 		return types.ErrApplicationStatus(k.codespace)
@@ -110,8 +268,8 @@ func (k Keeper) ValidateApplicationFinishUnstaking(ctx sdk.Ctx, application type
 	if application.IsJailed() {
 		return types.ErrApplicationJailed(k.codespace)
 	}
-	// sanity check
-	if application.StakedTokens.LT(sdk.NewInt(k.MinimumStake(ctx))) {
+	// sanity check, prepaid applications have no stake
+	if !k.IsPrepaid(ctx, application.Address) && application.StakedTokens.LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		panic("should not happen: application trying to begin unstaking has less than the minimum stake")
 	}
 	return nil
@@ -133,6 +291,8 @@ func (k Keeper) FinishUnstakingApplication(ctx sdk.Ctx, application types.Applic
 	application.MaxRelays = sdk.ZeroInt()
 	// update the application in the main store
 	k.SetApplication(ctx, application)
+	// return the balance left to a prepaid application
+	k.refundAppEscrow(ctx, application.Address)
 	ctx.Logger().Info("Finished unstaking application " + application.Address.String())
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -166,6 +326,8 @@ func (k Keeper) ForceApplicationUnstake(ctx sdk.Ctx, application types.Applicati
 	application.MaxRelays = sdk.ZeroInt()
 	// set the application in store
 	k.SetApplication(ctx, application)
+	// return the balance left to a prepaid application
+	k.refundAppEscrow(ctx, application.Address)
 	ctx.Logger().Info("Force Unstaked application " + application.Address.String())
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -201,18 +363,30 @@ func (k Keeper) ValidateUnjailMessage(ctx sdk.Ctx, msg types.MsgAppUnjail) (addr
 	if application == nil {
 		return nil, types.ErrNoApplicationForAddress(k.Codespace())
 	}
-	// cannot be unjailed if not staked
-	stake := application.GetTokens()
-	if stake == sdk.ZeroInt() {
-		return nil, types.ErrMissingAppStake(k.Codespace())
-	}
-	if application.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) { // TODO look into this state change (stuck in jail)
-		return nil, types.ErrStakeTooLow(k.Codespace())
+	if escrow, found := k.GetAppEscrow(ctx, msg.AppAddr); found {
+		// a prepaid application paused for running out of balance is resumed by a deposit
+		if escrow.Paused {
+			return nil, types.ErrEscrowPaused(k.Codespace())
+		}
+	} else {
+		// cannot be unjailed if not staked
+		stake := application.GetTokens()
+		if stake == sdk.ZeroInt() {
+			return nil, types.ErrMissingAppStake(k.Codespace())
+		}
+		if application.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) { // TODO look into this state change (stuck in jail)
+			return nil, types.ErrStakeTooLow(k.Codespace())
+		}
 	}
 	// cannot be unjailed if not jailed
 	if !application.IsJailed() {
 		return nil, types.ErrApplicationNotJailed(k.Codespace())
 	}
+	// cannot be unjailed until out of jail
+	if info, found := k.GetAppSigningInfo(ctx, msg.AppAddr); found && ctx.BlockHeader().Time.Before(info.JailedUntil) {
+		return nil, types.ErrApplicationJailed(k.Codespace())
+	}
+	addr = application.GetAddress()
 	return
 }
 
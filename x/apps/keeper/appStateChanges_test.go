@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestAppStateChange_ValidateApplicaitonBeginUnstaking(t *testing.T) {
@@ -248,3 +249,147 @@ func TestAppStateChange_BeginUnstakingApplication(t *testing.T) {
 		})
 	}
 }
+
+func TestAppStateChange_ValidateApplicationEditStake(t *testing.T) {
+	tests := []struct {
+		name        string
+		application types.Application
+		amount      sdk.Int
+		want        interface{}
+	}{
+		{
+			name:        "validates edit",
+			application: getStakedApplication(),
+			amount:      sdk.NewInt(100000000001),
+			want:        nil,
+		},
+		{
+			name:        "errors if stake decreases",
+			application: getStakedApplication(),
+			amount:      sdk.NewInt(1),
+			want:        types.ErrStakeDecrease("apps"),
+		},
+		{
+			name:        "errors if not staked",
+			application: getUnstakedApplication(),
+			amount:      sdk.NewInt(100000000001),
+			want:        types.ErrApplicationStatus("apps"),
+		},
+		{
+			name:        "errors bank does not have enough coins",
+			application: getStakedApplication(),
+			amount:      sdk.NewInt(1000000000000000000),
+			want:        types.ErrNotEnoughCoins("apps"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			context, _, keeper := createTestInput(t, true)
+			addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+			sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, tt.application.Address, sdk.NewInt(100))
+			keeper.SetApplication(context, tt.application)
+			if got := keeper.ValidateApplicationEditStake(context, tt.application, tt.amount); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AppStateChange.ValidateApplicationEditStake() = got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppStateChange_EditStakeApplication(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, application.Address, sdk.NewInt(100))
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	edit := application
+	edit.Chains = []string{"01"}
+	if err := keeper.EditStakeApplication(context, edit, application.StakedTokens.Add(sdk.NewInt(100))); err != nil {
+		t.Fatalf("AppStateChanges.EditStakeApplication() = unexpected error %v", err)
+	}
+	got, _ := keeper.GetApplication(context, application.Address)
+	if !got.StakedTokens.Equal(application.StakedTokens.Add(sdk.NewInt(100))) {
+		t.Errorf("AppStateChanges.EditStakeApplication() = did not add stake %v", got.StakedTokens)
+	}
+	if !reflect.DeepEqual(got.Chains, application.Chains) || !keeper.IsWaitingEditStake(context, application.Address) {
+		t.Errorf("AppStateChanges.EditStakeApplication() = chains edited before the end of the session %v", got.Chains)
+	}
+	keeper.ReleaseWaitingEditStakes(context)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if !reflect.DeepEqual(got.Chains, edit.Chains) || keeper.IsWaitingEditStake(context, application.Address) {
+		t.Errorf("AppStateChanges.ReleaseWaitingEditStakes() = got %v, want %v", got.Chains, edit.Chains)
+	}
+}
+
+func TestAppStateChange_ValidateApplicationPartialUnstake(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	excess := getStakedApplication().StakedTokens.Sub(sdk.NewInt(keeper.MinimumStake(context)))
+	tests := []struct {
+		name        string
+		application types.Application
+		amount      sdk.Int
+		want        interface{}
+	}{
+		{
+			name:        "validates partial unstake",
+			application: getStakedApplication(),
+			amount:      excess,
+			want:        nil,
+		},
+		{
+			name:        "errors if the stake left is below the minimum",
+			application: getStakedApplication(),
+			amount:      excess.Add(sdk.OneInt()),
+			want:        types.ErrMinimumStake("apps"),
+		},
+		{
+			name:        "errors if the amount is greater than the stake",
+			application: getStakedApplication(),
+			amount:      getStakedApplication().StakedTokens.Add(sdk.OneInt()),
+			want:        types.ErrNotEnoughStake("apps"),
+		},
+		{
+			name:        "errors if not staked",
+			application: getUnstakingApplication(),
+			amount:      sdk.OneInt(),
+			want:        types.ErrApplicationStatus("apps"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keeper.ValidateApplicationPartialUnstake(context, tt.application, tt.amount); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AppStateChange.ValidateApplicationPartialUnstake() = got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppStateChange_PartialUnstakeApplication(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	amount := sdk.NewInt(100)
+	completionTime := keeper.PartialUnstakeApplication(context, application, amount)
+	got, _ := keeper.GetApplication(context, application.Address)
+	if !got.StakedTokens.Equal(application.StakedTokens.Sub(amount)) || !got.IsStaked() {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = did not remove stake %v", got.StakedTokens)
+	}
+	if !got.MaxRelays.Equal(keeper.CalculateAppRelays(context, got)) {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = did not recalculate relays %v", got.MaxRelays)
+	}
+	// the tokens are not released before the completion time
+	keeper.releaseAllMaturePartialUnstakes(context)
+	if balance := keeper.AccountsKeeper.GetCoins(context, application.Address).AmountOf(keeper.StakeDenom(context)); !balance.IsZero() {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = released before completion %v", balance)
+	}
+	context = context.WithBlockTime(completionTime.Add(time.Second))
+	keeper.releaseAllMaturePartialUnstakes(context)
+	if balance := keeper.AccountsKeeper.GetCoins(context, application.Address).AmountOf(keeper.StakeDenom(context)); !balance.Equal(amount) {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = got %v, want %v", balance, amount)
+	}
+	if len(keeper.GetAllPartialUnstakes(context)) != 0 {
+		t.Errorf("AppStateChanges.PartialUnstakeApplication() = partial unstake not removed from the queue")
+	}
+}
@@ -115,3 +115,76 @@ func (k Keeper) unstakeAllMatureApplications(ctx sdk.Ctx) {
 		store.Delete(unstakingApplicationsIterator.Key())
 	}
 }
+
+// Insert a partial unstake to the appropriate position in the partial unstakes queue
+func (k Keeper) SetPartialUnstake(ctx sdk.Ctx, partialUnstake types.PartialUnstake) {
+	entries := k.getPartialUnstakes(ctx, partialUnstake.CompletionTime)
+	entries = append(entries, partialUnstake)
+	k.setPartialUnstakes(ctx, partialUnstake.CompletionTime, entries)
+}
+
+// get all of the partial unstakes in the queue
+func (k Keeper) GetAllPartialUnstakes(ctx sdk.Ctx) (entries types.PartialUnstakes) {
+	entries = make(types.PartialUnstakes, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PartialUnstakesKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pus types.PartialUnstakes
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &pus)
+		entries = append(entries, pus...)
+	}
+	return entries
+}
+
+// gets all of the partial unstakes that complete at exactly this time
+func (k Keeper) getPartialUnstakes(ctx sdk.Ctx, completionTime time.Time) (entries types.PartialUnstakes) {
+	entries = make(types.PartialUnstakes, 0)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForPartialUnstakes(completionTime))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &entries)
+	return
+}
+
+// Sets partial unstakes in the queue at a certain completion time
+func (k Keeper) setPartialUnstakes(ctx sdk.Ctx, completionTime time.Time, entries types.PartialUnstakes) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(entries)
+	store.Set(types.KeyForPartialUnstakes(completionTime), bz)
+}
+
+// iterator for all partial unstakes up to a certain time
+func (k Keeper) partialUnstakesIterator(ctx sdk.Ctx, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.PartialUnstakesKey, sdk.InclusiveEndBytes(types.KeyForPartialUnstakes(endTime)))
+}
+
+// Releases all the partial unstakes that have finished their unstaking period
+func (k Keeper) releaseAllMaturePartialUnstakes(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := k.partialUnstakesIterator(ctx, ctx.BlockHeader().Time)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entries types.PartialUnstakes
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &entries)
+		for _, pu := range entries {
+			coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), pu.Amount))
+			err := k.AccountsKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, pu.Address, coins)
+			if err != nil {
+				panic(err)
+			}
+			ctx.Logger().Info("Finished partially unstaking " + pu.Amount.String() + " of application " + pu.Address.String())
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCompletePartialUnstake,
+					sdk.NewAttribute(types.AttributeKeyApplication, pu.Address.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, pu.Amount.String()),
+				),
+			)
+		}
+		store.Delete(iterator.Key())
+	}
+}
@@ -91,6 +91,14 @@ func (k Keeper) IterateAndExecuteOverApps(
 }
 
 func (k Keeper) CalculateAppRelays(ctx sdk.Ctx, application types.Application) sdk.Int {
+	// prepaid applications are allotted the relays their escrow can pay for, after the relays already claimed
+	if escrow, found := k.GetAppEscrow(ctx, application.Address); found {
+		relays := escrow.Balance.Quo(sdk.NewInt(k.PrepaidRelayPrice(ctx))).SubRaw(escrow.PendingRelays)
+		if relays.IsNegative() {
+			return sdk.ZeroInt()
+		}
+		return relays
+	}
 	stakingAdjustment := sdk.NewDec(k.StakingAdjustment(ctx))
 	participationRate := sdk.NewDec(1)
 	baseRate := sdk.NewInt(k.BaselineThroughputStakeRate(ctx))
@@ -104,3 +112,32 @@ func (k Keeper) CalculateAppRelays(ctx sdk.Ctx, application types.Application) s
 	baselineThroughput := basePercentage.Mul(application.StakedTokens.ToDec())
 	return participationRate.Mul(baselineThroughput).Add(stakingAdjustment).TruncateInt()
 }
+
+// recalculate the max relays of every staked application with the current parameters and state
+func (k Keeper) UpdateAppRelays(ctx sdk.Ctx) {
+	for _, application := range k.GetAllApplications(ctx) {
+		if !application.IsStaked() {
+			continue
+		}
+		maxRelays := k.CalculateAppRelays(ctx, application)
+		if maxRelays.Equal(application.MaxRelays) {
+			continue
+		}
+		previousMaxRelays := application.MaxRelays
+		application.MaxRelays = maxRelays
+		k.SetApplication(ctx, application)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMaxRelaysChange,
+				sdk.NewAttribute(types.AttributeKeyApplication, application.Address.String()),
+				sdk.NewAttribute(types.AttributeKeyPreviousMaxRelays, previousMaxRelays.String()),
+				sdk.NewAttribute(types.AttributeKeyMaxRelays, maxRelays.String()),
+			),
+		)
+	}
+}
+
+// preview the max relays an application would be allotted for a hypothetical stake
+func (k Keeper) PreviewAppRelays(ctx sdk.Ctx, stake sdk.Int) sdk.Int {
+	return k.CalculateAppRelays(ctx, types.Application{StakedTokens: stake})
+}
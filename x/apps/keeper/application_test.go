@@ -154,3 +154,48 @@ func TestApplication_IterateAndExecuteOverApps(t *testing.T) {
 		})
 	}
 }
+
+func TestApplication_UpdateAppRelays(t *testing.T) {
+	stakedApplication := getStakedApplication()
+	unstakedApplication := getUnstakedApplication()
+	context, _, keeper := createTestInput(t, true)
+	keeper.SetApplication(context, stakedApplication)
+	keeper.SetApplication(context, unstakedApplication)
+	// nothing changes when the parameters are unchanged
+	keeper.UpdateAppRelays(context)
+	if len(context.EventManager().Events()) != 0 {
+		t.Errorf("Application.UpdateAppRelays() emitted events without a change in allowance")
+	}
+	// governance halves the base relays per POKT
+	params := keeper.GetParams(context)
+	params.BaseRelaysPerPOKT = params.BaseRelaysPerPOKT / 2
+	keeper.SetParams(context, params)
+	keeper.UpdateAppRelays(context)
+	got, found := keeper.GetApplication(context, stakedApplication.Address)
+	if !found {
+		t.Fatalf("Application.UpdateAppRelays() staked application not found")
+	}
+	if want := stakedApplication.MaxRelays.QuoRaw(2); !got.MaxRelays.Equal(want) {
+		t.Errorf("Application.UpdateAppRelays() = got %v, want %v", got.MaxRelays, want)
+	}
+	got, found = keeper.GetApplication(context, unstakedApplication.Address)
+	if !found {
+		t.Fatalf("Application.UpdateAppRelays() unstaked application not found")
+	}
+	if !got.MaxRelays.Equal(unstakedApplication.MaxRelays) {
+		t.Errorf("Application.UpdateAppRelays() changed the relays of an unstaked application")
+	}
+	events := context.EventManager().Events()
+	if len(events) != 1 || events[0].Type != types.EventTypeMaxRelaysChange {
+		t.Errorf("Application.UpdateAppRelays() events = %v, want one %s event", events, types.EventTypeMaxRelaysChange)
+	}
+}
+
+func TestApplication_PreviewAppRelays(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	want := keeper.CalculateAppRelays(context, application)
+	if got := keeper.PreviewAppRelays(context, application.StakedTokens); !got.Equal(want) {
+		t.Errorf("Application.PreviewAppRelays() = got %v, want %v", got, want)
+	}
+}
@@ -81,6 +81,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Context, []auth.Account,
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName:     nil,
 		types.StakedPoolName:      {auth.Burner, auth.Staking, auth.Minter},
+		types.EscrowPoolName:      nil,
 		nodestypes.StakedPoolName: {auth.Burner, auth.Staking},
 		govTypes.DAOAccountName:   {auth.Burner, auth.Staking},
 	}
@@ -94,7 +95,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Context, []auth.Account,
 	nodesSubspace := sdk.NewSubspace(nodestypes.DefaultParamspace)
 	appSubspace := sdk.NewSubspace(DefaultParamspace)
 	ak := auth.NewKeeper(cdc, keyAcc, accSubspace, maccPerms)
-	nk := nodeskeeper.NewKeeper(cdc, nodesKey, ak, nodesSubspace, "pos")
+	nk := nodeskeeper.NewKeeper(cdc, nodesKey, ak, testPocketKeeper{}, nodesSubspace, "pos")
 	moduleManager := module.NewManager(
 		auth.NewAppModule(ak),
 		nodes.NewAppModule(nk),
@@ -103,7 +104,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Context, []auth.Account,
 	moduleManager.InitGenesis(ctx, genesisState)
 	initialCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, valTokens))
 	accs := createTestAccs(ctx, int(nAccs), initialCoins, &ak)
-	keeper := NewKeeper(cdc, appsKey, nk, ak, appSubspace, "apps")
+	keeper := NewKeeper(cdc, appsKey, nk, ak, testPocketKeeper{}, appSubspace, "apps")
 	p := types.DefaultParams()
 	keeper.SetParams(ctx, p)
 	return ctx, accs, keeper
@@ -198,3 +199,18 @@ type InvariantRegistry struct {
 func (_m *InvariantRegistry) RegisterRoute(moduleName string, route string, invar sdk.Invariant) {
 	_m.Called(moduleName, route, invar)
 }
+
+// a pocket keeper supporting every chain, the chain registry is tested by the pocketcore module
+type testPocketKeeper struct{}
+
+func (testPocketKeeper) ValidateStakingChains(_ sdk.Ctx, _ []string) sdk.Error {
+	return nil
+}
+
+func (testPocketKeeper) ValidateLivenessProbe(_ sdk.Ctx, _ []byte, _ sdk.Address, _ string, _ []string, _ int64) sdk.Error {
+	return nil
+}
+
+func (testPocketKeeper) ClaimSessions(_ sdk.Ctx) int64 {
+	return 4
+}
@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth/exported"
+)
+
+// get the escrow of a prepaid application by address
+func (k Keeper) GetAppEscrow(ctx sdk.Ctx, addr sdk.Address) (escrow types.AppEscrow, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForAppEscrow(addr))
+	if bz == nil {
+		found = false
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &escrow)
+	found = true
+	return
+}
+
+// set the escrow of a prepaid application
+func (k Keeper) SetAppEscrow(ctx sdk.Ctx, escrow types.AppEscrow) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(escrow)
+	store.Set(types.KeyForAppEscrow(escrow.Address), bz)
+}
+
+// delete the escrow of a prepaid application
+func (k Keeper) deleteAppEscrow(ctx sdk.Ctx, addr sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForAppEscrow(addr))
+}
+
+// get all of the application escrows
+func (k Keeper) GetAllAppEscrows(ctx sdk.Ctx) (escrows types.AppEscrows) {
+	escrows = make(types.AppEscrows, 0)
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AppEscrowKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var escrow types.AppEscrow
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &escrow)
+		escrows = append(escrows, escrow)
+	}
+	return
+}
+
+// returns true if the application pays for its relays from an escrow instead of a stake
+func (k Keeper) IsPrepaid(ctx sdk.Ctx, addr sdk.Address) bool {
+	_, found := k.GetAppEscrow(ctx, addr)
+	return found
+}
+
+// GetEscrowPool returns the escrow pool's module account
+func (k Keeper) GetEscrowPool(ctx sdk.Ctx) (escrowPool exported.ModuleAccountI) {
+	return k.AccountsKeeper.GetModuleAccount(ctx, types.EscrowPoolName)
+}
+
+// validate check called before depositing into the escrow of an application
+func (k Keeper) ValidateApplicationDeposit(ctx sdk.Ctx, application types.Application, amount sdk.Int) sdk.Error {
+	app, found := k.GetApplication(ctx, application.Address)
+	if found {
+		if k.IsPrepaid(ctx, app.Address) {
+			// a prepaid application can only top up its escrow while staked
+			if !app.IsStaked() {
+				return types.ErrApplicationStatus(k.codespace)
+			}
+		} else {
+			// a staked application must unstake before switching to prepaid
+			if !app.IsUnstaked() {
+				return types.ErrNotPrepaidApplication(k.codespace)
+			}
+			if app.IsJailed() {
+				return types.ErrApplicationJailed(k.codespace)
+			}
+		}
+	} else if err := k.validateApplicationPubKeyType(ctx, application); err != nil {
+		return err
+	}
+	// ensure the chains are supported and not deprecated in the chain registry
+	if err := k.PocketKeeper.ValidateStakingChains(ctx, application.Chains); err != nil {
+		return err
+	}
+	coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
+	if !k.AccountsKeeper.HasCoins(ctx, application.Address, coin) {
+		return types.ErrNotEnoughCoins(k.codespace)
+	}
+	return nil
+}
+
+// store ops when an application deposits into its escrow
+// the first deposit stakes the application without a stake, later deposits top up the escrow and edit the chains
+func (k Keeper) DepositApplication(ctx sdk.Ctx, application types.Application, amount sdk.Int) sdk.Error {
+	// send the coins from address to the escrow module account
+	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
+	err := k.AccountsKeeper.SendCoinsFromAccountToModule(ctx, application.Address, types.EscrowPoolName, coins)
+	if err != nil {
+		return err
+	}
+	escrow, found := k.GetAppEscrow(ctx, application.Address)
+	if !found {
+		escrow = types.AppEscrow{Address: application.Address, Balance: sdk.ZeroInt()}
+	}
+	escrow.Balance = escrow.Balance.Add(amount)
+	k.SetAppEscrow(ctx, escrow)
+	app, found := k.GetApplication(ctx, application.Address)
+	if !found || app.IsUnstaked() {
+		// the prepaid application is staked without any staked tokens
+		app = application.UpdateStatus(sdk.Staked)
+		app.MaxRelays = k.CalculateAppRelays(ctx, app)
+		k.SetApplication(ctx, app)
+		k.SetStakedApplication(ctx, app)
+		// ensure there's a signing info entry for the application (used in jailing)
+		if _, found := k.GetAppSigningInfo(ctx, app.Address); !found {
+			k.SetAppSigningInfo(ctx, app.Address, types.AppSigningInfo{
+				Address:     app.Address,
+				StartHeight: ctx.BlockHeight(),
+				JailedUntil: time.Unix(0, 0),
+			})
+		}
+	} else {
+		app.MaxRelays = k.CalculateAppRelays(ctx, app)
+		k.SetApplication(ctx, app)
+		// the chains wait until the end of the session, like an edit stake
+		if !sameChains(app.Chains, application.Chains) {
+			k.SetWaitingEditStake(ctx, application)
+		}
+	}
+	if escrow.Paused && escrow.Balance.GTE(sdk.NewInt(k.PrepaidRelayPrice(ctx))) {
+		k.resumeApplication(ctx, escrow)
+	}
+	return nil
+}
+
+// reserves the relays claimed by a servicer in the escrow of a prepaid application until the claim is proven or expires,
+// so the application isn't allotted relays its escrow already owes
+func (k Keeper) ReserveEscrowRelays(ctx sdk.Ctx, appAddr sdk.Address, relays int64) {
+	escrow, found := k.GetAppEscrow(ctx, appAddr)
+	if !found {
+		return
+	}
+	escrow.PendingRelays += relays
+	k.SetAppEscrow(ctx, escrow)
+	k.updateEscrowRelays(ctx, appAddr)
+}
+
+// releases the relays of a claim that expired without a proof from the escrow of a prepaid application
+func (k Keeper) ReleaseEscrowRelays(ctx sdk.Ctx, appAddr sdk.Address, relays int64) {
+	escrow, found := k.GetAppEscrow(ctx, appAddr)
+	if !found {
+		return
+	}
+	escrow.PendingRelays = releaseRelays(escrow.PendingRelays, relays)
+	k.SetAppEscrow(ctx, escrow)
+	k.updateEscrowRelays(ctx, appAddr)
+}
+
+// settles the proven relays of a prepaid application from its escrow and returns the payment
+// the payment is burned from the escrow, so the nodes module distributes it like the reward of a staked application
+// the escrow pays as much as its balance covers and the application is paused once it can't pay for another relay
+func (k Keeper) PayRelaysFromEscrow(ctx sdk.Ctx, appAddr sdk.Address, servicer sdk.Address, relays int64) sdk.Int {
+	escrow, found := k.GetAppEscrow(ctx, appAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	price := sdk.NewInt(k.PrepaidRelayPrice(ctx))
+	payment := price.MulRaw(relays)
+	if payment.GT(escrow.Balance) {
+		payment = escrow.Balance
+	}
+	if payment.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), payment))
+		// the escrow pool can't burn, so the payment is burned through the staked pool
+		if err := k.AccountsKeeper.SendCoinsFromModuleToModule(ctx, types.EscrowPoolName, types.StakedPoolName, coins); err != nil {
+			panic(err)
+		}
+		if err := k.AccountsKeeper.BurnCoins(ctx, types.StakedPoolName, coins); err != nil {
+			panic(err)
+		}
+		escrow.Balance = escrow.Balance.Sub(payment)
+	}
+	escrow.PendingRelays = releaseRelays(escrow.PendingRelays, relays)
+	k.SetAppEscrow(ctx, escrow)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEscrowPayment,
+			sdk.NewAttribute(types.AttributeKeyApplication, appAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, servicer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, payment.String()),
+			sdk.NewAttribute(types.AttributeKeyBalance, escrow.Balance.String()),
+		),
+	)
+	k.updateEscrowRelays(ctx, appAddr)
+	if !escrow.Paused && escrow.Balance.LT(price) {
+		k.pauseApplication(ctx, escrow)
+	}
+	return payment
+}
+
+// the relays of a prepaid application follow the balance left and the relays pending in its escrow
+func (k Keeper) updateEscrowRelays(ctx sdk.Ctx, appAddr sdk.Address) {
+	if app, found := k.GetApplication(ctx, appAddr); found {
+		app.MaxRelays = k.CalculateAppRelays(ctx, app)
+		k.SetApplication(ctx, app)
+	}
+}
+
+// removes the relays of a settled claim from the pending relays, which never go below zero
+func releaseRelays(pending, relays int64) int64 {
+	if relays >= pending {
+		return 0
+	}
+	return pending - relays
+}
+
+// pauses a prepaid application that ran out of balance by jailing it, so it's left out of new sessions
+func (k Keeper) pauseApplication(ctx sdk.Ctx, escrow types.AppEscrow) {
+	escrow.Paused = true
+	k.SetAppEscrow(ctx, escrow)
+	// an unstaking application is not jailed, as a jailed application can't finish unstaking
+	if app, found := k.GetApplication(ctx, escrow.Address); found && app.IsStaked() && !app.IsJailed() {
+		k.JailApplication(ctx, escrow.Address)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEscrowPause,
+			sdk.NewAttribute(types.AttributeKeyApplication, escrow.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyBalance, escrow.Balance.String()),
+		),
+	)
+}
+
+// resumes a paused prepaid application after a deposit
+// an application also jailed for misbehavior stays jailed until the jail duration is over and it unjails
+func (k Keeper) resumeApplication(ctx sdk.Ctx, escrow types.AppEscrow) {
+	escrow.Paused = false
+	k.SetAppEscrow(ctx, escrow)
+	if app, found := k.GetApplication(ctx, escrow.Address); found && app.IsJailed() {
+		if info, found := k.GetAppSigningInfo(ctx, escrow.Address); !found || !ctx.BlockHeader().Time.Before(info.JailedUntil) {
+			k.UnjailApplication(ctx, escrow.Address)
+		}
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEscrowResume,
+			sdk.NewAttribute(types.AttributeKeyApplication, escrow.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyBalance, escrow.Balance.String()),
+		),
+	)
+}
+
+// returns true if the application is a paused prepaid application that is not serving a jail duration for misbehavior
+func (k Keeper) isPausedOnly(ctx sdk.Ctx, addr sdk.Address) bool {
+	escrow, found := k.GetAppEscrow(ctx, addr)
+	if !found || !escrow.Paused {
+		return false
+	}
+	info, found := k.GetAppSigningInfo(ctx, addr)
+	return !found || !ctx.BlockHeader().Time.Before(info.JailedUntil)
+}
+
+// refunds the balance left in the escrow of a prepaid application that is no longer staked
+func (k Keeper) refundAppEscrow(ctx sdk.Ctx, addr sdk.Address) {
+	escrow, found := k.GetAppEscrow(ctx, addr)
+	if !found {
+		return
+	}
+	if escrow.Balance.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), escrow.Balance))
+		if err := k.AccountsKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowPoolName, addr, coins); err != nil {
+			panic(err)
+		}
+	}
+	k.deleteAppEscrow(ctx, addr)
+	ctx.Logger().Info(fmt.Sprintf("Refunded the escrow of %s to application %s", escrow.Balance, addr))
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+func getPrepaidApplication() types.Application {
+	application := getUnstakedApplication()
+	application.StakedTokens = sdk.ZeroInt()
+	application.MaxRelays = sdk.ZeroInt()
+	return application
+}
+
+func TestEscrow_ValidateApplicationDeposit(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	staked := getStakedApplication()
+	keeper.SetApplication(context, staked)
+	prepaid := getPrepaidApplication()
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, prepaid.Address, sdk.NewInt(100))
+	tests := []struct {
+		name        string
+		application types.Application
+		amount      sdk.Int
+		want        sdk.Error
+	}{
+		{
+			name:        "validates the deposit of a new application",
+			application: prepaid,
+			amount:      sdk.NewInt(100),
+		},
+		{
+			name:        "errors if not enough coins",
+			application: prepaid,
+			amount:      sdk.NewInt(101),
+			want:        types.ErrNotEnoughCoins(types.DefaultCodespace),
+		},
+		{
+			name:        "errors if the application is staked",
+			application: staked,
+			amount:      sdk.NewInt(100),
+			want:        types.ErrNotPrepaidApplication(types.DefaultCodespace),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keeper.ValidateApplicationDeposit(context, tt.application, tt.amount); got != nil && tt.want != nil {
+				if got.Code() != tt.want.Code() {
+					t.Errorf("Escrow.ValidateApplicationDeposit() = %v, want %v", got, tt.want)
+				}
+			} else if got != nil || tt.want != nil {
+				t.Errorf("Escrow.ValidateApplicationDeposit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscrow_DepositAndPay(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockTime(time.Now())
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	application := getPrepaidApplication()
+	price := sdk.NewInt(keeper.PrepaidRelayPrice(context))
+	deposit := price.MulRaw(10)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, application.Address, deposit.MulRaw(2))
+	// the first deposit stakes the application without a stake
+	if err := keeper.DepositApplication(context, application, deposit); err != nil {
+		t.Fatalf("Escrow.DepositApplication() = unexpected error %v", err)
+	}
+	got, found := keeper.GetApplication(context, application.Address)
+	if !found || !got.IsStaked() || !got.StakedTokens.IsZero() || !keeper.IsPrepaid(context, application.Address) {
+		t.Fatalf("Escrow.DepositApplication() = application not staked as prepaid %v", got)
+	}
+	if !got.MaxRelays.Equal(sdk.NewInt(10)) {
+		t.Errorf("Escrow.DepositApplication() = got max relays %v, want %v", got.MaxRelays, 10)
+	}
+	if !keeper.GetEscrowPool(context).GetCoins().AmountOf(keeper.StakeDenom(context)).Equal(deposit) {
+		t.Errorf("Escrow.DepositApplication() = coins not sent to the escrow pool")
+	}
+	// the relays are paid from the escrow and burned, so the nodes module distributes the payment
+	servicer := getRandomApplicationAddress()
+	supply := keeper.AccountsKeeper.GetSupply(context).GetTotal().AmountOf(keeper.StakeDenom(context))
+	paid := keeper.PayRelaysFromEscrow(context, application.Address, servicer, 4)
+	if !paid.Equal(price.MulRaw(4)) || !keeper.AccountsKeeper.GetCoins(context, servicer).IsZero() {
+		t.Errorf("Escrow.PayRelaysFromEscrow() = got %v, want %v", paid, price.MulRaw(4))
+	}
+	if !keeper.AccountsKeeper.GetSupply(context).GetTotal().AmountOf(keeper.StakeDenom(context)).Equal(supply.Sub(paid)) {
+		t.Errorf("Escrow.PayRelaysFromEscrow() = payment not burned")
+	}
+	got, _ = keeper.GetApplication(context, application.Address)
+	if !got.MaxRelays.Equal(sdk.NewInt(6)) {
+		t.Errorf("Escrow.PayRelaysFromEscrow() = got max relays %v, want %v", got.MaxRelays, 6)
+	}
+	// the escrow only pays what's left and the application is paused
+	paid = keeper.PayRelaysFromEscrow(context, application.Address, servicer, 8)
+	if !paid.Equal(price.MulRaw(6)) {
+		t.Errorf("Escrow.PayRelaysFromEscrow() = got %v, want %v", paid, price.MulRaw(6))
+	}
+	escrow, _ := keeper.GetAppEscrow(context, application.Address)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if !escrow.Paused || !escrow.Balance.IsZero() || !got.IsJailed() {
+		t.Errorf("Escrow.PayRelaysFromEscrow() = application not paused %v", escrow)
+	}
+	// a deposit resumes the application
+	if err := keeper.DepositApplication(context, application, deposit); err != nil {
+		t.Fatalf("Escrow.DepositApplication() = unexpected error %v", err)
+	}
+	escrow, _ = keeper.GetAppEscrow(context, application.Address)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if escrow.Paused || got.IsJailed() || !got.MaxRelays.Equal(sdk.NewInt(10)) {
+		t.Errorf("Escrow.DepositApplication() = application not resumed %v", escrow)
+	}
+}
+
+func TestEscrow_PendingRelays(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockTime(time.Now())
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	application := getPrepaidApplication()
+	price := sdk.NewInt(keeper.PrepaidRelayPrice(context))
+	deposit := price.MulRaw(10)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, application.Address, deposit)
+	if err := keeper.DepositApplication(context, application, deposit); err != nil {
+		t.Fatalf("Escrow.DepositApplication() = unexpected error %v", err)
+	}
+	// the claimed relays are not allotted again while the claim is unsettled
+	keeper.ReserveEscrowRelays(context, application.Address, 6)
+	got, _ := keeper.GetApplication(context, application.Address)
+	if !got.MaxRelays.Equal(sdk.NewInt(4)) {
+		t.Errorf("Escrow.ReserveEscrowRelays() = got max relays %v, want %v", got.MaxRelays, 4)
+	}
+	keeper.ReserveEscrowRelays(context, application.Address, 6)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if !got.MaxRelays.IsZero() {
+		t.Errorf("Escrow.ReserveEscrowRelays() = got max relays %v, want %v", got.MaxRelays, 0)
+	}
+	// an expired claim releases its relays
+	keeper.ReleaseEscrowRelays(context, application.Address, 6)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if !got.MaxRelays.Equal(sdk.NewInt(4)) {
+		t.Errorf("Escrow.ReleaseEscrowRelays() = got max relays %v, want %v", got.MaxRelays, 4)
+	}
+	// a proven claim settles its relays
+	keeper.PayRelaysFromEscrow(context, application.Address, getRandomApplicationAddress(), 6)
+	escrow, _ := keeper.GetAppEscrow(context, application.Address)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if escrow.PendingRelays != 0 || !got.MaxRelays.Equal(sdk.NewInt(4)) {
+		t.Errorf("Escrow.PayRelaysFromEscrow() = got pending relays %v and max relays %v, want %v and %v", escrow.PendingRelays, got.MaxRelays, 0, 4)
+	}
+}
+
+func TestEscrow_UnstakeRefund(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockTime(time.Now())
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	application := getPrepaidApplication()
+	price := sdk.NewInt(keeper.PrepaidRelayPrice(context))
+	deposit := price.MulRaw(10).AddRaw(1)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, application.Address, deposit)
+	if err := keeper.DepositApplication(context, application, deposit); err != nil {
+		t.Fatalf("Escrow.DepositApplication() = unexpected error %v", err)
+	}
+	// a paused application can still unstake
+	keeper.PayRelaysFromEscrow(context, application.Address, getRandomApplicationAddress(), 10)
+	got, _ := keeper.GetApplication(context, application.Address)
+	if err := keeper.ValidateApplicationBeginUnstaking(context, got); err != nil {
+		t.Fatalf("Escrow.ValidateApplicationBeginUnstaking() = unexpected error %v", err)
+	}
+	keeper.BeginUnstakingApplication(context, got)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if err := keeper.ValidateApplicationFinishUnstaking(context, got); err != nil {
+		t.Fatalf("Escrow.ValidateApplicationFinishUnstaking() = unexpected error %v", err)
+	}
+	keeper.FinishUnstakingApplication(context, got)
+	if keeper.IsPrepaid(context, application.Address) {
+		t.Errorf("Escrow.FinishUnstakingApplication() = escrow not deleted")
+	}
+	if balance := keeper.AccountsKeeper.GetCoins(context, application.Address).AmountOf(keeper.StakeDenom(context)); !balance.Equal(sdk.OneInt()) {
+		t.Errorf("Escrow.FinishUnstakingApplication() = got refund %v, want %v", balance, 1)
+	}
+}
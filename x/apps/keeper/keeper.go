@@ -20,6 +20,7 @@ type Keeper struct {
 	cdc                  *codec.Codec
 	AccountsKeeper       types.AuthKeeper
 	POSKeeper            types.PosKeeper
+	PocketKeeper         types.PocketKeeper
 	Paramstore           sdk.Subspace
 	applicationCache     map[string]cachedApplication
 	applicationCacheList *list.List
@@ -30,18 +31,26 @@ type Keeper struct {
 
 // NewKeeper creates a new staking Keeper instance
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, posKeeper types.PosKeeper, supplyKeeper types.AuthKeeper,
-	paramstore sdk.Subspace, codespace sdk.CodespaceType) Keeper {
+	pocketKeeper types.PocketKeeper, paramstore sdk.Subspace, codespace sdk.CodespaceType) Keeper {
 
 	// ensure staked module accounts are set
 	if addr := supplyKeeper.GetModuleAddress(types.StakedPoolName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.StakedPoolName))
 	}
+	if addr := supplyKeeper.GetModuleAddress(types.EscrowPoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.EscrowPoolName))
+	}
+	// ensure the chains of the stakes are validated
+	if pocketKeeper == nil {
+		panic("the pocket keeper validating the staked chains has not been set")
+	}
 
 	return Keeper{
 		storeKey:             key,
 		cdc:                  cdc,
 		AccountsKeeper:       supplyKeeper,
 		POSKeeper:            posKeeper,
+		PocketKeeper:         pocketKeeper,
 		Paramstore:           paramstore.WithKeyTable(ParamKeyTable()),
 		applicationCache:     make(map[string]cachedApplication, aminoCacheSize),
 		applicationCacheList: list.New(),
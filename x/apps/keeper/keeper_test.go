@@ -82,6 +82,7 @@ func TestKeepers_NewKeeper(t *testing.T) {
 			}
 			if !tt.panics {
 				maccPerms[types.StakedPoolName] = []string{auth.Burner, auth.Staking, auth.Minter}
+				maccPerms[types.EscrowPoolName] = nil
 			}
 
 			modAccAddrs := make(map[string]bool)
@@ -94,7 +95,7 @@ func TestKeepers_NewKeeper(t *testing.T) {
 			nodesSubspace := sdk.NewSubspace(nodestypes.DefaultParamspace)
 			appSubspace := sdk.NewSubspace(DefaultParamspace)
 			ak := auth.NewKeeper(cdc, keyAcc, accSubspace, maccPerms)
-			nk := nodeskeeper.NewKeeper(cdc, nodesKey, ak, nodesSubspace, "pos")
+			nk := nodeskeeper.NewKeeper(cdc, nodesKey, ak, testPocketKeeper{}, nodesSubspace, "pos")
 			moduleManager := module.NewManager(
 				auth.NewAppModule(ak),
 				nodes.NewAppModule(nk),
@@ -113,7 +114,7 @@ func TestKeepers_NewKeeper(t *testing.T) {
 					}
 				}()
 			}
-			_ = NewKeeper(cdc, appsKey, nk, ak, appSubspace, "apps")
+			_ = NewKeeper(cdc, appsKey, nk, ak, testPocketKeeper{}, appSubspace, "apps")
 		})
 	}
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/pokt-network/pocket-core/x/apps/types"
@@ -49,15 +50,58 @@ func (k Keeper) MinimumStake(ctx sdk.Ctx) (res int64) {
 	return
 }
 
+// the minimum time an application is jailed for misbehavior
+// the default until set, as a state from before the param doesn't hold it
+func (k Keeper) MinimumJailDuration(ctx sdk.Ctx) (res time.Duration) {
+	res = types.DefaultMinimumJailDuration
+	k.Paramstore.GetIfExists(ctx, types.KeyMinimumJailDuration, &res)
+	return
+}
+
+// the price of a relay debited from the escrow of a prepaid application
+func (k Keeper) PrepaidRelayPrice(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultPrepaidRelayPrice
+	k.Paramstore.GetIfExists(ctx, types.KeyPrepaidRelayPrice, &res)
+	return
+}
+
+// the # of servicers proving the misbehavior of an application in a session to jail it
+// the default until set, as a state from before the param doesn't hold it
+func (k Keeper) MisbehaviorReportThreshold(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultMisbehaviorReportThreshold
+	k.Paramstore.GetIfExists(ctx, types.KeyMisbehaviorThreshold, &res)
+	return
+}
+
+// the fees of the messages of the module
+// the default fees until set, as a state from before the param doesn't hold it
+func (k Keeper) MessageFees(ctx sdk.Ctx) (res types.MessageFees) {
+	res = append(types.MessageFees(nil), types.DefaultMessageFees...)
+	k.Paramstore.GetIfExists(ctx, types.KeyMessageFees, &res)
+	return
+}
+
+// the fee of the message type (in uPOKT), its default fee if the message is newer than the fees in state
+func (k Keeper) MessageFee(ctx sdk.Ctx, msgType string) sdk.Int {
+	if fee, found := k.MessageFees(ctx).Fee(msgType); found {
+		return sdk.NewInt(fee)
+	}
+	return types.DefaultMessageFees.Amount(msgType)
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
-		UnstakingTime:       k.UnStakingTime(ctx),
-		MaxApplications:     k.MaxApplications(ctx),
-		AppStakeMin:         k.MinimumStake(ctx),
-		BaseRelaysPerPOKT:   k.BaselineThroughputStakeRate(ctx),
-		ParticipationRateOn: k.ParticipationRateOn(ctx),
-		StabilityAdjustment: k.StakingAdjustment(ctx),
+		UnstakingTime:              k.UnStakingTime(ctx),
+		MaxApplications:            k.MaxApplications(ctx),
+		AppStakeMin:                k.MinimumStake(ctx),
+		BaseRelaysPerPOKT:          k.BaselineThroughputStakeRate(ctx),
+		ParticipationRateOn:        k.ParticipationRateOn(ctx),
+		StabilityAdjustment:        k.StakingAdjustment(ctx),
+		MinimumJailDuration:        k.MinimumJailDuration(ctx),
+		PrepaidRelayPrice:          k.PrepaidRelayPrice(ctx),
+		MisbehaviorReportThreshold: k.MisbehaviorReportThreshold(ctx),
+		MessageFees:                k.MessageFees(ctx),
 	}
 }
 
@@ -65,3 +109,35 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 func (k Keeper) SetParams(ctx sdk.Ctx, params types.Params) {
 	k.Paramstore.SetParamSet(ctx, &params)
 }
+
+// simulates the change of a param against the current state without committing it, reporting the affected applications
+func (k Keeper) SimulateParamChange(ctx sdk.Ctx, key string, value []byte) (types.ParamChangeReport, sdk.Error) {
+	params, _, err := k.GetParams(ctx).ChangeParam(key, value)
+	if err != nil {
+		return types.ParamChangeReport{}, types.ErrInvalidParamChange(k.codespace, err)
+	}
+	// the change is applied to a cached context, which is discarded
+	cacheCtx, _ := ctx.CacheContext()
+	k.SetParams(cacheCtx, params)
+	report := types.ParamChangeReport{ParamKey: key, Params: params, AffectedApps: make([]types.ParamChangeEffect, 0)}
+	minStake := sdk.NewInt(params.AppStakeMin)
+	for _, application := range k.GetAllApplications(cacheCtx) {
+		if !application.IsStaked() {
+			continue
+		}
+		// prepaid applications have no stake
+		if !k.IsPrepaid(cacheCtx, application.Address) && application.StakedTokens.LT(minStake) {
+			report.AffectedApps = append(report.AffectedApps, types.ParamChangeEffect{
+				Address: application.Address,
+				Effect:  fmt.Sprintf("the stake %s is below the minimum stake %s", application.StakedTokens, minStake),
+			})
+		}
+		if relays := k.CalculateAppRelays(cacheCtx, application); !relays.Equal(application.MaxRelays) {
+			report.AffectedApps = append(report.AffectedApps, types.ParamChangeEffect{
+				Address: application.Address,
+				Effect:  fmt.Sprintf("the max relays change from %s to %s", application.MaxRelays, relays),
+			})
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_SimulateParamChange(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	application.MaxRelays = keeper.CalculateAppRelays(context, application)
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	// raising the minimum stake above the stake of the application and doubling its relays
+	minStake := application.StakedTokens.Add(sdk.OneInt())
+	report, err := keeper.SimulateParamChange(context, string(types.KeyApplicationMinStake), []byte(`"`+minStake.String()+`"`))
+	if err != nil {
+		t.Fatalf("SimulateParamChange() = unexpected error %v", err)
+	}
+	if report.Params.AppStakeMin != minStake.Int64() || len(report.AffectedApps) != 1 {
+		t.Errorf("SimulateParamChange() = got %v, want the application below the minimum stake", report)
+	}
+	report, err = keeper.SimulateParamChange(context, string(types.BaseRelaysPerPOKT), []byte(`"`+sdk.NewInt(keeper.BaselineThroughputStakeRate(context)*2).String()+`"`))
+	if err != nil {
+		t.Fatalf("SimulateParamChange() = unexpected error %v", err)
+	}
+	if len(report.AffectedApps) != 1 {
+		t.Errorf("SimulateParamChange() = got %v, want the relays of the application changed", report)
+	}
+	// the change is not committed
+	if keeper.MinimumStake(context) != types.DefaultMinStake {
+		t.Errorf("SimulateParamChange() = the change was committed")
+	}
+	// an invalid change is rejected
+	if _, err := keeper.SimulateParamChange(context, string(types.KeyPrepaidRelayPrice), []byte(`"0"`)); err == nil {
+		t.Errorf("SimulateParamChange() = expected an error for invalid params")
+	}
+}
+
+func TestKeeper_ParamsDefaultUntilSet(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	// a state from before the params doesn't hold them
+	store := context.KVStore(sdk.ParamsKey)
+	for _, key := range [][]byte{types.KeyMinimumJailDuration, types.KeyPrepaidRelayPrice, types.KeyMisbehaviorThreshold, types.KeyMessageFees} {
+		store.Delete(append([]byte(keeper.Paramstore.Name()+"/"), key...))
+		assert.False(t, keeper.Paramstore.Has(context, key))
+	}
+	assert.Equal(t, types.DefaultMinimumJailDuration, keeper.MinimumJailDuration(context))
+	assert.Equal(t, types.DefaultPrepaidRelayPrice, keeper.PrepaidRelayPrice(context))
+	assert.Equal(t, types.DefaultMisbehaviorReportThreshold, keeper.MisbehaviorReportThreshold(context))
+	assert.Equal(t, types.DefaultMessageFees, keeper.MessageFees(context))
+	assert.Equal(t, types.DefaultParams(), keeper.GetParams(context))
+}
@@ -42,6 +42,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryStakedPool(ctx, k)
 		case types.QueryAppUnstakedPool:
 			return queryUnstakedPool(ctx, k)
+		case types.QueryAppRelays:
+			return queryAppRelays(ctx, req, k)
+		case types.QueryAppEscrow:
+			return queryAppEscrow(ctx, req, k)
+		case types.QueryParamChange:
+			return queryParamChange(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -109,3 +115,53 @@ func queryParameters(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
 	}
 	return res, nil
 }
+
+func queryAppRelays(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAppRelaysParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	if params.Stake == (sdk.Int{}) || params.Stake.IsNegative() {
+		return nil, types.ErrBadStakeAmount(types.DefaultCodespace)
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.PreviewAppRelays(ctx, params.Stake))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryAppEscrow(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAppParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	escrow, found := k.GetAppEscrow(ctx, params.Address)
+	if !found {
+		return nil, types.ErrNotPrepaidApplication(types.DefaultCodespace)
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, escrow)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryParamChange(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryParamChangeParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	report, er := k.SimulateParamChange(ctx, params.Key, []byte(params.Value))
+	if er != nil {
+		return nil, er
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, report)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/apps/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// get the signing info for the application by address
+func (k Keeper) GetAppSigningInfo(ctx sdk.Ctx, addr sdk.Address) (info types.AppSigningInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForAppSigningInfo(addr))
+	if bz == nil {
+		found = false
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &info)
+	found = true
+	return
+}
+
+// set the signing info for the application by address
+func (k Keeper) SetAppSigningInfo(ctx sdk.Ctx, addr sdk.Address, info types.AppSigningInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(info)
+	store.Set(types.KeyForAppSigningInfo(addr), bz)
+}
+
+// get all of the application signing infos
+func (k Keeper) GetAllAppSigningInfos(ctx sdk.Ctx) (infos types.AppSigningInfos) {
+	infos = make(types.AppSigningInfos, 0)
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AppSigningInfoKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.AppSigningInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &info)
+		infos = append(infos, info)
+	}
+	return
+}
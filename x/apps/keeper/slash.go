@@ -106,3 +106,56 @@ func (k Keeper) deleteApplicationBurn(ctx sdk.Ctx, address sdk.Address) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.KeyForAppBurn(address))
 }
+
+// track the misbehavior of the clients of an application proven by a servicer, and jail the application
+// once enough distinct servicers proved its misbehavior within the same session
+// the application cannot be unjailed before the minimum jail duration has passed
+func (k Keeper) HandleApplicationMisbehavior(ctx sdk.Ctx, addr, reporter sdk.Address, sessionBlockHeight, misbehaviors int64) {
+	application, found := k.GetApplication(ctx, addr)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("WARNING: Ignored attempt to jail a nonexistent application with address %s for misbehavior", addr))
+		return
+	}
+	if !application.IsStaked() {
+		k.Logger(ctx).Info(fmt.Sprintf("Ignored misbehavior of application %s, because it is not staked", addr))
+		return
+	}
+	info, found := k.GetAppSigningInfo(ctx, addr)
+	if !found {
+		info = types.AppSigningInfo{Address: addr, StartHeight: ctx.BlockHeight()}
+	}
+	// the reporters are only counted within a session
+	if info.ReportSessionHeight != sessionBlockHeight {
+		info.ReportSessionHeight = sessionBlockHeight
+		info.Reporters = nil
+	}
+	for _, r := range info.Reporters {
+		if r.Equals(reporter) {
+			k.Logger(ctx).Info(fmt.Sprintf("Ignored misbehavior of application %s, already proven by %s in the session", addr, reporter))
+			return
+		}
+	}
+	info.Reporters = append(info.Reporters, reporter)
+	info.MisbehaviorCounter += misbehaviors
+	threshold := k.MisbehaviorReportThreshold(ctx)
+	if threshold <= 0 || int64(len(info.Reporters)) < threshold {
+		k.SetAppSigningInfo(ctx, addr, info)
+		return
+	}
+	info.JailedUntil = ctx.BlockHeader().Time.Add(k.MinimumJailDuration(ctx))
+	if !application.IsJailed() {
+		k.JailApplication(ctx, addr)
+		info.JailedCounter++
+	}
+	// reset the reporters so the application won't be immediately jailed upon unjailing
+	info.Reporters = nil
+	k.SetAppSigningInfo(ctx, addr, info)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJail,
+			sdk.NewAttribute(types.AttributeKeyApplication, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyMisbehaviors, fmt.Sprintf("%d", misbehaviors)),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, info.JailedUntil.String()),
+		),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestGetAndSetApplicationBurn(t *testing.T) {
@@ -302,3 +303,57 @@ func TestBurnApplications(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleApplicationMisbehavior(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	application := getStakedApplication()
+	keeper.SetApplication(context, application)
+	keeper.SetStakedApplication(context, application)
+	threshold := keeper.MisbehaviorReportThreshold(context)
+	reporters := make([]sdk.Address, threshold)
+	for i := range reporters {
+		reporters[i] = getRandomApplicationAddress()
+	}
+	for _, reporter := range reporters[:threshold-1] {
+		keeper.HandleApplicationMisbehavior(context, application.Address, reporter, 1, 5)
+	}
+	// the same servicer is only counted once in a session
+	keeper.HandleApplicationMisbehavior(context, application.Address, reporters[0], 1, 5)
+	got, _ := keeper.GetApplication(context, application.Address)
+	if got.IsJailed() {
+		t.Errorf("HandleApplicationMisbehavior() = application jailed below the report threshold")
+	}
+	// the reporters of another session are not counted
+	keeper.HandleApplicationMisbehavior(context, application.Address, reporters[threshold-1], 5, 5)
+	got, _ = keeper.GetApplication(context, application.Address)
+	if got.IsJailed() {
+		t.Errorf("HandleApplicationMisbehavior() = application jailed by the reporters of different sessions")
+	}
+	for _, reporter := range reporters[:threshold-1] {
+		keeper.HandleApplicationMisbehavior(context, application.Address, reporter, 5, 5)
+	}
+	got, _ = keeper.GetApplication(context, application.Address)
+	if !got.IsJailed() {
+		t.Errorf("HandleApplicationMisbehavior() = application not jailed")
+	}
+	info, found := keeper.GetAppSigningInfo(context, application.Address)
+	if !found {
+		t.Fatalf("HandleApplicationMisbehavior() = signing info not found")
+	}
+	if want := 5 * (2*threshold - 1); info.MisbehaviorCounter != want || info.JailedCounter != 1 || len(info.Reporters) != 0 {
+		t.Errorf("HandleApplicationMisbehavior() = got %v", info)
+	}
+	if want := context.BlockHeader().Time.Add(keeper.MinimumJailDuration(context)); !info.JailedUntil.Equal(want) {
+		t.Errorf("HandleApplicationMisbehavior() = jailed until %v, want %v", info.JailedUntil, want)
+	}
+	// the application cannot be unjailed before the minimum jail duration has passed
+	msg := types.MsgAppUnjail{AppAddr: application.Address}
+	if _, err := keeper.ValidateUnjailMessage(context, msg); err == nil || err.Code() != types.CodeApplicationJailed {
+		t.Errorf("ValidateUnjailMessage() = got %v, want %v", err, types.ErrApplicationJailed(types.DefaultCodespace))
+	}
+	context = context.WithBlockTime(info.JailedUntil.Add(time.Second))
+	addr, err := keeper.ValidateUnjailMessage(context, msg)
+	if err != nil || !addr.Equals(application.Address) {
+		t.Errorf("ValidateUnjailMessage() = got %v, %v", addr, err)
+	}
+}
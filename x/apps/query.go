@@ -85,3 +85,48 @@ func QueryPOSParams(cdc *codec.Codec, tmNode client.Client, height int64) (types
 	cdc.MustUnmarshalJSON(bz, &params)
 	return params, nil
 }
+
+func QueryAppRelays(cdc *codec.Codec, tmNode client.Client, height int64, stake sdk.Int) (sdk.Int, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.QueryAppRelaysParams{Stake: stake})
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf(customQuery, types.StoreKey, types.QueryAppRelays), bz)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	var maxRelays sdk.Int
+	err = cdc.UnmarshalJSON(res, &maxRelays)
+	return maxRelays, err
+}
+
+func QueryAppEscrow(cdc *codec.Codec, tmNode client.Client, addr sdk.Address, height int64) (types.AppEscrow, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryAppParams(addr))
+	if err != nil {
+		return types.AppEscrow{}, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf(customQuery, types.StoreKey, types.QueryAppEscrow), bz)
+	if err != nil {
+		return types.AppEscrow{}, err
+	}
+	var escrow types.AppEscrow
+	err = cdc.UnmarshalJSON(res, &escrow)
+	return escrow, err
+}
+
+func QueryParamChange(cdc *codec.Codec, tmNode client.Client, height int64, key string, value []byte) (types.ParamChangeReport, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.QueryParamChangeParams{Key: key, Value: string(value)})
+	if err != nil {
+		return types.ParamChangeReport{}, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf(customQuery, types.StoreKey, types.QueryParamChange), bz)
+	if err != nil {
+		return types.ParamChangeReport{}, err
+	}
+	var report types.ParamChangeReport
+	err = cdc.UnmarshalJSON(res, &report)
+	return report, err
+}
@@ -28,6 +28,36 @@ func StakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chain
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
+func EditStakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chains []string, amount sdk.Int, kp keys.KeyPair, passphrase string) (*sdk.TxResponse, error) {
+	fromAddr := kp.GetAddress()
+	msg := types.MsgAppEditStake{
+		PubKey: kp.PublicKey,
+		Value:  amount, // the new total stake of the app
+		Chains: chains, // non native blockchains
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, fromAddr, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func DepositTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chains []string, amount sdk.Int, kp keys.KeyPair, passphrase string) (*sdk.TxResponse, error) {
+	fromAddr := kp.GetAddress()
+	msg := types.MsgAppDeposit{
+		PubKey: kp.PublicKey,
+		Chains: chains, // non native blockchains
+		Amount: amount, // the amount added to the escrow
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, fromAddr, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
 func UnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, passphrase string) (*sdk.TxResponse, error) {
 	msg := types.MsgBeginAppUnstake{Address: address}
 	txBuilder, cliCtx := newTx(cdc, msg, address, tmNode, keybase, passphrase)
@@ -38,6 +68,26 @@ func UnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, add
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
+func PartialUnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	msg := types.MsgAppPartialUnstake{Address: address, Amount: amount}
+	txBuilder, cliCtx := newTx(cdc, msg, address, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func RevokeAATTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, clientPubKey string, passphrase string) (*sdk.TxResponse, error) {
+	msg := types.MsgAppRevokeAAT{AppAddr: address, ClientPubKey: clientPubKey}
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, address, tmNode, keybase, passphrase)
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
 func newTx(cdc *codec.Codec, msg sdk.Msg, fromAddr sdk.Address, tmNode client.Client, keybase keys.Keybase, passphrase string) (txBuilder auth.TxBuilder, cliCtx util.CLIContext) {
 	genDoc, err := tmNode.Genesis()
 	if err != nil {
@@ -63,7 +113,15 @@ func newTx(cdc *codec.Codec, msg sdk.Msg, fromAddr sdk.Address, tmNode client.Cl
 	if err != nil {
 		panic(err)
 	}
+	// the fee of the message is a governance param
+	params, err := QueryPOSParams(cdc, tmNode, 0)
+	if err != nil {
+		panic(err)
+	}
 	fee := msg.GetFee()
+	if f, ok := params.MessageFees.Fee(msg.Type()); ok {
+		fee = sdk.NewInt(f)
+	}
 	if account.GetCoins().AmountOf(sdk.DefaultStakeDenom).LTE(fee) { // todo get stake denom
 		panic(fmt.Sprintf("insufficient funds: the fee needed is %v", fee))
 	}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// AATRevocation - the revocation of every application authentication token issued to a client public key
+type AATRevocation struct {
+	AppAddr      sdk.Address `json:"address" yaml:"address"`               // address of the application
+	ClientPubKey string      `json:"client_pub_key" yaml:"client_pub_key"` // the revoked client public key in hex
+	Height       int64       `json:"height" yaml:"height"`                 // the block height of the revocation
+}
+
+// AATRevocations - a slice of aat revocations
+type AATRevocations []AATRevocation
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pokt-network/posmint/crypto"
@@ -108,3 +109,25 @@ func (a Application) GetPublicKey() crypto.PublicKey { return a.PublicKey }
 func (a Application) GetTokens() sdk.Int             { return a.StakedTokens }
 func (a Application) GetConsensusPower() int64       { return a.ConsensusPower() }
 func (a Application) GetMaxRelays() sdk.Int          { return a.MaxRelays }
+
+// PartialUnstake - part of the stake of an application waiting to be released from the staked pool
+type PartialUnstake struct {
+	Address        sdk.Address `json:"address" yaml:"address"`                 // the address of the application
+	Amount         sdk.Int     `json:"amount" yaml:"amount"`                   // the amount of tokens unstaking
+	CompletionTime time.Time   `json:"completion_time" yaml:"completion_time"` // the time the tokens are released to the application
+}
+
+// String returns a human readable string representation of a partial unstake
+func (p PartialUnstake) String() string {
+	return fmt.Sprintf("Address:\t\t%s\nAmount:\t\t\t%s\nCompletion Time:\t%v\n", p.Address, p.Amount, p.CompletionTime)
+}
+
+// PartialUnstakes - a slice of partial unstakes
+type PartialUnstakes []PartialUnstake
+
+func (p PartialUnstakes) String() (out string) {
+	for _, pu := range p {
+		out += pu.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -9,6 +9,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAppStake{}, "apps/MsgAppStake", nil)
 	cdc.RegisterConcrete(MsgBeginAppUnstake{}, "apps/MsgAppBeginUnstake", nil)
 	cdc.RegisterConcrete(MsgAppUnjail{}, "apps/MsgAppUnjail", nil)
+	cdc.RegisterConcrete(MsgAppRevokeAAT{}, "apps/MsgAppRevokeAAT", nil)
+	cdc.RegisterConcrete(MsgAppEditStake{}, "apps/MsgAppEditStake", nil)
+	cdc.RegisterConcrete(MsgAppPartialUnstake{}, "apps/MsgAppPartialUnstake", nil)
+	cdc.RegisterConcrete(MsgAppDeposit{}, "apps/MsgAppDeposit", nil)
 }
 
 var ModuleCdc *codec.Codec // generic sealed codec to be used throughout this module
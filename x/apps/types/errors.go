@@ -22,6 +22,16 @@ const (
 	CodeInvalidStakeAmount    CodeType          = 115
 	CodeNoChains              CodeType          = 116
 	CodeInvalidNetworkID      CodeType          = 117
+	CodeInvalidClientPubKey   CodeType          = 118
+	CodeAATAlreadyRevoked     CodeType          = 119
+	CodeStakeDecrease         CodeType          = 120
+	CodeNotEnoughStake        CodeType          = 121
+	CodeNoSigningInfoFound    CodeType          = 122
+	CodeInvalidDepositAmount  CodeType          = 123
+	CodePrepaidApplication    CodeType          = 124
+	CodeNotPrepaidApplication CodeType          = 125
+	CodeEscrowPaused          CodeType          = 126
+	CodeInvalidParamChange    CodeType          = 127
 )
 
 func ErrNoChains(codespace sdk.CodespaceType) sdk.Error {
@@ -85,3 +95,43 @@ func ErrStakeTooLow(codespace sdk.CodespaceType) sdk.Error {
 func ErrInvalidNetworkIdentifier(codespace sdk.CodespaceType, err error) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidNetworkID, "the applications network identifier is not valid: "+err.Error())
 }
+
+func ErrInvalidClientPubKey(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClientPubKey, "the client public key is not valid: "+err.Error())
+}
+
+func ErrAATAlreadyRevoked(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAATAlreadyRevoked, "the application authentication tokens of the client public key are already revoked")
+}
+
+func ErrStakeDecrease(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeStakeDecrease, "the stake of an application can't be decreased by editing the stake, unstake instead")
+}
+
+func ErrNotEnoughStake(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNotEnoughStake, "the amount to unstake is greater than the stake of the application")
+}
+
+func ErrNoSigningInfoFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoSigningInfoFound, "no signing info found for the application")
+}
+
+func ErrBadDepositAmount(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDepositAmount, "the deposit amount is invalid")
+}
+
+func ErrPrepaidApplication(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodePrepaidApplication, "the application is prepaid, use a deposit to change the escrow or the chains")
+}
+
+func ErrNotPrepaidApplication(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNotPrepaidApplication, "the application is staked and can't deposit into an escrow, unstake first")
+}
+
+func ErrEscrowPaused(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEscrowPaused, "the application is paused for running out of escrow balance, deposit to resume")
+}
+
+func ErrInvalidParamChange(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamChange, "the param change is invalid: "+err.Error())
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// Escrow of a prepaid application, the proven relays are debited from the balance instead of being derived from a stake
+type AppEscrow struct {
+	Address       sdk.Address `json:"address" yaml:"address"`               // application address
+	Balance       sdk.Int     `json:"balance" yaml:"balance"`               // tokens left in the escrow
+	PendingRelays int64       `json:"pending_relays" yaml:"pending_relays"` // relays claimed by servicers that are not proven or expired yet
+	Paused        bool        `json:"paused" yaml:"paused"`                 // whether the application is paused for running out of balance
+}
+
+// Return human readable application escrow
+func (e AppEscrow) String() string {
+	return fmt.Sprintf(`Application Escrow:
+  Address: %s
+  Balance: %s
+  Pending Relays: %d
+  Paused:  %v`,
+		e.Address, e.Balance, e.PendingRelays, e.Paused)
+}
+
+type AppEscrows []AppEscrow
+
+// Return human readable application escrows
+func (es AppEscrows) String() (out string) {
+	for _, e := range es {
+		out += e.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
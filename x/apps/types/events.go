@@ -2,11 +2,30 @@ package types
 
 // pos module event types
 const (
-	EventTypeCompleteUnstaking = "complete_unstaking"
-	EventTypeCreateApplication = "create_application"
-	EventTypeStake             = "stake"
-	EventTypeBeginUnstake      = "begin_unstake"
-	EventTypeUnstake           = "unstake"
-	AttributeKeyApplication    = "application"
-	AttributeValueCategory     = ModuleName
+	EventTypeCompleteUnstaking      = "complete_unstaking"
+	EventTypeCreateApplication      = "create_application"
+	EventTypeStake                  = "stake"
+	EventTypeEditStake              = "edit_stake"
+	EventTypeWaitingToEdit          = "waiting_to_edit_stake"
+	EventTypeBeginUnstake           = "begin_unstake"
+	EventTypeUnstake                = "unstake"
+	EventTypePartialUnstake         = "partial_unstake"
+	EventTypeCompletePartialUnstake = "complete_partial_unstake"
+	EventTypeRevokeAAT              = "revoke_aat"
+	EventTypeJail                   = "jail"
+	EventTypeMaxRelaysChange        = "max_relays_change"
+	EventTypeDeposit                = "deposit"
+	EventTypeEscrowPayment          = "escrow_payment"
+	EventTypeEscrowPause            = "escrow_pause"
+	EventTypeEscrowResume           = "escrow_resume"
+	AttributeKeyApplication         = "application"
+	AttributeKeyClientPubKey        = "client_pub_key"
+	AttributeKeyCompletionTime      = "completion_time"
+	AttributeKeyMisbehaviors        = "misbehaviors"
+	AttributeKeyJailedUntil         = "jailed_until"
+	AttributeKeyMaxRelays           = "max_relays"
+	AttributeKeyPreviousMaxRelays   = "previous_max_relays"
+	AttributeKeyBalance             = "balance"
+	AttributeKeyRecipient           = "recipient"
+	AttributeValueCategory          = ModuleName
 )
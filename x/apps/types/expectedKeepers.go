@@ -10,6 +10,8 @@ type PosKeeper interface {
 	StakeDenom(ctx sdk.Ctx) (res string)
 	// GetStakedTokens total staking tokens supply which is staked
 	GetStakedTokens(ctx sdk.Ctx) sdk.Int
+	// BlocksPerSession the number of blocks in a session
+	BlocksPerSession(ctx sdk.Ctx) (res int64)
 }
 
 // AuthKeeper defines the expected supply Keeper (noalias)
@@ -61,3 +63,9 @@ type ApplicationSet interface {
 	// MaxApplications returns the maximum amount of staked applications
 	MaxApplications(sdk.Ctx) uint64
 }
+
+// PocketKeeper defines the expected pocketcore keeper (noalias)
+type PocketKeeper interface {
+	// validate the chains of a stake against the pocketcore chain registry
+	ValidateStakingChains(ctx sdk.Ctx, chains []string) sdk.Error
+}
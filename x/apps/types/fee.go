@@ -1,15 +1,34 @@
 package types
 
+import (
+	coreTypes "github.com/pokt-network/pocket-core/types"
+)
+
+// default fees of the messages (in uPOKT), the fees in state are the MessageFees param
 const (
-	StakeFee   = 100000
-	UnstakeFee = 100000
-	UnjailFee  = 100000
+	StakeFee          = 100000
+	UnstakeFee        = 100000
+	UnjailFee         = 100000
+	RevokeAATFee      = 100000
+	EditStakeFee      = 100000
+	PartialUnstakeFee = 100000
+	DepositFee        = 100000
 )
 
 var (
-	AppFeeMap = map[string]int64{
-		MsgAppStakeName:   StakeFee,
-		MsgAppUnstakeName: UnstakeFee,
-		MsgAppUnjailName:  UnjailFee,
+	DefaultMessageFees = MessageFees{
+		{MsgType: MsgAppStakeName, Fee: StakeFee},
+		{MsgType: MsgAppUnstakeName, Fee: UnstakeFee},
+		{MsgType: MsgAppUnjailName, Fee: UnjailFee},
+		{MsgType: MsgAppRevokeAATName, Fee: RevokeAATFee},
+		{MsgType: MsgAppEditStakeName, Fee: EditStakeFee},
+		{MsgType: MsgAppPartialUnstakeName, Fee: PartialUnstakeFee},
+		{MsgType: MsgAppDepositName, Fee: DepositFee},
 	}
 )
+
+// the fee of a message type (in uPOKT)
+type MessageFee = coreTypes.MessageFee
+
+// the fees of the messages of the module
+type MessageFees = coreTypes.MessageFees
@@ -6,9 +6,13 @@ import (
 
 // GenesisState - all staking state that must be provided at genesis
 type GenesisState struct {
-	Params       Params       `json:"params" yaml:"params"`
-	Applications Applications `json:"applications" yaml:"applications"`
-	Exported     bool         `json:"exported" yaml:"exported"`
+	Params          Params          `json:"params" yaml:"params"`
+	Applications    Applications    `json:"applications" yaml:"applications"`
+	AATRevocations  AATRevocations  `json:"aat_revocations" yaml:"aat_revocations"`
+	PartialUnstakes PartialUnstakes `json:"partial_unstakes" yaml:"partial_unstakes"`
+	SigningInfos    AppSigningInfos `json:"signing_infos" yaml:"signing_infos"`
+	Escrows         AppEscrows      `json:"escrows" yaml:"escrows"`
+	Exported        bool            `json:"exported" yaml:"exported"`
 }
 
 // PrevState application power, needed for application set update logic
@@ -20,7 +24,11 @@ type PrevStatePowerMapping struct {
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:       DefaultParams(),
-		Applications: make(Applications, 0),
+		Params:          DefaultParams(),
+		Applications:    make(Applications, 0),
+		AATRevocations:  make(AATRevocations, 0),
+		PartialUnstakes: make(PartialUnstakes, 0),
+		SigningInfos:    make(AppSigningInfos, 0),
+		Escrows:         make(AppEscrows, 0),
 	}
 }
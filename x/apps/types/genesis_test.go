@@ -10,8 +10,12 @@ func TestDefaultGenesisState(t *testing.T) {
 		name string
 		want GenesisState
 	}{{"defaultState", GenesisState{
-		Params:       DefaultParams(),
-		Applications: make(Applications, 0),
+		Params:          DefaultParams(),
+		Applications:    make(Applications, 0),
+		AATRevocations:  make(AATRevocations, 0),
+		PartialUnstakes: make(PartialUnstakes, 0),
+		SigningInfos:    make(AppSigningInfos, 0),
+		Escrows:         make(AppEscrows, 0),
 	}},
 	}
 	for _, tt := range tests {
@@ -19,6 +19,11 @@ var (
 	StakedAppsKey      = []byte{0x02} // prefix for each key to a staked application index, sorted by power
 	UnstakingAppsKey   = []byte{0x03} // prefix for unstaking application
 	BurnApplicationKey = []byte{0x04} // prefix for awarding applications
+	AATRevocationKey   = []byte{0x05} // prefix for revoked client public keys of applications
+	WaitingToEditKey   = []byte{0x06} // prefix for applications waiting to edit their chains
+	PartialUnstakesKey = []byte{0x07} // prefix for the partial unstakes queue
+	AppSigningInfoKey  = []byte{0x08} // prefix for the signing info (misbehavior tracking) of applications
+	AppEscrowKey       = []byte{0x09} // prefix for the escrow of prepaid applications
 )
 
 // Removes the prefix bytes from a key to expose true address
@@ -37,6 +42,22 @@ func KeyForUnstakingApps(unstakingTime time.Time) []byte {
 	return append(UnstakingAppsKey, bz...) // use the unstaking time as part of the key
 }
 
+// generates the key for the partial unstakes by the completion time
+func KeyForPartialUnstakes(completionTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(completionTime)
+	return append(PartialUnstakesKey, bz...) // use the completion time as part of the key
+}
+
+// generates the key for the signing info of the application with address
+func KeyForAppSigningInfo(addr sdk.Address) []byte {
+	return append(AppSigningInfoKey, addr.Bytes()...)
+}
+
+// generates the key for the escrow of a prepaid application
+func KeyForAppEscrow(addr sdk.Address) []byte {
+	return append(AppEscrowKey, addr.Bytes()...)
+}
+
 // generates the key for a application in the staking set
 func KeyForAppInStakingSet(app Application) []byte {
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
@@ -47,6 +68,16 @@ func KeyForAppBurn(address sdk.Address) []byte {
 	return append(BurnApplicationKey, address...)
 }
 
+// generates the key for an application waiting to edit its chains
+func KeyForAppWaitingToEdit(address sdk.Address) []byte {
+	return append(WaitingToEditKey, address...)
+}
+
+// generates the key for the aat revocation of the client public key by the application
+func KeyForAATRevocation(appAddr sdk.Address, clientPubKey string) []byte {
+	return append(append(AATRevocationKey, appAddr.Bytes()...), []byte(clientPubKey)...)
+}
+
 // get the power ranking key of a application
 // NOTE the larger values are of higher value
 func getStakedValPowerRankKey(application Application) []byte {
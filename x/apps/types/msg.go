@@ -10,12 +10,20 @@ var (
 	_ sdk.Msg = &MsgAppStake{}
 	_ sdk.Msg = &MsgBeginAppUnstake{}
 	_ sdk.Msg = &MsgAppUnjail{}
+	_ sdk.Msg = &MsgAppRevokeAAT{}
+	_ sdk.Msg = &MsgAppEditStake{}
+	_ sdk.Msg = &MsgAppPartialUnstake{}
+	_ sdk.Msg = &MsgAppDeposit{}
 )
 
 const (
-	MsgAppStakeName   = "app_stake"
-	MsgAppUnstakeName = "app_begin_unstake"
-	MsgAppUnjailName  = "app_unjail"
+	MsgAppStakeName          = "app_stake"
+	MsgAppUnstakeName        = "app_begin_unstake"
+	MsgAppUnjailName         = "app_unjail"
+	MsgAppRevokeAATName      = "app_revoke_aat"
+	MsgAppEditStakeName      = "app_edit_stake"
+	MsgAppPartialUnstakeName = "app_partial_unstake"
+	MsgAppDepositName        = "app_deposit"
 )
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -66,7 +74,58 @@ func (msg MsgAppStake) Type() string { return MsgAppStakeName }
 
 // GetFee get fee for msg
 func (msg MsgAppStake) GetFee() sdk.Int {
-	return sdk.NewInt(AppFeeMap[msg.Type()])
+	return DefaultMessageFees.Amount(msg.Type())
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgAppEditStake - struct for editing the stake of a staked application
+// the value is the new total stake of the application and can't be lower than the current stake
+type MsgAppEditStake struct {
+	PubKey crypto.PublicKey `json:"pubkey" yaml:"pubkey"`
+	Chains []string         `json:"chains" yaml:"chains"`
+	Value  sdk.Int          `json:"value" yaml:"value"`
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAppEditStake) GetSigners() []sdk.Address {
+	return []sdk.Address{sdk.Address(msg.PubKey.Address())}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAppEditStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for editing the stake of an application
+func (msg MsgAppEditStake) ValidateBasic() sdk.Error {
+	if msg.PubKey == nil || msg.PubKey.RawString() == "" {
+		return ErrNilApplicationAddr(DefaultCodespace)
+	}
+	if msg.Value.LTE(sdk.ZeroInt()) {
+		return ErrBadStakeAmount(DefaultCodespace)
+	}
+	if len(msg.Chains) == 0 {
+		return ErrNoChains(DefaultCodespace)
+	}
+	for _, chain := range msg.Chains {
+		if err := ValidateNetworkIdentifier(chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgAppEditStake) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgAppEditStake) Type() string { return MsgAppEditStakeName }
+
+// GetFee get fee for msg
+func (msg MsgAppEditStake) GetFee() sdk.Int {
+	return DefaultMessageFees.Amount(msg.Type())
 }
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -103,7 +162,7 @@ func (msg MsgBeginAppUnstake) Type() string { return MsgAppUnstakeName }
 
 // GetFee get fee for msg
 func (msg MsgBeginAppUnstake) GetFee() sdk.Int {
-	return sdk.NewInt(AppFeeMap[msg.Type()])
+	return DefaultMessageFees.Amount(msg.Type())
 }
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -121,7 +180,7 @@ func (msg MsgAppUnjail) Type() string { return MsgAppUnjailName }
 
 // GetFee get fee for msg
 func (msg MsgAppUnjail) GetFee() sdk.Int {
-	return sdk.NewInt(AppFeeMap[msg.Type()])
+	return DefaultMessageFees.Amount(msg.Type())
 }
 
 // GetSigners return address(es) that must sign over msg.GetSignBytes()
@@ -142,3 +201,137 @@ func (msg MsgAppUnjail) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgAppRevokeAAT - struct for revoking the application authentication tokens of a client public key
+type MsgAppRevokeAAT struct {
+	AppAddr      sdk.Address `json:"address" yaml:"address"`               // address of the application
+	ClientPubKey string      `json:"client_pub_key" yaml:"client_pub_key"` // the client public key of the revoked tokens in hex
+}
+
+// Route provides router key for msg
+func (msg MsgAppRevokeAAT) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgAppRevokeAAT) Type() string { return MsgAppRevokeAATName }
+
+// GetFee get fee for msg
+func (msg MsgAppRevokeAAT) GetFee() sdk.Int {
+	return DefaultMessageFees.Amount(msg.Type())
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAppRevokeAAT) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.AppAddr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAppRevokeAAT) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for revoking the aats of a client
+func (msg MsgAppRevokeAAT) ValidateBasic() sdk.Error {
+	if msg.AppAddr.Empty() {
+		return ErrNilApplicationAddr(DefaultCodespace)
+	}
+	if _, err := crypto.NewPublicKey(msg.ClientPubKey); err != nil {
+		return ErrInvalidClientPubKey(DefaultCodespace, err)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgAppPartialUnstake - struct for unstaking part of the stake of an application
+// the amount goes through the unstaking queue while the application stays staked with the rest
+type MsgAppPartialUnstake struct {
+	Address sdk.Address `json:"application_address" yaml:"application_address"`
+	Amount  sdk.Int     `json:"amount" yaml:"amount"`
+}
+
+// GetSigners address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAppPartialUnstake) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Address}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAppPartialUnstake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for partially unstaking an application
+func (msg MsgAppPartialUnstake) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilApplicationAddr(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadStakeAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgAppPartialUnstake) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgAppPartialUnstake) Type() string { return MsgAppPartialUnstakeName }
+
+// GetFee get fee for msg
+func (msg MsgAppPartialUnstake) GetFee() sdk.Int {
+	return DefaultMessageFees.Amount(msg.Type())
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgAppDeposit - struct for depositing into the escrow of a prepaid application
+// the first deposit creates the prepaid application with the chains, later deposits top up the escrow
+type MsgAppDeposit struct {
+	PubKey crypto.PublicKey `json:"pubkey" yaml:"pubkey"`
+	Chains []string         `json:"chains" yaml:"chains"`
+	Amount sdk.Int          `json:"amount" yaml:"amount"`
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgAppDeposit) GetSigners() []sdk.Address {
+	return []sdk.Address{sdk.Address(msg.PubKey.Address())}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgAppDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for depositing into the escrow of an application
+func (msg MsgAppDeposit) ValidateBasic() sdk.Error {
+	if msg.PubKey == nil || msg.PubKey.RawString() == "" {
+		return ErrNilApplicationAddr(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadDepositAmount(DefaultCodespace)
+	}
+	if len(msg.Chains) == 0 {
+		return ErrNoChains(DefaultCodespace)
+	}
+	for _, chain := range msg.Chains {
+		if err := ValidateNetworkIdentifier(chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgAppDeposit) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgAppDeposit) Type() string { return MsgAppDepositName }
+
+// GetFee get fee for msg
+func (msg MsgAppDeposit) GetFee() sdk.Int {
+	return DefaultMessageFees.Amount(msg.Type())
+}
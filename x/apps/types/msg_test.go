@@ -414,3 +414,153 @@ func TestMsgAppUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgAppRevokeAAT_ValidateBasic(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	var clientPub crypto.Ed25519PublicKey
+	rand.Read(clientPub[:])
+	tests := []struct {
+		name   string
+		msg    MsgAppRevokeAAT
+		hasErr bool
+	}{
+		{
+			name:   "errs if no address",
+			msg:    MsgAppRevokeAAT{ClientPubKey: clientPub.RawString()},
+			hasErr: true,
+		},
+		{
+			name:   "errs if invalid client public key",
+			msg:    MsgAppRevokeAAT{AppAddr: sdk.Address(pub.Address()), ClientPubKey: "invalid"},
+			hasErr: true,
+		},
+		{
+			name: "returns nil if valid",
+			msg:  MsgAppRevokeAAT{AppAddr: sdk.Address(pub.Address()), ClientPubKey: clientPub.RawString()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); (got != nil) != tt.hasErr {
+				t.Errorf("ValidateBasic() = %v, want error %v", got, tt.hasErr)
+			}
+		})
+	}
+}
+
+func TestMsgAppRevokeAAT_GetSigners(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	msg := MsgAppRevokeAAT{AppAddr: sdk.Address(pub.Address())}
+	if got := msg.GetSigners(); !reflect.DeepEqual(got, []sdk.Address{sdk.Address(pub.Address())}) {
+		t.Errorf("GetSigners() = %v, want %v", got, []sdk.Address{sdk.Address(pub.Address())})
+	}
+	if got := msg.Type(); got != MsgAppRevokeAATName {
+		t.Errorf("Type() = %v, want %v", got, MsgAppRevokeAATName)
+	}
+	if got := msg.GetFee(); !got.Equal(sdk.NewInt(RevokeAATFee)) {
+		t.Errorf("GetFee() = %v, want %v", got, RevokeAATFee)
+	}
+}
+
+func TestMsgAppEditStake_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAppEditStake
+		want sdk.Error
+	}{
+		{
+			name: "errs if no Address",
+			msg:  MsgAppEditStake{},
+			want: ErrNilApplicationAddr(DefaultCodespace),
+		},
+		{
+			name: "errs if stake is not positive",
+			msg:  MsgAppEditStake{PubKey: msgAppStake.PubKey, Value: sdk.ZeroInt()},
+			want: ErrBadStakeAmount(DefaultCodespace),
+		},
+		{
+			name: "errs if no native chains supported",
+			msg:  MsgAppEditStake{PubKey: msgAppStake.PubKey, Value: sdk.NewInt(1), Chains: []string{}},
+			want: ErrNoChains(DefaultCodespace),
+		},
+		{
+			name: "returns nil if valid",
+			msg:  MsgAppEditStake{PubKey: msgAppStake.PubKey, Value: sdk.NewInt(1), Chains: []string{"00"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgAppPartialUnstake_ValidateBasic(t *testing.T) {
+	address := sdk.Address(msgAppStake.PubKey.Address())
+	tests := []struct {
+		name string
+		msg  MsgAppPartialUnstake
+		want sdk.Error
+	}{
+		{
+			name: "errs if no Address",
+			msg:  MsgAppPartialUnstake{Amount: sdk.OneInt()},
+			want: ErrNilApplicationAddr(DefaultCodespace),
+		},
+		{
+			name: "errs if amount is not positive",
+			msg:  MsgAppPartialUnstake{Address: address, Amount: sdk.ZeroInt()},
+			want: ErrBadStakeAmount(DefaultCodespace),
+		},
+		{
+			name: "returns nil if valid",
+			msg:  MsgAppPartialUnstake{Address: address, Amount: sdk.OneInt()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgAppDeposit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAppDeposit
+		want sdk.Error
+	}{
+		{
+			name: "errs if no Address",
+			msg:  MsgAppDeposit{},
+			want: ErrNilApplicationAddr(DefaultCodespace),
+		},
+		{
+			name: "errs if amount is not positive",
+			msg:  MsgAppDeposit{PubKey: msgAppStake.PubKey, Amount: sdk.ZeroInt()},
+			want: ErrBadDepositAmount(DefaultCodespace),
+		},
+		{
+			name: "errs if no native chains supported",
+			msg:  MsgAppDeposit{PubKey: msgAppStake.PubKey, Amount: sdk.NewInt(1), Chains: []string{}},
+			want: ErrNoChains(DefaultCodespace),
+		},
+		{
+			name: "returns nil if valid",
+			msg:  MsgAppDeposit{PubKey: msgAppStake.PubKey, Amount: sdk.NewInt(1), Chains: []string{"00"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
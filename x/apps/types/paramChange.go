@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	coreTypes "github.com/pokt-network/pocket-core/types"
+)
+
+// returns a copy of the params with the param of the key changed to the amino json value, along with the typed value
+func (p Params) ChangeParam(key string, value []byte) (Params, interface{}, error) {
+	v, err := coreTypes.ChangeParam(ModuleCdc, &p, key, value)
+	return p, v, err
+}
+
+// the effect of a param change on a single actor
+type ParamChangeEffect = coreTypes.ParamChangeEffect
+
+// the simulated outcome of a param change against the current state
+type ParamChangeReport struct {
+	ParamKey     string              `json:"param_key" yaml:"param_key"`
+	Params       Params              `json:"params" yaml:"params"`               // the params after the change
+	AffectedApps []ParamChangeEffect `json:"affected_apps" yaml:"affected_apps"` // the applications affected by the change
+}
+
+// Return human readable param change report
+func (r ParamChangeReport) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Param Change %s:\n%s\n", r.ParamKey, r.Params.String()))
+	b.WriteString(fmt.Sprintf("Affected Applications: %d\n", len(r.AffectedApps)))
+	for _, e := range r.AffectedApps {
+		b.WriteString(fmt.Sprintf("  %s: %s\n", e.Address, e.Effect))
+	}
+	return strings.TrimSpace(b.String())
+}
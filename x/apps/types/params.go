@@ -20,28 +20,40 @@ const (
 	DefaultBaseRelaysPerPOKT   int64  = 100
 	DefaultStabilityAdjustment int64  = 0
 	DefaultParticipationRateOn bool   = false
+	DefaultMinimumJailDuration        = time.Hour
+	DefaultPrepaidRelayPrice   int64  = 1000
+	// DefaultMisbehaviorReportThreshold the # of servicers proving the misbehavior of an application in a session to jail it
+	DefaultMisbehaviorReportThreshold int64 = 3
 )
 
 // Keys for parameter access
 var (
-	KeyUnstakingTime       = []byte("AppUnstakingTime")
-	KeyMaxApplications     = []byte("MaxApplications")
-	KeyApplicationMinStake = []byte("ApplicationStakeMinimum")
-	BaseRelaysPerPOKT      = []byte("BaseRelaysPerPOKT")
-	StabilityAdjustment    = []byte("StabilityAdjustment")
-	ParticipationRateOn    = []byte("ParticipationRateOn")
+	KeyUnstakingTime        = []byte("AppUnstakingTime")
+	KeyMaxApplications      = []byte("MaxApplications")
+	KeyApplicationMinStake  = []byte("ApplicationStakeMinimum")
+	BaseRelaysPerPOKT       = []byte("BaseRelaysPerPOKT")
+	StabilityAdjustment     = []byte("StabilityAdjustment")
+	ParticipationRateOn     = []byte("ParticipationRateOn")
+	KeyMinimumJailDuration  = []byte("AppMinimumJailDuration")
+	KeyPrepaidRelayPrice    = []byte("PrepaidRelayPrice")
+	KeyMisbehaviorThreshold = []byte("AppMisbehaviorReportThreshold")
+	KeyMessageFees          = []byte("MessageFees")
 )
 
 var _ types.ParamSet = (*Params)(nil)
 
 // Params defines the high level settings for pos module
 type Params struct {
-	UnstakingTime       time.Duration `json:"unstaking_time" yaml:"unstaking_time"`               // duration of unstaking
-	MaxApplications     uint64        `json:"max_applications" yaml:"max_applications"`           // maximum number of applications
-	AppStakeMin         int64         `json:"app_stake_minimum" yaml:"app_stake_minimum"`         // minimum amount needed to stake as an application
-	BaseRelaysPerPOKT   int64         `json:"base_relays_per_pokt" yaml:"base_relays_per_pokt"`   // base relays per POKT coin staked
-	StabilityAdjustment int64         `json:"stability_adjustment" yaml:"stability_adjustment"`   // the stability adjustment from the governance
-	ParticipationRateOn bool          `json:"participation_rate_on" yaml:"participation_rate_on"` // the participation rate affects the amount minted based on staked ratio
+	UnstakingTime              time.Duration `json:"unstaking_time" yaml:"unstaking_time"`                             // duration of unstaking
+	MaxApplications            uint64        `json:"max_applications" yaml:"max_applications"`                         // maximum number of applications
+	AppStakeMin                int64         `json:"app_stake_minimum" yaml:"app_stake_minimum"`                       // minimum amount needed to stake as an application
+	BaseRelaysPerPOKT          int64         `json:"base_relays_per_pokt" yaml:"base_relays_per_pokt"`                 // base relays per POKT coin staked
+	StabilityAdjustment        int64         `json:"stability_adjustment" yaml:"stability_adjustment"`                 // the stability adjustment from the governance
+	ParticipationRateOn        bool          `json:"participation_rate_on" yaml:"participation_rate_on"`               // the participation rate affects the amount minted based on staked ratio
+	MinimumJailDuration        time.Duration `json:"minimum_jail_duration" yaml:"minimum_jail_duration"`               // the minimum time an application is jailed for misbehavior
+	PrepaidRelayPrice          int64         `json:"prepaid_relay_price" yaml:"prepaid_relay_price"`                   // the price of a relay debited from the escrow of a prepaid application
+	MisbehaviorReportThreshold int64         `json:"misbehavior_report_threshold" yaml:"misbehavior_report_threshold"` // the # of servicers proving the misbehavior of an application in a session to jail it (zero disables jailing)
+	MessageFees                MessageFees   `json:"message_fees" yaml:"message_fees"`                                 // the fees of the messages of the module (in uPOKT)
 }
 
 // Implements params.ParamSet
@@ -53,18 +65,26 @@ func (p *Params) ParamSetPairs() types.ParamSetPairs {
 		{Key: BaseRelaysPerPOKT, Value: &p.BaseRelaysPerPOKT},
 		{Key: StabilityAdjustment, Value: &p.StabilityAdjustment},
 		{Key: ParticipationRateOn, Value: &p.ParticipationRateOn},
+		{Key: KeyMinimumJailDuration, Value: &p.MinimumJailDuration},
+		{Key: KeyPrepaidRelayPrice, Value: &p.PrepaidRelayPrice},
+		{Key: KeyMisbehaviorThreshold, Value: &p.MisbehaviorReportThreshold},
+		{Key: KeyMessageFees, Value: &p.MessageFees},
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		UnstakingTime:       DefaultUnstakingTime,
-		MaxApplications:     DefaultMaxApplications,
-		AppStakeMin:         DefaultMinStake,
-		BaseRelaysPerPOKT:   DefaultBaseRelaysPerPOKT,
-		StabilityAdjustment: DefaultStabilityAdjustment,
-		ParticipationRateOn: DefaultParticipationRateOn,
+		UnstakingTime:              DefaultUnstakingTime,
+		MaxApplications:            DefaultMaxApplications,
+		AppStakeMin:                DefaultMinStake,
+		BaseRelaysPerPOKT:          DefaultBaseRelaysPerPOKT,
+		StabilityAdjustment:        DefaultStabilityAdjustment,
+		ParticipationRateOn:        DefaultParticipationRateOn,
+		MinimumJailDuration:        DefaultMinimumJailDuration,
+		PrepaidRelayPrice:          DefaultPrepaidRelayPrice,
+		MisbehaviorReportThreshold: DefaultMisbehaviorReportThreshold,
+		MessageFees:                append(MessageFees(nil), DefaultMessageFees...),
 	}
 }
 
@@ -79,6 +99,18 @@ func (p Params) Validate() error {
 	if p.BaseRelaysPerPOKT < 0 {
 		return fmt.Errorf("invalid baseline throughput stake rate, must be above 0")
 	}
+	if p.MinimumJailDuration < 0 {
+		return fmt.Errorf("invalid minimum jail duration, must not be negative")
+	}
+	if p.PrepaidRelayPrice <= 0 {
+		return fmt.Errorf("invalid prepaid relay price, must be above 0")
+	}
+	if p.MisbehaviorReportThreshold < 0 {
+		return fmt.Errorf("invalid misbehavior report threshold, must not be negative")
+	}
+	if err := p.MessageFees.Validate(DefaultMessageFees); err != nil {
+		return err
+	}
 	// todo
 	return nil
 }
@@ -98,13 +130,21 @@ func (p Params) String() string {
   Minimum Stake:     	       %d
   BaseRelaysPerPOKT            %d
   Stability Adjustment         %d
-  Participation Rate On        %v,`,
+  Participation Rate On        %v
+  Minimum Jail Duration        %s
+  Prepaid Relay Price          %d
+  Misbehavior Report Threshold %d
+  Message Fees                 %s,`,
 		p.UnstakingTime,
 		p.MaxApplications,
 		p.AppStakeMin,
 		p.BaseRelaysPerPOKT,
 		p.StabilityAdjustment,
-		p.ParticipationRateOn)
+		p.ParticipationRateOn,
+		p.MinimumJailDuration,
+		p.PrepaidRelayPrice,
+		p.MisbehaviorReportThreshold,
+		p.MessageFees)
 }
 
 // unmarshal the current pos params value from store key or panic
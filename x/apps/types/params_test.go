@@ -14,12 +14,16 @@ func TestDefaultParams(t *testing.T) {
 	}{
 		{"Default Test",
 			Params{
-				UnstakingTime:       DefaultUnstakingTime,
-				MaxApplications:     DefaultMaxApplications,
-				AppStakeMin:         DefaultMinStake,
-				BaseRelaysPerPOKT:   DefaultBaseRelaysPerPOKT,
-				StabilityAdjustment: DefaultStabilityAdjustment,
-				ParticipationRateOn: DefaultParticipationRateOn,
+				UnstakingTime:              DefaultUnstakingTime,
+				MaxApplications:            DefaultMaxApplications,
+				AppStakeMin:                DefaultMinStake,
+				BaseRelaysPerPOKT:          DefaultBaseRelaysPerPOKT,
+				StabilityAdjustment:        DefaultStabilityAdjustment,
+				ParticipationRateOn:        DefaultParticipationRateOn,
+				MinimumJailDuration:        DefaultMinimumJailDuration,
+				PrepaidRelayPrice:          DefaultPrepaidRelayPrice,
+				MisbehaviorReportThreshold: DefaultMisbehaviorReportThreshold,
+				MessageFees:                DefaultMessageFees,
 			},
 		}}
 	for _, tt := range tests {
@@ -105,6 +109,7 @@ func TestParams_Validate(t *testing.T) {
 		BaselineThrouhgputStakeRate int64         `json:"baseline_throughput_stake_rate" yaml:"baseline_throughput_stake_rate"`
 		StabilityAdjustment         int64         `json:"staking_adjustment" yaml:"staking_adjustment"`
 		ParticipationRateOn         bool          `json:"participation_rate_on" yaml:"participation_rate_on"`
+		PrepaidRelayPrice           int64         `json:"prepaid_relay_price" yaml:"prepaid_relay_price"`
 	}
 	tests := []struct {
 		name    string
@@ -138,7 +143,17 @@ func TestParams_Validate(t *testing.T) {
 			BaselineThrouhgputStakeRate: 90,
 			StabilityAdjustment:         100,
 			ParticipationRateOn:         false,
+			PrepaidRelayPrice:           1000,
 		}, false},
+		{"Default Validation Test / Wrong PrepaidRelayPrice", fields{
+			UnstakingTime:               10000,
+			MaxApplications:             2,
+			AppStakeMin:                 1000000,
+			BaselineThrouhgputStakeRate: 90,
+			StabilityAdjustment:         100,
+			ParticipationRateOn:         false,
+			PrepaidRelayPrice:           0,
+		}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -149,6 +164,8 @@ func TestParams_Validate(t *testing.T) {
 				BaseRelaysPerPOKT:   tt.fields.BaselineThrouhgputStakeRate,
 				StabilityAdjustment: tt.fields.StabilityAdjustment,
 				ParticipationRateOn: tt.fields.ParticipationRateOn,
+				PrepaidRelayPrice:   tt.fields.PrepaidRelayPrice,
+				MessageFees:         DefaultMessageFees,
 			}
 			if err := p.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -177,10 +194,14 @@ func TestParams_MustMarshalMarshal(t *testing.T) {
 			"Unmarshal application",
 			false,
 			Params{
-				UnstakingTime:     DefaultUnstakingTime,
-				MaxApplications:   DefaultMaxApplications,
-				AppStakeMin:       DefaultMinStake,
-				BaseRelaysPerPOKT: DefaultBaseRelaysPerPOKT,
+				UnstakingTime:              DefaultUnstakingTime,
+				MaxApplications:            DefaultMaxApplications,
+				AppStakeMin:                DefaultMinStake,
+				BaseRelaysPerPOKT:          DefaultBaseRelaysPerPOKT,
+				MinimumJailDuration:        DefaultMinimumJailDuration,
+				PrepaidRelayPrice:          DefaultPrepaidRelayPrice,
+				MisbehaviorReportThreshold: DefaultMisbehaviorReportThreshold,
+				MessageFees:                DefaultMessageFees,
 			},
 			args{moduleCdc.MustMarshalBinaryLengthPrefixed(DefaultParams())},
 		},
@@ -233,3 +254,31 @@ func TestParams_String(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ChangeParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		want    interface{}
+		wantErr bool
+	}{
+		{"changes an int64 param", string(KeyApplicationMinStake), `"2000000"`, int64(2000000), false},
+		{"changes a duration param", string(KeyUnstakingTime), `"3600000000000"`, time.Hour, false},
+		{"changes a bool param", string(ParticipationRateOn), `true`, true, false},
+		{"errors if the params are invalid", string(KeyPrepaidRelayPrice), `"0"`, nil, true},
+		{"errors if the value is not of the param type", string(KeyApplicationMinStake), `true`, nil, true},
+		{"errors if the param is unknown", "UnknownParam", `"1"`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got, err := DefaultParams().ChangeParam(tt.key, []byte(tt.value))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ChangeParam() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChangeParam() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,8 +8,10 @@ import (
 
 // names used as root for pool module accounts:
 // StakingPool -> "application_staked_tokens_pool"
+// EscrowPool -> "application_escrow_pool"
 const (
 	StakedPoolName = "application_staked_tokens_pool"
+	EscrowPoolName = "application_escrow_pool"
 )
 
 type Pool struct {
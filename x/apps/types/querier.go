@@ -11,6 +11,9 @@ const (
 	QueryAppStakedPool   = "appStakedPool"
 	QueryAppUnstakedPool = "appUnstakedPool"
 	QueryParameters      = "parameters"
+	QueryAppRelays       = "appRelays"
+	QueryAppEscrow       = "appEscrow"
+	QueryParamChange     = "paramChange"
 )
 
 type QueryAppParams struct {
@@ -23,6 +26,12 @@ func NewQueryAppParams(applicationAddr sdk.Address) QueryAppParams {
 	}
 }
 
+// the param and amino json value of a simulated param change
+type QueryParamChangeParams struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type QueryAppsParams struct {
 	Page, Limit int
 }
@@ -66,3 +75,7 @@ func (opts QueryApplicationsWithOpts) IsValid(app Application) bool {
 type QueryStakedApplicationsParams struct {
 	Page, Limit int
 }
+
+type QueryAppRelaysParams struct {
+	Stake sdk.Int `json:"stake"`
+}
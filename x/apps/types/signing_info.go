@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// Signing info of an application, tracking the misbehavior of its clients proven by the servicers
+type AppSigningInfo struct {
+	Address             sdk.Address   `json:"address" yaml:"address"`                             // application address
+	StartHeight         int64         `json:"start_height" yaml:"start_height"`                   // height at which the application was first tracked
+	JailedUntil         time.Time     `json:"jailed_until" yaml:"jailed_until"`                   // timestamp the application cannot be unjailed before
+	MisbehaviorCounter  int64         `json:"misbehavior_counter" yaml:"misbehavior_counter"`     // proven misbehaviors over the lifetime of the application
+	JailedCounter       int64         `json:"jailed_counter" yaml:"jailed_counter"`               // number of times the application was jailed for misbehavior
+	ReportSessionHeight int64         `json:"report_session_height" yaml:"report_session_height"` // the session of the reporters
+	Reporters           []sdk.Address `json:"reporters" yaml:"reporters"`                         // the servicers that proved a misbehavior in the session
+}
+
+// Return human readable application signing info
+func (i AppSigningInfo) String() string {
+	return fmt.Sprintf(`Application Signing Info:
+  Address:             %s
+  Start Height:        %d
+  Jailed Until:        %v
+  Misbehavior Counter: %d
+  Jailed Counter:      %d
+  Report Session:      %d
+  Reporters:           %v`,
+		i.Address, i.StartHeight, i.JailedUntil, i.MisbehaviorCounter, i.JailedCounter, i.ReportSessionHeight, i.Reporters)
+}
+
+type AppSigningInfos []AppSigningInfo
+
+// Return human readable application signing infos
+func (is AppSigningInfos) String() (out string) {
+	for _, i := range is {
+		out += i.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -1,6 +1,7 @@
 package nodes
 
 import (
+	"fmt"
 	"github.com/pokt-network/pocket-core/x/nodes/keeper"
 	"github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/posmint/codec"
@@ -105,7 +106,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Context, []auth.Account,
 	initialCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, valTokens))
 	accs := createTestAccs(ctx, int(nAccs), initialCoins, &ak)
 
-	keeper := keeper.NewKeeper(cdc, keyPOS, ak, posSubspace, sdk.CodespaceType("pos"))
+	keeper := keeper.NewKeeper(cdc, keyPOS, ak, testPocketKeeper{}, posSubspace, sdk.CodespaceType("pos"))
 
 	params := types.DefaultParams()
 	keeper.SetParams(ctx, params)
@@ -220,6 +221,29 @@ func getGenesisStateForTest(ctx sdk.Ctx, keeper keeper.Keeper, defaultparams boo
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		Delegations:              keeper.GetAllDelegations(ctx),
+		UnbondingDelegations:     keeper.GetAllUnbondingDelegations(ctx),
+		PartialUnstakes:          keeper.GetAllPartialUnstakes(ctx),
+		RetiredServicerKeys:      keeper.GetAllRetiredServicerKeys(ctx),
 	}
 
 }
+
+// a pocket keeper supporting every chain, the chain registry is tested by the pocketcore module
+type testPocketKeeper struct{}
+
+func (testPocketKeeper) ValidateStakingChains(_ sdk.Ctx, _ []string) sdk.Error {
+	return nil
+}
+
+// a probe is valid if present, the probe validation is tested by the pocketcore module
+func (testPocketKeeper) ValidateLivenessProbe(_ sdk.Ctx, probe []byte, _ sdk.Address, _ string, _ []string, _ int64) sdk.Error {
+	if len(probe) == 0 {
+		return types.ErrInvalidLivenessProbe(types.DefaultCodespace, fmt.Errorf("the probe is empty"))
+	}
+	return nil
+}
+
+func (testPocketKeeper) ClaimSessions(_ sdk.Ctx) int64 {
+	return 4
+}
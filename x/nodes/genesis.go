@@ -31,6 +31,10 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 		// set the validators from the data
 		keeper.SetValidator(ctx, validator)
 		keeper.SetStakedValidator(ctx, validator)
+		// index the registered servicer key of the validator
+		if validator.ServicerPublicKey != nil {
+			keeper.RotateServicerKey(ctx, validator.Address, validator.ServicerPublicKey)
+		}
 		// ensure there's a signing info entry for the validator (used in slashing)
 		_, found := keeper.GetValidatorSigningInfo(ctx, validator.GetAddress())
 		if !found {
@@ -51,6 +55,23 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			stakedTokens = stakedTokens.Add(validator.GetTokens())
 		}
 	}
+	// set the delegations from the data
+	for _, delegation := range data.Delegations {
+		keeper.SetDelegation(ctx, delegation)
+	}
+	// the unbonding delegations remain in the staked pool until released
+	for _, ubd := range data.UnbondingDelegations {
+		keeper.SetUnbondingDelegation(ctx, ubd)
+		stakedTokens = stakedTokens.Add(ubd.Amount)
+	}
+	for _, retired := range data.RetiredServicerKeys {
+		keeper.SetRetiredServicerKey(ctx, retired)
+	}
+	// the partial unstakes remain in the staked pool until released
+	for _, pu := range data.PartialUnstakes {
+		keeper.SetPartialUnstake(ctx, pu)
+		stakedTokens = stakedTokens.Add(pu.Amount)
+	}
 	// take the staked amount and create the corresponding coins object
 	stakedCoins := sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(ctx), stakedTokens))
 	// check if the staked pool accounts exists
@@ -150,6 +171,10 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		Delegations:              keeper.GetAllDelegations(ctx),
+		UnbondingDelegations:     keeper.GetAllUnbondingDelegations(ctx),
+		PartialUnstakes:          keeper.GetAllPartialUnstakes(ctx),
+		RetiredServicerKeys:      keeper.GetAllRetiredServicerKeys(ctx),
 	}
 }
 
@@ -160,6 +185,20 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	err = validateGenesisStateDelegations(data.Validators, data.Delegations)
+	if err != nil {
+		return err
+	}
+	for _, pu := range data.PartialUnstakes {
+		if pu.Address.Empty() || !pu.Amount.IsPositive() {
+			return fmt.Errorf("partial unstake in genesis state is not valid: %v", pu)
+		}
+	}
+	for _, retired := range data.RetiredServicerKeys {
+		if retired.ServicerAddress.Empty() || retired.ValidatorAddress.Empty() {
+			return fmt.Errorf("retired servicer key in genesis state is not valid: %v", retired)
+		}
+	}
 	err = data.Params.Validate()
 	if err != nil {
 		return err
@@ -211,6 +250,9 @@ func validateGenesisStateValidators(validators []types.Validator, minimumStake s
 		if !val.IsUnstaked() && val.StakedTokens.LTE(minimumStake) {
 			return fmt.Errorf("validator has less than minimum stake: %v", val)
 		}
+		if val.CommissionRate < 0 || val.CommissionRate > 100 {
+			return types.ErrInvalidCommissionRate(types.ModuleName)
+		}
 		if err := types.ValidateServiceURL(val.ServiceURL); err != nil {
 			return types.ErrInvalidServiceURL(types.ModuleName, err)
 		}
@@ -223,3 +265,29 @@ func validateGenesisStateValidators(validators []types.Validator, minimumStake s
 	}
 	return
 }
+
+func validateGenesisStateDelegations(validators []types.Validator, delegations []types.Delegation) error {
+	delegated := make(map[string]sdk.Int, len(validators))
+	for _, val := range validators {
+		delegated[val.Address.String()] = sdk.ZeroInt()
+	}
+	for _, delegation := range delegations {
+		total, ok := delegated[delegation.ValidatorAddress.String()]
+		if !ok {
+			return fmt.Errorf("delegation to a validator not found in genesis state: %v", delegation)
+		}
+		if !delegation.Amount.IsPositive() {
+			return fmt.Errorf("genesis delegation must have a positive amount: %v", delegation)
+		}
+		if delegation.DelegatorAddress.Equals(delegation.ValidatorAddress) {
+			return fmt.Errorf("genesis delegation cannot be a self delegation: %v", delegation)
+		}
+		delegated[delegation.ValidatorAddress.String()] = total.Add(delegation.Amount)
+	}
+	for _, val := range validators {
+		if delegated[val.Address.String()].GT(val.StakedTokens) {
+			return fmt.Errorf("validator has more delegated tokens than staked tokens: %v", val)
+		}
+	}
+	return nil
+}
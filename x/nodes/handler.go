@@ -19,6 +19,18 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgUnjail(ctx, msg, k)
 		case types.MsgSend:
 			return handleMsgSend(ctx, msg, k)
+		case types.MsgDelegate:
+			return handleMsgDelegate(ctx, msg, k)
+		case types.MsgUndelegate:
+			return handleMsgUndelegate(ctx, msg, k)
+		case types.MsgEditStake:
+			return handleMsgEditStake(ctx, msg, k)
+		case types.MsgPartialUnstake:
+			return handleMsgPartialUnstake(ctx, msg, k)
+		case types.MsgRotateServicerKey:
+			return handleMsgRotateServicerKey(ctx, msg, k)
+		case types.MsgReportServicerLiveness:
+			return handleMsgReportServicerLiveness(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -29,8 +41,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 func handleStake(ctx sdk.Ctx, msg types.MsgStake, k keeper.Keeper) sdk.Result {
 	// create validator object using the message fields
 	validator := types.NewValidator(sdk.Address(msg.PublicKey.Address()), msg.PublicKey, msg.Chains, msg.ServiceURL, sdk.ZeroInt())
+	validator.CommissionRate = msg.CommissionRate
+	validator.OutputAddress = msg.Output
+	// a restaking validator keeps its registered servicer key
+	if val, found := k.GetValidator(ctx, validator.Address); found {
+		validator.ServicerPublicKey = val.ServicerPublicKey
+	}
 	// check if they can stake
-	if err := k.ValidateValidatorStaking(ctx, validator, msg.Value); err != nil {
+	if err := k.ValidateValidatorStaking(ctx, validator, msg.Value, msg.GetSigners()); err != nil {
 		return err.Result()
 	}
 	// change the validator state to staked
@@ -55,6 +73,34 @@ func handleStake(ctx sdk.Ctx, msg types.MsgStake, k keeper.Keeper) sdk.Result {
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgEditStake(ctx sdk.Ctx, msg types.MsgEditStake, k keeper.Keeper) sdk.Result {
+	address := sdk.Address(msg.PublicKey.Address())
+	// create the edited validator object using the message fields
+	validator := types.NewValidator(address, msg.PublicKey, msg.Chains, msg.ServiceURL, sdk.ZeroInt())
+	// check if they can edit the stake
+	if err := k.ValidateValidatorEditStake(ctx, validator, msg.Value); err != nil {
+		return err.Result()
+	}
+	if err := k.EditStakeValidator(ctx, validator, msg.Value); err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWaitingToEditStake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Value.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keeper) sdk.Result {
 	ctx.Logger().Info("Begin Unstaking Message received from " + msg.Address.String())
 	// move coins from the msg.Address account to a (self-delegation) delegator account
@@ -85,6 +131,83 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keep
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+func handleMsgPartialUnstake(ctx sdk.Ctx, msg types.MsgPartialUnstake, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Partial Unstake Message received from " + msg.Address.String())
+	validator, found := k.GetValidator(ctx, msg.Address)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace()).Result()
+	}
+	if err := k.ValidateValidatorPartialUnstake(ctx, validator, msg.Amount); err != nil {
+		return err.Result()
+	}
+	completionTime := k.PartialUnstakeValidator(ctx, validator, msg.Amount)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePartialUnstake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyOutputAddress, validator.GetOutputAddress().String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRotateServicerKey(ctx sdk.Ctx, msg types.MsgRotateServicerKey, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Rotate Servicer Key Message received from " + msg.Address.String())
+	if err := k.ValidateServicerKeyRotation(ctx, msg.Address, msg.ServicerPublicKey); err != nil {
+		return err.Result()
+	}
+	k.RotateServicerKey(ctx, msg.Address, msg.ServicerPublicKey)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateServicerKey,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyServicerPublicKey, msg.ServicerPublicKey.RawString()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgReportServicerLiveness(ctx sdk.Ctx, msg types.MsgReportServicerLiveness, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Servicer Liveness Report Message received from " + msg.Reporter.String())
+	info, _, err := k.HandleServicerLivenessReport(ctx, msg.Reporter, msg.Servicer, msg.Probe)
+	if err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeServicerLiveness,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Servicer.String()),
+			sdk.NewAttribute(types.AttributeKeyReporter, msg.Reporter.String()),
+			sdk.NewAttribute(types.AttributeKeyProbeFailure, msg.Failure),
+			sdk.NewAttribute(types.AttributeKeyFailureReports, fmt.Sprintf("%d", info.FailureReports)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Reporter.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 // Validators must submit a transaction to unjail itself after todo
 // having been jailed (and thus unstaked) for downtime
 func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgUnjail, k keeper.Keeper) sdk.Result {
@@ -118,3 +241,54 @@ func handleMsgSend(ctx sdk.Ctx, msg types.MsgSend, k keeper.Keeper) sdk.Result {
 	)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+func handleMsgDelegate(ctx sdk.Ctx, msg types.MsgDelegate, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Delegate Message received from " + msg.DelegatorAddr.String())
+	if err := k.ValidateDelegation(ctx, msg); err != nil {
+		return err.Result()
+	}
+	if err := k.Delegate(ctx, msg); err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddr.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgUndelegate(ctx sdk.Ctx, msg types.MsgUndelegate, k keeper.Keeper) sdk.Result {
+	ctx.Logger().Info("Undelegate Message received from " + msg.DelegatorAddr.String())
+	if err := k.ValidateUndelegation(ctx, msg); err != nil {
+		return err.Result()
+	}
+	completionTime := k.Undelegate(ctx, msg)
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddr.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
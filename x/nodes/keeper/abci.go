@@ -21,6 +21,8 @@ func BeginBlocker(ctx sdk.Ctx, req abci.RequestBeginBlock, k Keeper) {
 	k.mintNodeRelayRewards(ctx)
 	// burn any custom validator slashes
 	k.burnValidators(ctx)
+	// forget the retired servicer keys past the claims and proofs of their sessions
+	k.DeleteExpiredRetiredServicerKeys(ctx)
 	// record the new proposer for when we payout on the next block
 	addr := sdk.Address(req.Header.ProposerAddress)
 	k.SetPreviousProposer(ctx, addr)
@@ -50,5 +52,9 @@ func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	validatorUpdates := k.UpdateTendermintValidators(ctx)
 	// Unstake all mature validators from the unstakeing queue.
 	k.unstakeAllMatureValidators(ctx)
+	// Release all mature delegations from the unbonding queue.
+	k.unbondAllMatureDelegations(ctx)
+	// Release all mature partial unstakes from the partial unstakes queue.
+	k.releaseAllMaturePartialUnstakes(ctx)
 	return validatorUpdates
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
 	"github.com/pokt-network/posmint/crypto"
 	"github.com/pokt-network/posmint/types/module"
@@ -88,7 +89,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Context, []auth.Account,
 	moduleManager.InitGenesis(ctx, genesisState)
 	initialCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, valTokens))
 	accs := createTestAccs(ctx, int(nAccs), initialCoins, &ak)
-	keeper := NewKeeper(cdc, keyPOS, ak, posSubspace, "pos")
+	keeper := NewKeeper(cdc, keyPOS, ak, testPocketKeeper{}, posSubspace, "pos")
 	params := types.DefaultParams()
 	keeper.SetParams(ctx, params)
 	return ctx, accs, keeper
@@ -193,3 +194,22 @@ func modifyFn(i *int) func(index int64, Validator exported.ValidatorI) (stop boo
 		return
 	}
 }
+
+// a pocket keeper supporting every chain, the chain registry is tested by the pocketcore module
+type testPocketKeeper struct{}
+
+func (testPocketKeeper) ValidateStakingChains(_ sdk.Ctx, _ []string) sdk.Error {
+	return nil
+}
+
+// a probe is valid if present, the probe validation is tested by the pocketcore module
+func (testPocketKeeper) ValidateLivenessProbe(_ sdk.Ctx, probe []byte, _ sdk.Address, _ string, _ []string, _ int64) sdk.Error {
+	if len(probe) == 0 {
+		return types.ErrInvalidLivenessProbe(types.DefaultCodespace, fmt.Errorf("the probe is empty"))
+	}
+	return nil
+}
+
+func (testPocketKeeper) ClaimSessions(_ sdk.Ctx) int64 {
+	return 4
+}
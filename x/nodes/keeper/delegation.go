@@ -0,0 +1,357 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// get a delegation of a delegator to a validator
+func (k Keeper) GetDelegation(ctx sdk.Ctx, valAddr, delAddr sdk.Address) (delegation types.Delegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.KeyForDelegation(valAddr, delAddr))
+	if value == nil {
+		return delegation, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(value, &delegation)
+	return delegation, true
+}
+
+// set a delegation in the store
+func (k Keeper) SetDelegation(ctx sdk.Ctx, delegation types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(delegation)
+	store.Set(types.KeyForDelegation(delegation.ValidatorAddress, delegation.DelegatorAddress), bz)
+}
+
+// delete a delegation from the store
+func (k Keeper) deleteDelegation(ctx sdk.Ctx, delegation types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForDelegation(delegation.ValidatorAddress, delegation.DelegatorAddress))
+}
+
+// get all of the delegations to a validator
+func (k Keeper) GetValidatorDelegations(ctx sdk.Ctx, valAddr sdk.Address) (delegations types.Delegations) {
+	delegations = make(types.Delegations, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForValidatorDelegations(valAddr))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+	return delegations
+}
+
+// get all of the delegations of a delegator
+func (k Keeper) GetDelegatorDelegations(ctx sdk.Ctx, delAddr sdk.Address) (delegations types.Delegations) {
+	delegations = make(types.Delegations, 0)
+	for _, delegation := range k.GetAllDelegations(ctx) {
+		if delegation.DelegatorAddress.Equals(delAddr) {
+			delegations = append(delegations, delegation)
+		}
+	}
+	return delegations
+}
+
+// get every delegation in the store
+func (k Keeper) GetAllDelegations(ctx sdk.Ctx) (delegations types.Delegations) {
+	delegations = make(types.Delegations, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+	return delegations
+}
+
+// get the total tokens delegated to a validator
+func (k Keeper) getValidatorDelegatedTokens(ctx sdk.Ctx, valAddr sdk.Address) sdk.Int {
+	return k.GetValidatorDelegations(ctx, valAddr).Total()
+}
+
+// validate check called before delegating
+func (k Keeper) ValidateDelegation(ctx sdk.Ctx, msg types.MsgDelegate) sdk.Error {
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.codespace)
+	}
+	if !validator.IsStaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	if validator.IsJailed() {
+		return types.ErrValidatorJailed(k.codespace)
+	}
+	if k.IsWaitingValidator(ctx, validator.Address) {
+		return types.ErrValidatorWaitingToUnstake(k.codespace)
+	}
+	if msg.DelegatorAddr.Equals(validator.Address) {
+		return types.ErrSelfDelegation(k.codespace)
+	}
+	coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), msg.Amount))
+	if !k.AccountKeeper.HasCoins(ctx, msg.DelegatorAddr, coin) {
+		return types.ErrNotEnoughCoins(k.codespace)
+	}
+	return nil
+}
+
+// store ops when a delegator delegates to a validator
+func (k Keeper) Delegate(ctx sdk.Ctx, msg types.MsgDelegate) sdk.Error {
+	validator := k.mustGetValidator(ctx, msg.ValidatorAddr)
+	// send the coins from the delegator to the staked module account
+	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), msg.Amount))
+	err := k.AccountKeeper.SendCoinsFromAccountToModule(ctx, msg.DelegatorAddr, types.StakedPoolName, coins)
+	if err != nil {
+		return err
+	}
+	// add the coins to the staked field of the validator
+	k.setValidatorTokens(ctx, validator, validator.StakedTokens.Add(msg.Amount))
+	// update the delegator ledger of the validator
+	delegation, found := k.GetDelegation(ctx, msg.ValidatorAddr, msg.DelegatorAddr)
+	if !found {
+		delegation = types.NewDelegation(msg.DelegatorAddr, msg.ValidatorAddr, sdk.ZeroInt())
+	}
+	delegation.Amount = delegation.Amount.Add(msg.Amount)
+	k.SetDelegation(ctx, delegation)
+	ctx.Logger().Info("Successfully delegated " + msg.Amount.String() + " from " + msg.DelegatorAddr.String() + " to validator: " + msg.ValidatorAddr.String())
+	return nil
+}
+
+// validate check called before undelegating
+func (k Keeper) ValidateUndelegation(ctx sdk.Ctx, msg types.MsgUndelegate) sdk.Error {
+	validator, found := k.GetValidator(ctx, msg.ValidatorAddr)
+	if !found {
+		return types.ErrNoValidatorFound(k.codespace)
+	}
+	if validator.IsUnstaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	delegation, found := k.GetDelegation(ctx, msg.ValidatorAddr, msg.DelegatorAddr)
+	if !found {
+		return types.ErrNoDelegationFound(k.codespace)
+	}
+	if delegation.Amount.LT(msg.Amount) {
+		return types.ErrInsufficientDelegation(k.codespace)
+	}
+	if validator.StakedTokens.Sub(msg.Amount).LT(sdk.NewInt(k.MinimumStake(ctx))) {
+		return types.ErrMinimumStake(k.codespace)
+	}
+	return nil
+}
+
+// store ops when a delegator undelegates from a validator -> starts the unbonding timer
+func (k Keeper) Undelegate(ctx sdk.Ctx, msg types.MsgUndelegate) (completionTime time.Time) {
+	validator := k.mustGetValidator(ctx, msg.ValidatorAddr)
+	// remove the coins from the staked field of the validator
+	k.setValidatorTokens(ctx, validator, validator.StakedTokens.Sub(msg.Amount))
+	// update the delegator ledger of the validator
+	delegation, _ := k.GetDelegation(ctx, msg.ValidatorAddr, msg.DelegatorAddr)
+	delegation.Amount = delegation.Amount.Sub(msg.Amount)
+	if delegation.Amount.IsZero() {
+		k.deleteDelegation(ctx, delegation)
+	} else {
+		k.SetDelegation(ctx, delegation)
+	}
+	// the tokens remain in the staked pool until the unbonding time is up
+	completionTime = ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx))
+	k.SetUnbondingDelegation(ctx, types.UnbondingDelegation{
+		DelegatorAddress: msg.DelegatorAddr,
+		ValidatorAddress: msg.ValidatorAddr,
+		Amount:           msg.Amount,
+		CreationHeight:   ctx.BlockHeight(),
+		CompletionTime:   completionTime,
+	})
+	ctx.Logger().Info("Began undelegating " + msg.Amount.String() + " of " + msg.DelegatorAddr.String() + " from validator: " + msg.ValidatorAddr.String())
+	return completionTime
+}
+
+// update the staked tokens of a validator, only updating the staking set if the validator is in it
+func (k Keeper) setValidatorTokens(ctx sdk.Ctx, validator types.Validator, tokens sdk.Int) types.Validator {
+	inStakingSet := validator.IsStaked() && !validator.IsJailed()
+	if inStakingSet {
+		k.deleteValidatorFromStakingSet(ctx, validator)
+	}
+	validator.StakedTokens = tokens
+	k.SetValidator(ctx, validator)
+	if inStakingSet {
+		k.SetStakedValidator(ctx, validator)
+	}
+	return validator
+}
+
+// reduce the delegations of a validator in proportion to the tokens burned from the validator
+// returns the total amount burned from the delegations
+func (k Keeper) slashDelegations(ctx sdk.Ctx, validator types.Validator, tokensToBurn sdk.Int) (burned sdk.Int) {
+	burned = sdk.ZeroInt()
+	if !tokensToBurn.IsPositive() || !validator.StakedTokens.IsPositive() {
+		return
+	}
+	for _, delegation := range k.GetValidatorDelegations(ctx, validator.Address) {
+		amount := delegation.Amount.Mul(tokensToBurn).Quo(validator.StakedTokens) // truncates
+		delegation.Amount = delegation.Amount.Sub(amount)
+		if delegation.Amount.IsZero() {
+			k.deleteDelegation(ctx, delegation)
+		} else {
+			k.SetDelegation(ctx, delegation)
+		}
+		burned = burned.Add(amount)
+	}
+	return
+}
+
+// burn the unbonding delegations of a validator created at or after the infraction height by the slash factor
+func (k Keeper) slashUnbondingDelegations(ctx sdk.Ctx, valAddr sdk.Address, infractionHeight int64, slashFactor sdk.Dec) {
+	totalBurn := sdk.ZeroInt()
+	// only the queue entries holding unbonding delegations of the validator are rewritten
+	for _, completionTime := range k.getValidatorUnbondingTimes(ctx, valAddr) {
+		entries := k.getUnbondingDelegations(ctx, completionTime)
+		burned := sdk.ZeroInt()
+		for i, entry := range entries {
+			// only stake that contributed to the infraction is slashed
+			if entry.ValidatorAddress.Equals(valAddr) && entry.CreationHeight >= infractionHeight {
+				burn := sdk.MinInt(entry.Amount.ToDec().Mul(slashFactor).TruncateInt(), entry.Amount)
+				entries[i].Amount = entry.Amount.Sub(burn)
+				burned = burned.Add(burn)
+			}
+		}
+		if burned.IsPositive() {
+			k.setUnbondingDelegations(ctx, completionTime, entries)
+			totalBurn = totalBurn.Add(burned)
+		}
+	}
+	if totalBurn.IsZero() {
+		return
+	}
+	if err := k.burnStakedTokens(ctx, totalBurn); err != nil {
+		panic(err)
+	}
+}
+
+// pay out and delete the delegations of a validator -> used when a validator finishes unstaking
+// returns the total amount paid out
+func (k Keeper) releaseDelegations(ctx sdk.Ctx, validator types.Validator) (released sdk.Int) {
+	released = sdk.ZeroInt()
+	for _, delegation := range k.GetValidatorDelegations(ctx, validator.Address) {
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), delegation.Amount))
+		err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, delegation.DelegatorAddress, coins)
+		if err != nil {
+			panic(err)
+		}
+		k.deleteDelegation(ctx, delegation)
+		released = released.Add(delegation.Amount)
+	}
+	return
+}
+
+// Insert an unbonding delegation to the appropriate position in the unbonding queue
+func (k Keeper) SetUnbondingDelegation(ctx sdk.Ctx, ubd types.UnbondingDelegation) {
+	entries := k.getUnbondingDelegations(ctx, ubd.CompletionTime)
+	entries = append(entries, ubd)
+	k.setUnbondingDelegations(ctx, ubd.CompletionTime, entries)
+	// index the completion time by validator
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForValidatorUnbonding(ubd.ValidatorAddress, ubd.CompletionTime), sdk.FormatTimeBytes(ubd.CompletionTime))
+}
+
+// get the completion times of the unbonding delegations of a validator
+func (k Keeper) getValidatorUnbondingTimes(ctx sdk.Ctx, valAddr sdk.Address) (completionTimes []time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForValidatorUnbondings(valAddr))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+		completionTimes = append(completionTimes, completionTime)
+	}
+	return
+}
+
+// get all of the unbonding delegations in the queue
+func (k Keeper) GetAllUnbondingDelegations(ctx sdk.Ctx) (entries types.UnbondingDelegations) {
+	entries = make(types.UnbondingDelegations, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnbondingDelegationsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var ubds types.UnbondingDelegations
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &ubds)
+		entries = append(entries, ubds...)
+	}
+	return entries
+}
+
+// get all of the unbonding delegations of a delegator
+func (k Keeper) GetDelegatorUnbondingDelegations(ctx sdk.Ctx, delAddr sdk.Address) (entries types.UnbondingDelegations) {
+	entries = make(types.UnbondingDelegations, 0)
+	for _, ubd := range k.GetAllUnbondingDelegations(ctx) {
+		if ubd.DelegatorAddress.Equals(delAddr) {
+			entries = append(entries, ubd)
+		}
+	}
+	return entries
+}
+
+// gets all of the unbonding delegations that complete at exactly this time
+func (k Keeper) getUnbondingDelegations(ctx sdk.Ctx, completionTime time.Time) (entries types.UnbondingDelegations) {
+	entries = make(types.UnbondingDelegations, 0)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForUnbondingDelegations(completionTime))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &entries)
+	return
+}
+
+// Sets unbonding delegations in the queue at a certain completion time
+func (k Keeper) setUnbondingDelegations(ctx sdk.Ctx, completionTime time.Time, entries types.UnbondingDelegations) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(entries)
+	store.Set(types.KeyForUnbondingDelegations(completionTime), bz)
+}
+
+// iterator for all unbonding delegations up to a certain time
+func (k Keeper) unbondingDelegationsIterator(ctx sdk.Ctx, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.UnbondingDelegationsKey, sdk.InclusiveEndBytes(types.KeyForUnbondingDelegations(endTime)))
+}
+
+// Releases all the unbonding delegations that have finished their unbonding period
+func (k Keeper) unbondAllMatureDelegations(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := k.unbondingDelegationsIterator(ctx, ctx.BlockHeader().Time)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entries types.UnbondingDelegations
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &entries)
+		for _, ubd := range entries {
+			if ubd.Amount.IsPositive() {
+				coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), ubd.Amount))
+				err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, ubd.DelegatorAddress, coins)
+				if err != nil {
+					panic(err)
+				}
+			}
+			store.Delete(types.KeyForValidatorUnbonding(ubd.ValidatorAddress, ubd.CompletionTime))
+			ctx.Logger().Info(fmt.Sprintf("Finished undelegating %s of %s from validator %s", ubd.Amount, ubd.DelegatorAddress, ubd.ValidatorAddress))
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCompleteUndelegation,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyDelegator, ubd.DelegatorAddress.String()),
+					sdk.NewAttribute(types.AttributeKeyValidator, ubd.ValidatorAddress.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, ubd.Amount.String()),
+				),
+			)
+		}
+		store.Delete(iterator.Key())
+	}
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// stakes a validator and funds a delegator
+func setupDelegation(t *testing.T) (sdk.Context, Keeper, types.Validator, sdk.Address) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	keeper.SetValidator(context, validator)
+	keeper.SetStakedValidator(context, validator)
+	delegator := getRandomValidatorAddress()
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, delegator, sdk.NewInt(100000000000))
+	return context, keeper, validator, delegator
+}
+
+func TestKeeper_Delegate(t *testing.T) {
+	context, keeper, validator, delegator := setupDelegation(t)
+	amount := sdk.NewInt(1000000)
+	msg := types.MsgDelegate{DelegatorAddr: delegator, ValidatorAddr: validator.Address, Amount: amount}
+	assert.Nil(t, keeper.ValidateDelegation(context, msg))
+	assert.Nil(t, keeper.Delegate(context, msg))
+	// the tokens are added to the validator stake
+	val, found := keeper.GetValidator(context, validator.Address)
+	assert.True(t, found)
+	assert.True(t, validator.StakedTokens.Add(amount).Equal(val.StakedTokens))
+	// the delegation is recorded in the ledger
+	delegation, found := keeper.GetDelegation(context, validator.Address, delegator)
+	assert.True(t, found)
+	assert.True(t, amount.Equal(delegation.Amount))
+	assert.Len(t, keeper.GetValidatorDelegations(context, validator.Address), 1)
+	assert.Len(t, keeper.GetDelegatorDelegations(context, delegator), 1)
+	// delegating again adds to the delegation
+	assert.Nil(t, keeper.Delegate(context, msg))
+	delegation, _ = keeper.GetDelegation(context, validator.Address, delegator)
+	assert.True(t, amount.Add(amount).Equal(delegation.Amount))
+}
+
+func TestKeeper_ValidateDelegation(t *testing.T) {
+	context, keeper, validator, delegator := setupDelegation(t)
+	jailed := getStakedJailedValidator()
+	keeper.SetValidator(context, jailed)
+	unstaking := getUnstakingValidator()
+	keeper.SetValidator(context, unstaking)
+	amount := sdk.NewInt(1000000)
+	tests := []struct {
+		name string
+		msg  types.MsgDelegate
+		err  sdk.Error
+	}{
+		{"validator not found", types.MsgDelegate{DelegatorAddr: delegator, ValidatorAddr: getRandomValidatorAddress(), Amount: amount}, types.ErrNoValidatorFound(types.DefaultCodespace)},
+		{"validator jailed", types.MsgDelegate{DelegatorAddr: delegator, ValidatorAddr: jailed.Address, Amount: amount}, types.ErrValidatorJailed(types.DefaultCodespace)},
+		{"validator unstaking", types.MsgDelegate{DelegatorAddr: delegator, ValidatorAddr: unstaking.Address, Amount: amount}, types.ErrValidatorStatus(types.DefaultCodespace)},
+		{"self delegation", types.MsgDelegate{DelegatorAddr: validator.Address, ValidatorAddr: validator.Address, Amount: amount}, types.ErrSelfDelegation(types.DefaultCodespace)},
+		{"not enough coins", types.MsgDelegate{DelegatorAddr: getRandomValidatorAddress(), ValidatorAddr: validator.Address, Amount: amount}, types.ErrNotEnoughCoins(types.DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := keeper.ValidateDelegation(context, tt.msg)
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.err.Code(), err.Code())
+		})
+	}
+}
+
+func TestKeeper_Undelegate(t *testing.T) {
+	context, keeper, validator, delegator := setupDelegation(t)
+	amount := sdk.NewInt(1000000)
+	assert.Nil(t, keeper.Delegate(context, types.MsgDelegate{DelegatorAddr: delegator, ValidatorAddr: validator.Address, Amount: amount}))
+	balance := keeper.GetBalance(context, delegator)
+	// can't undelegate more than delegated
+	err := keeper.ValidateUndelegation(context, types.MsgUndelegate{DelegatorAddr: delegator, ValidatorAddr: validator.Address, Amount: amount.Add(sdk.OneInt())})
+	assert.Equal(t, types.ErrInsufficientDelegation(types.DefaultCodespace).Code(), err.Code())
+	err = keeper.ValidateUndelegation(context, types.MsgUndelegate{DelegatorAddr: getRandomValidatorAddress(), ValidatorAddr: validator.Address, Amount: amount})
+	assert.Equal(t, types.ErrNoDelegationFound(types.DefaultCodespace).Code(), err.Code())
+	// can't undelegate below the minimum stake of the validator
+	belowMinimum := validator
+	belowMinimum.StakedTokens = sdk.NewInt(keeper.MinimumStake(context))
+	keeper.SetValidator(context, belowMinimum)
+	err = keeper.ValidateUndelegation(context, types.MsgUndelegate{DelegatorAddr: delegator, ValidatorAddr: validator.Address, Amount: sdk.OneInt()})
+	assert.Equal(t, types.ErrMinimumStake(types.DefaultCodespace).Code(), err.Code())
+	keeper.SetValidator(context, validator.AddStakedTokens(amount))
+	// undelegate half
+	half := amount.Quo(sdk.NewInt(2))
+	msg := types.MsgUndelegate{DelegatorAddr: delegator, ValidatorAddr: validator.Address, Amount: half}
+	assert.Nil(t, keeper.ValidateUndelegation(context, msg))
+	completionTime := keeper.Undelegate(context, msg)
+	val, _ := keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.StakedTokens.Add(half).Equal(val.StakedTokens))
+	delegation, _ := keeper.GetDelegation(context, validator.Address, delegator)
+	assert.True(t, half.Equal(delegation.Amount))
+	unbonding := keeper.GetDelegatorUnbondingDelegations(context, delegator)
+	assert.Len(t, unbonding, 1)
+	assert.True(t, half.Equal(unbonding[0].Amount))
+	assert.Len(t, keeper.getValidatorUnbondingTimes(context, validator.Address), 1)
+	// the tokens are not released before the completion time
+	keeper.unbondAllMatureDelegations(context)
+	assert.True(t, balance.Equal(keeper.GetBalance(context, delegator)))
+	// the tokens are released after the completion time
+	context = context.WithBlockTime(completionTime.Add(time.Second))
+	keeper.unbondAllMatureDelegations(context)
+	assert.True(t, balance.Add(half).Equal(keeper.GetBalance(context, delegator)))
+	assert.Empty(t, keeper.GetAllUnbondingDelegations(context))
+	assert.Empty(t, keeper.getValidatorUnbondingTimes(context, validator.Address))
+	// undelegating the rest removes the delegation
+	keeper.Undelegate(context, msg)
+	_, found := keeper.GetDelegation(context, validator.Address, delegator)
+	assert.False(t, found)
+}
+
+func TestKeeper_MintRelayRewardWithDelegations(t *testing.T) {
+	context, keeper, validator, delegator := setupDelegation(t)
+	validator.CommissionRate = 10
+	keeper.SetValidator(context, validator)
+	// the delegator owns half of the validator stake
+	amount := validator.StakedTokens
+	assert.Nil(t, keeper.Delegate(context, types.MsgDelegate{DelegatorAddr: delegator, ValidatorAddr: validator.Address, Amount: amount}))
+	delegatorBalance := keeper.GetBalance(context, delegator)
+	validatorBalance := keeper.GetBalance(context, validator.Address)
+	keeper.mintRelayReward(context, sdk.NewInt(1000), validator.Address)
+	// the delegator receives half of the reward minus the 10% commission
+	assert.True(t, delegatorBalance.Add(sdk.NewInt(450)).Equal(keeper.GetBalance(context, delegator)))
+	assert.True(t, validatorBalance.Add(sdk.NewInt(550)).Equal(keeper.GetBalance(context, validator.Address)))
+}
+
+func TestKeeper_SlashWithDelegations(t *testing.T) {
+	context, keeper, validator, delegator := setupDelegation(t)
+	amount := validator.StakedTokens
+	assert.Nil(t, keeper.Delegate(context, types.MsgDelegate{DelegatorAddr: delegator, ValidatorAddr: validator.Address, Amount: amount}))
+	// the delegator unbonds part of the stake after the infraction height
+	unbonding := sdk.NewInt(1000000)
+	context = context.WithBlockHeight(10)
+	keeper.Undelegate(context, types.MsgUndelegate{DelegatorAddr: delegator, ValidatorAddr: validator.Address, Amount: unbonding})
+	val, _ := keeper.GetValidator(context, validator.Address)
+	stakedPool := keeper.GetStakedTokens(context)
+	power := val.ConsensusPower()
+	fraction := sdk.NewDecWithPrec(1, 1) // 10%
+	keeper.slash(context, validator.Address, 5, power, fraction)
+	// the delegation is slashed in proportion to the validator stake
+	burned := sdk.TokensFromConsensusPower(power).ToDec().Mul(fraction).TruncateInt()
+	delegation, _ := keeper.GetDelegation(context, validator.Address, delegator)
+	expected := amount.Sub(unbonding)
+	expected = expected.Sub(expected.Mul(burned).Quo(val.StakedTokens))
+	assert.True(t, expected.Equal(delegation.Amount))
+	// the unbonding delegation is slashed by the fraction
+	entries := keeper.GetDelegatorUnbondingDelegations(context, delegator)
+	assert.Len(t, entries, 1)
+	assert.True(t, sdk.NewInt(900000).Equal(entries[0].Amount))
+	// the burned tokens are removed from the staked pool
+	assert.True(t, stakedPool.Sub(burned).Sub(sdk.NewInt(100000)).Equal(keeper.GetStakedTokens(context)))
+}
+
+func TestKeeper_FinishUnstakingWithDelegations(t *testing.T) {
+	context, keeper, validator, delegator := setupDelegation(t)
+	amount := sdk.NewInt(1000000)
+	assert.Nil(t, keeper.Delegate(context, types.MsgDelegate{DelegatorAddr: delegator, ValidatorAddr: validator.Address, Amount: amount}))
+	balance := keeper.GetBalance(context, delegator)
+	validatorBalance := keeper.GetBalance(context, validator.Address)
+	val, _ := keeper.GetValidator(context, validator.Address)
+	keeper.BeginUnstakingValidator(context, val)
+	val, _ = keeper.GetValidator(context, validator.Address)
+	keeper.FinishUnstakingValidator(context, val)
+	// the delegator receives the delegation and the validator its own stake
+	assert.True(t, balance.Add(amount).Equal(keeper.GetBalance(context, delegator)))
+	assert.True(t, validatorBalance.Add(validator.StakedTokens).Equal(keeper.GetBalance(context, validator.Address)))
+	assert.Empty(t, keeper.GetValidatorDelegations(context, validator.Address))
+}
+
+func TestKeeper_ForceUnstakeWithDelegations(t *testing.T) {
+	context, keeper, validator, delegator := setupDelegation(t)
+	amount := sdk.NewInt(1000000)
+	assert.Nil(t, keeper.Delegate(context, types.MsgDelegate{DelegatorAddr: delegator, ValidatorAddr: validator.Address, Amount: amount}))
+	balance := keeper.GetBalance(context, delegator)
+	val, _ := keeper.GetValidator(context, validator.Address)
+	assert.Nil(t, keeper.ForceValidatorUnstake(context, val))
+	// the delegator receives the delegation
+	assert.True(t, balance.Add(amount).Equal(keeper.GetBalance(context, delegator)))
+	assert.Empty(t, keeper.GetValidatorDelegations(context, validator.Address))
+}
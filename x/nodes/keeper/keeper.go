@@ -21,6 +21,7 @@ type Keeper struct {
 	cdc                *codec.Codec
 	govKeeper          govKeeper.Keeper
 	AccountKeeper      types.AuthKeeper
+	PocketKeeper       types.PocketKeeper
 	Paramstore         sdk.Subspace
 	validatorCache     map[string]cachedValidator
 	validatorCacheList *list.List
@@ -30,16 +31,21 @@ type Keeper struct {
 }
 
 // NewKeeper creates a new staking Keeper instance
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, accountKeeper types.AuthKeeper,
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, accountKeeper types.AuthKeeper, pocketKeeper types.PocketKeeper,
 	paramstore sdk.Subspace, codespace sdk.CodespaceType) Keeper {
 	// ensure staked module accounts are set
 	if addr := accountKeeper.GetModuleAddress(types.StakedPoolName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.StakedPoolName))
 	}
+	// ensure the chains of the stakes are validated
+	if pocketKeeper == nil {
+		panic("the pocket keeper validating the staked chains has not been set")
+	}
 	return Keeper{
 		storeKey:           key,
 		cdc:                cdc,
 		AccountKeeper:      accountKeeper,
+		PocketKeeper:       pocketKeeper,
 		Paramstore:         paramstore.WithKeyTable(ParamKeyTable()),
 		validatorCache:     make(map[string]cachedValidator, aminoCacheSize),
 		validatorCacheList: list.New(),
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/pokt-network/pocket-core/x/nodes/types"
@@ -101,27 +103,62 @@ func (k Keeper) DAOAllocation(ctx sdk.Ctx) (res int64) {
 	return
 }
 
+// the # of validators reporting a servicer unreachable in a session to jail it
+// the default until set, as a state from before the param doesn't hold it
+func (k Keeper) ServicerLivenessJailThreshold(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultServicerLivenessJailThreshold
+	k.Paramstore.GetIfExists(ctx, types.KeyServicerLivenessJailThreshold, &res)
+	return
+}
+
+// the minimum amount of time a servicer spends in jail after being reported unreachable
+// the default until set, as a state from before the param doesn't hold it
+func (k Keeper) ServicerLivenessJailDuration(ctx sdk.Ctx) (res time.Duration) {
+	res = types.DefaultServicerLivenessJailDuration
+	k.Paramstore.GetIfExists(ctx, types.KeyServicerLivenessJailDuration, &res)
+	return
+}
+
 func (k Keeper) BlocksPerSession(ctx sdk.Ctx) (res int64) {
 	k.Paramstore.Get(ctx, types.KeySessionBlock, &res)
 	return
 }
 
+// MessageFees - the fees of the messages of the module
+// the default fees until set, as a state from before the param doesn't hold it
+func (k Keeper) MessageFees(ctx sdk.Ctx) (res types.MessageFees) {
+	res = append(types.MessageFees(nil), types.DefaultMessageFees...)
+	k.Paramstore.GetIfExists(ctx, types.KeyMessageFees, &res)
+	return
+}
+
+// MessageFee - the fee of the message type (in uPOKT), its default fee if the message is newer than the fees in state
+func (k Keeper) MessageFee(ctx sdk.Ctx, msgType string) sdk.Int {
+	if fee, found := k.MessageFees(ctx).Fee(msgType); found {
+		return sdk.NewInt(fee)
+	}
+	return types.DefaultMessageFees.Amount(msgType)
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
-		UnstakingTime:           k.UnStakingTime(ctx),
-		MaxValidators:           k.MaxValidators(ctx),
-		StakeDenom:              k.StakeDenom(ctx),
-		StakeMinimum:            k.MinimumStake(ctx),
-		ProposerAllocation:      k.ProposerAllocation(ctx),
-		SessionBlockFrequency:   k.BlocksPerSession(ctx),
-		DAOAllocation:           k.DAOAllocation(ctx),
-		MaxEvidenceAge:          k.MaxEvidenceAge(ctx),
-		SignedBlocksWindow:      k.SignedBlocksWindow(ctx),
-		MinSignedPerWindow:      sdk.NewDec(k.MinSignedPerWindow(ctx)),
-		DowntimeJailDuration:    k.DowntimeJailDuration(ctx),
-		SlashFractionDoubleSign: k.SlashFractionDoubleSign(ctx),
-		SlashFractionDowntime:   k.SlashFractionDowntime(ctx),
+		UnstakingTime:                 k.UnStakingTime(ctx),
+		MaxValidators:                 k.MaxValidators(ctx),
+		StakeDenom:                    k.StakeDenom(ctx),
+		StakeMinimum:                  k.MinimumStake(ctx),
+		ProposerAllocation:            k.ProposerAllocation(ctx),
+		SessionBlockFrequency:         k.BlocksPerSession(ctx),
+		DAOAllocation:                 k.DAOAllocation(ctx),
+		MaxEvidenceAge:                k.MaxEvidenceAge(ctx),
+		SignedBlocksWindow:            k.SignedBlocksWindow(ctx),
+		MinSignedPerWindow:            sdk.NewDec(k.MinSignedPerWindow(ctx)),
+		DowntimeJailDuration:          k.DowntimeJailDuration(ctx),
+		SlashFractionDoubleSign:       k.SlashFractionDoubleSign(ctx),
+		SlashFractionDowntime:         k.SlashFractionDowntime(ctx),
+		ServicerLivenessJailThreshold: k.ServicerLivenessJailThreshold(ctx),
+		ServicerLivenessJailDuration:  k.ServicerLivenessJailDuration(ctx),
+		MessageFees:                   k.MessageFees(ctx),
 	}
 }
 
@@ -129,3 +166,36 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 func (k Keeper) SetParams(ctx sdk.Ctx, params types.Params) {
 	k.Paramstore.SetParamSet(ctx, &params)
 }
+
+// simulates the change of a param against the current state without committing it, reporting the affected nodes
+func (k Keeper) SimulateParamChange(ctx sdk.Ctx, key string, value []byte) (types.ParamChangeReport, sdk.Error) {
+	params, _, err := k.GetParams(ctx).ChangeParam(key, value)
+	if err != nil {
+		return types.ParamChangeReport{}, types.ErrInvalidParamChange(k.codespace, err)
+	}
+	// the change is applied to a cached context, which is discarded
+	cacheCtx, _ := ctx.CacheContext()
+	k.SetParams(cacheCtx, params)
+	report := types.ParamChangeReport{ParamKey: key, Params: params, AffectedNodes: make([]types.ParamChangeEffect, 0)}
+	validators := k.GetStakedValidators(cacheCtx)
+	// rank the validators by stake, the lowest stakes fall out of the max validators first
+	sort.SliceStable(validators, func(i, j int) bool {
+		return validators[i].GetTokens().GT(validators[j].GetTokens())
+	})
+	minStake := sdk.NewInt(params.StakeMinimum)
+	for i, validator := range validators {
+		if validator.GetTokens().LT(minStake) {
+			report.AffectedNodes = append(report.AffectedNodes, types.ParamChangeEffect{
+				Address: validator.GetAddress(),
+				Effect:  fmt.Sprintf("the stake %s is below the minimum stake %s", validator.GetTokens(), minStake),
+			})
+		}
+		if uint64(i) >= params.MaxValidators {
+			report.AffectedNodes = append(report.AffectedNodes, types.ParamChangeEffect{
+				Address: validator.GetAddress(),
+				Effect:  fmt.Sprintf("exceeds the max validators %d", params.MaxValidators),
+			})
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_SimulateParamChange(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	keeper.SetStakedValidator(context, validator)
+	other := getStakedValidator()
+	keeper.SetValidator(context, other)
+	keeper.SetStakedValidator(context, other)
+	staked := len(keeper.GetStakedValidators(context))
+	// raising the minimum stake above the stake of the validator
+	minStake := validator.StakedTokens.Add(sdk.OneInt())
+	report, err := keeper.SimulateParamChange(context, string(types.KeyStakeMinimum), []byte(`"`+minStake.String()+`"`))
+	if err != nil {
+		t.Fatalf("SimulateParamChange() = unexpected error %v", err)
+	}
+	if report.Params.StakeMinimum != minStake.Int64() || len(report.AffectedNodes) == 0 {
+		t.Errorf("SimulateParamChange() = got %v, want the validator below the minimum stake", report)
+	}
+	// lowering the max validators below the staked validators
+	report, err = keeper.SimulateParamChange(context, string(types.KeyMaxValidators), []byte(`"`+sdk.NewInt(int64(staked-1)).String()+`"`))
+	if err != nil {
+		t.Fatalf("SimulateParamChange() = unexpected error %v", err)
+	}
+	if len(report.AffectedNodes) != 1 {
+		t.Errorf("SimulateParamChange() = got %v, want one validator over the max validators", report)
+	}
+	// the change is not committed
+	if keeper.MinimumStake(context) != types.DefaultMinStake {
+		t.Errorf("SimulateParamChange() = the change was committed")
+	}
+	// an unknown param is rejected
+	if _, err := keeper.SimulateParamChange(context, "UnknownParam", []byte(`"1"`)); err == nil {
+		t.Errorf("SimulateParamChange() = expected an error for an unknown param")
+	}
+}
+
+func TestKeeper_ParamsDefaultUntilSet(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	// a state from before the params doesn't hold them
+	store := context.KVStore(sdk.ParamsKey)
+	for _, key := range [][]byte{types.KeyServicerLivenessJailThreshold, types.KeyServicerLivenessJailDuration, types.KeyMessageFees} {
+		store.Delete(append([]byte(keeper.Paramstore.Name()+"/"), key...))
+		assert.False(t, keeper.Paramstore.Has(context, key))
+	}
+	assert.Equal(t, types.DefaultServicerLivenessJailThreshold, keeper.ServicerLivenessJailThreshold(context))
+	assert.Equal(t, types.DefaultServicerLivenessJailDuration, keeper.ServicerLivenessJailDuration(context))
+	assert.Equal(t, types.DefaultMessageFees, keeper.MessageFees(context))
+	params := keeper.GetParams(context)
+	assert.Equal(t, types.DefaultServicerLivenessJailDuration, params.ServicerLivenessJailDuration)
+	assert.Nil(t, params.MessageFees.Validate(types.DefaultMessageFees))
+	// a message newer than the fees in state has its default fee
+	keeper.Paramstore.Set(context, types.KeyMessageFees, types.DefaultMessageFees[1:])
+	assert.Equal(t, sdk.NewInt(types.StakeFee), keeper.MessageFee(context, types.MsgStakeName))
+}
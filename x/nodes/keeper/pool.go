@@ -28,10 +28,10 @@ func (k Keeper) GetStakedPool(ctx sdk.Ctx) (stakedPool exported.ModuleAccountI)
 	return k.AccountKeeper.GetModuleAccount(ctx, types.StakedPoolName)
 }
 
-// moves coins from the module account to the validator -> used in unstaking
+// moves coins from the module account to the validator output address -> used in unstaking
 func (k Keeper) coinsFromStakedToUnstaked(ctx sdk.Ctx, validator types.Validator) {
 	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), validator.StakedTokens))
-	err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, validator.Address, coins)
+	err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, validator.GetOutputAddress(), coins)
 	if err != nil {
 		panic(err)
 	}
@@ -33,6 +33,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryAccount(ctx, req, k)
 		case types.QueryParameters:
 			return queryParameters(ctx, k)
+		case types.QueryValidatorDelegations:
+			return queryValidatorDelegations(ctx, req, k)
+		case types.QueryDelegatorDelegations:
+			return queryDelegatorDelegations(ctx, req, k)
+		case types.QueryUnbondingDelegations:
+			return queryUnbondingDelegations(ctx, req, k)
+		case types.QueryParamChange:
+			return queryParamChange(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -117,6 +125,48 @@ func queryValidator(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.E
 	return res, nil
 }
 
+func queryValidatorDelegations(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	delegations := k.GetValidatorDelegations(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryDelegatorDelegations(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	delegations := k.GetDelegatorDelegations(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
+func queryUnbondingDelegations(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	entries := k.GetDelegatorUnbondingDelegations(ctx, params.Address)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, entries)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
+
 func queryStakedPool(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
 	stakedTokens := k.GetStakedTokens(ctx)
 	pool := types.StakingPool(types.NewPool(stakedTokens))
@@ -188,3 +238,20 @@ func querySigningInfos(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sd
 	}
 	return res, nil
 }
+
+func queryParamChange(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryParamChangeParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	report, er := k.SimulateParamChange(ctx, params.Key, []byte(params.Value))
+	if er != nil {
+		return nil, er
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, report)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
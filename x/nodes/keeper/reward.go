@@ -17,6 +17,14 @@ func (k Keeper) RewardForRelays(ctx sdk.Ctx, relays sdk.Int, address sdk.Address
 	ctx.Logger().Info("Custom award of " + coins.String() + " set for " + address.String())
 }
 
+// award tokens paid by the escrow of a prepaid application for the relays of a validator
+// the payment is burned from the escrow and distributed like a relay reward (dao, proposer and delegators included)
+func (k Keeper) RewardForPrepaidRelays(ctx sdk.Ctx, tokens sdk.Int, address sdk.Address) {
+	award, _ := k.getValidatorAward(ctx, address)
+	k.setValidatorAward(ctx, award.Add(tokens), address)
+	ctx.Logger().Info("Prepaid award of " + tokens.String() + " set for " + address.String())
+}
+
 // blockReward handles distribution of the collected fees
 func (k Keeper) blockReward(ctx sdk.Ctx, previousProposer sdk.Address) {
 	logger := k.Logger(ctx)
@@ -57,21 +65,22 @@ func (k Keeper) blockReward(ctx sdk.Ctx, previousProposer sdk.Address) {
 		if err != nil {
 			panic(err)
 		}
-		// send to validator
-		if err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposerValidator.GetAddress(), propRewardCoins); err != nil {
+		// send to the output address of the validator
+		if err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposerValidator.GetOutputAddress(), propRewardCoins); err != nil {
 			panic(err)
 		}
 		// send to rest dao
 		if err := k.AccountKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, govTypes.DAOAccountName, daoRewardCoins); err != nil {
 			panic(err)
 		}
-		logger.Info(fmt.Sprintf("minted %s to block proposer: %s", propRewardCoins.String(), proposerValidator.GetAddress().String()))
+		logger.Info(fmt.Sprintf("minted %s to block proposer: %s", propRewardCoins.String(), proposerValidator.GetOutputAddress().String()))
 		logger.Info(fmt.Sprintf("minted %s to DAO", daoRewardCoins.String()))
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeProposerReward,
 				sdk.NewAttribute(sdk.AttributeKeyAmount, proposerReward.String()),
 				sdk.NewAttribute(types.AttributeKeyValidator, proposerValidator.GetAddress().String()),
+				sdk.NewAttribute(types.AttributeKeyOutputAddress, proposerValidator.GetOutputAddress().String()),
 			),
 		)
 		ctx.EventManager().EmitEvent(
@@ -137,13 +146,60 @@ func (k Keeper) mintNodeRelayRewards(ctx sdk.Ctx) {
 		address := sdk.Address(types.AddressFromKey(iterator.Key()))
 		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
 		amount = k.NodeCutOfReward(ctx).Mul(amount).Quo(sdk.NewInt(100)) // truncate
-		k.mint(ctx, amount, address)
+		k.mintRelayReward(ctx, amount, address)
 		// remove from the award store
 		store.Delete(iterator.Key())
 		ctx.Logger().Info("Relay reward of " + amount.String() + " minted to" + address.String())
 	}
 }
 
+// splits the relay reward of a validator between the validator and its delegators
+// the delegators share is proportional to their stake, minus the commission of the validator
+// the validator share is minted to its output address
+func (k Keeper) mintRelayReward(ctx sdk.Ctx, amount sdk.Int, address sdk.Address) {
+	validator, found := k.GetValidator(ctx, address)
+	if !found {
+		k.mint(ctx, amount, address)
+		return
+	}
+	output := validator.GetOutputAddress()
+	if !validator.StakedTokens.IsPositive() {
+		k.mint(ctx, amount, output)
+		return
+	}
+	delegations := k.GetValidatorDelegations(ctx, address)
+	delegated := delegations.Total()
+	if delegated.IsZero() {
+		k.mint(ctx, amount, output)
+		return
+	}
+	// the share of the reward produced by the delegated stake
+	delegatorsReward := amount.Mul(delegated).Quo(validator.StakedTokens) // truncates
+	commission := delegatorsReward.Mul(sdk.NewInt(validator.CommissionRate)).Quo(sdk.NewInt(100))
+	delegatorsReward = delegatorsReward.Sub(commission)
+	distributed := sdk.ZeroInt()
+	for _, delegation := range delegations {
+		reward := delegatorsReward.Mul(delegation.Amount).Quo(delegated) // truncates
+		if !reward.IsPositive() {
+			continue
+		}
+		k.mint(ctx, reward, delegation.DelegatorAddress)
+		distributed = distributed.Add(reward)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDelegatorReward,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, reward.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, address.String()),
+			),
+		)
+	}
+	// the validator keeps its own share, the commission and the truncated remainder
+	if operatorReward := amount.Sub(distributed); operatorReward.IsPositive() {
+		k.mint(ctx, operatorReward, output)
+	}
+}
+
 // Mints sdk.Coins and sends them to an address
 func (k Keeper) mint(ctx sdk.Ctx, amount sdk.Int, address sdk.Address) sdk.Result {
 	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
@@ -267,6 +267,18 @@ func TestKeeper_AwardCoinsTo(t *testing.T) {
 	}
 }
 
+func TestKeeper_RewardForPrepaidRelays(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	address := getRandomValidatorAddress()
+	keeper.RewardForRelays(context, sdk.NewInt(2), address)
+	keeper.RewardForPrepaidRelays(context, sdk.NewInt(10), address)
+	// the prepaid tokens are added to the relay award as is, without the relays to tokens multiplier
+	want := keeper.RelaysToTokensMultiplier(context).MulRaw(2).AddRaw(10)
+	if got, _ := keeper.getValidatorAward(context, address); !got.Equal(want) {
+		t.Errorf("RewardForPrepaidRelays() = %v, want %v", got, want)
+	}
+}
+
 func TestKeeper_rewardFromFees(t *testing.T) {
 	type fields struct {
 		keeper Keeper
@@ -299,3 +311,14 @@ func TestKeeper_rewardFromFees(t *testing.T) {
 		})
 	}
 }
+
+func TestMintRelayRewardToOutputAddress(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	validator.OutputAddress = getRandomValidatorAddress()
+	keeper.SetValidator(context, validator)
+	keeper.mintRelayReward(context, sdk.NewInt(1000), validator.Address)
+	// the reward is minted to the output address, not the operator
+	assert.True(t, sdk.NewInt(1000).Equal(keeper.GetBalance(context, validator.OutputAddress)))
+	assert.True(t, keeper.GetBalance(context, validator.Address).IsZero())
+}
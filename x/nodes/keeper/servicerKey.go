@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"github.com/pokt-network/pocket-core/x/nodes/exported"
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// get the address of the validator that registered the servicer key with the address
+func (k Keeper) getServicerKeyIndex(ctx sdk.Ctx, servicerAddr sdk.Address) (addr sdk.Address, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForServicerKey(servicerAddr))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.Address(bz), true
+}
+
+// index the validator by the address of its servicer key
+func (k Keeper) setServicerKeyIndex(ctx sdk.Ctx, validator types.Validator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForServicerKey(validator.GetServicerAddress()), validator.Address)
+}
+
+// delete the index of the servicer key with the address
+func (k Keeper) deleteServicerKeyIndex(ctx sdk.Ctx, servicerAddr sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForServicerKey(servicerAddr))
+}
+
+// get the validator serving with the servicer key of the address
+// a validator without a registered servicer key serves with its consensus key
+func (k Keeper) GetValidatorByServicerAddress(ctx sdk.Ctx, servicerAddr sdk.Address) (validator types.Validator, found bool) {
+	addr, found := k.getServicerKeyIndex(ctx, servicerAddr)
+	if !found {
+		addr = servicerAddr
+	}
+	validator, found = k.GetValidator(ctx, addr)
+	if !found || !validator.GetServicerAddress().Equals(servicerAddr) {
+		return types.Validator{}, false
+	}
+	return validator, true
+}
+
+// wrapper of GetValidatorByServicerAddress for the validator interface, returns nil if not found
+func (k Keeper) ValidatorByServicerAddress(ctx sdk.Ctx, servicerAddr sdk.Address) exported.ValidatorI {
+	validator, found := k.GetValidatorByServicerAddress(ctx, servicerAddr)
+	if !found {
+		return nil
+	}
+	return validator
+}
+
+// validate check called before rotating the servicer key of a validator
+func (k Keeper) ValidateServicerKeyRotation(ctx sdk.Ctx, addr sdk.Address, servicerPubKey crypto.PublicKey) sdk.Error {
+	validator, found := k.GetValidator(ctx, addr)
+	if !found {
+		return types.ErrNoValidatorFound(k.codespace)
+	}
+	if validator.IsUnstaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	servicerAddr := sdk.Address(servicerPubKey.Address())
+	// the servicer key can't be registered by another validator
+	if owner, found := k.getServicerKeyIndex(ctx, servicerAddr); found && !owner.Equals(addr) {
+		return types.ErrServicerKeyInUse(k.codespace)
+	}
+	// nor while it is retired by another validator, as it may still hold its claims
+	if retired, found := k.GetRetiredServicerKey(ctx, servicerAddr); found && !retired.ValidatorAddress.Equals(addr) {
+		return types.ErrServicerKeyInUse(k.codespace)
+	}
+	// nor be the consensus key of another validator
+	if !servicerAddr.Equals(addr) {
+		if _, found := k.GetValidator(ctx, servicerAddr); found {
+			return types.ErrServicerKeyInUse(k.codespace)
+		}
+	}
+	return nil
+}
+
+// store ops to rotate the servicer key of a validator, effective immediately
+// the node of the validator must sign with the new servicer key from then on, the old servicer key is retired so the
+// claims and proofs of the sessions it served still credit the validator (see GetRetiredServicerKey)
+func (k Keeper) RotateServicerKey(ctx sdk.Ctx, addr sdk.Address, servicerPubKey crypto.PublicKey) {
+	validator := k.mustGetValidator(ctx, addr)
+	if oldAddr, newAddr := validator.GetServicerAddress(), sdk.Address(servicerPubKey.Address()); !oldAddr.Equals(newAddr) {
+		k.SetRetiredServicerKey(ctx, types.RetiredServicerKey{
+			ServicerAddress:  oldAddr,
+			ValidatorAddress: validator.Address,
+			RetiredHeight:    ctx.BlockHeight(),
+		})
+		// rotating back to a retired key makes it current again
+		k.deleteRetiredServicerKey(ctx, newAddr)
+	}
+	if validator.ServicerPublicKey != nil {
+		k.deleteServicerKeyIndex(ctx, validator.GetServicerAddress())
+	}
+	if sdk.Address(servicerPubKey.Address()).Equals(validator.Address) {
+		// rotating to the consensus key removes the servicer key
+		validator.ServicerPublicKey = nil
+	} else {
+		validator.ServicerPublicKey = servicerPubKey
+		k.setServicerKeyIndex(ctx, validator)
+	}
+	k.SetValidator(ctx, validator)
+}
+
+// get the retired servicer key with the address
+func (k Keeper) GetRetiredServicerKey(ctx sdk.Ctx, servicerAddr sdk.Address) (retired types.RetiredServicerKey, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForRetiredServicerKey(servicerAddr))
+	if bz == nil {
+		return retired, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &retired)
+	return retired, true
+}
+
+// set the retired servicer key
+func (k Keeper) SetRetiredServicerKey(ctx sdk.Ctx, retired types.RetiredServicerKey) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForRetiredServicerKey(retired.ServicerAddress), k.cdc.MustMarshalBinaryLengthPrefixed(retired))
+}
+
+// delete the retired servicer key with the address
+func (k Keeper) deleteRetiredServicerKey(ctx sdk.Ctx, servicerAddr sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForRetiredServicerKey(servicerAddr))
+}
+
+// get all of the retired servicer keys
+func (k Keeper) GetAllRetiredServicerKeys(ctx sdk.Ctx) (retired types.RetiredServicerKeys) {
+	retired = make(types.RetiredServicerKeys, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RetiredServicerKeyKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var r types.RetiredServicerKey
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &r)
+		retired = append(retired, r)
+	}
+	return retired
+}
+
+// get the addresses of the servicer keys retired by the validator, which may still hold its claims
+func (k Keeper) GetRetiredServicerAddresses(ctx sdk.Ctx, addr sdk.Address) (servicerAddrs []sdk.Address) {
+	for _, r := range k.GetAllRetiredServicerKeys(ctx) {
+		if r.ValidatorAddress.Equals(addr) {
+			servicerAddrs = append(servicerAddrs, r.ServicerAddress)
+		}
+	}
+	return
+}
+
+// get the validator that served with the retired servicer key of the address, returns nil if not found
+func (k Keeper) ValidatorByRetiredServicerAddress(ctx sdk.Ctx, servicerAddr sdk.Address) exported.ValidatorI {
+	retired, found := k.GetRetiredServicerKey(ctx, servicerAddr)
+	if !found {
+		return nil
+	}
+	validator, found := k.GetValidator(ctx, retired.ValidatorAddress)
+	if !found {
+		return nil
+	}
+	return validator
+}
+
+// delete the retired servicer keys once the claims and proofs of the sessions they served can no longer be sent
+func (k Keeper) DeleteExpiredRetiredServicerKeys(ctx sdk.Ctx) {
+	retention := (k.PocketKeeper.ClaimSessions(ctx) + 1) * k.BlocksPerSession(ctx)
+	for _, r := range k.GetAllRetiredServicerKeys(ctx) {
+		if r.RetiredHeight+retention <= ctx.BlockHeight() {
+			k.deleteRetiredServicerKey(ctx, r.ServicerAddress)
+		}
+	}
+}
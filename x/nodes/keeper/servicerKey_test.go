@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_RotateServicerKey(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	other := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	keeper.SetValidator(context, other)
+	// without a servicer key the validator serves with its consensus key
+	got, found := keeper.GetValidatorByServicerAddress(context, validator.Address)
+	assert.True(t, found)
+	assert.Equal(t, validator.Address, got.Address)
+	// register a servicer key
+	servicerPub := getRandomPubKey()
+	servicerAddr := sdk.Address(servicerPub.Address())
+	assert.Nil(t, keeper.ValidateServicerKeyRotation(context, validator.Address, servicerPub))
+	keeper.RotateServicerKey(context, validator.Address, servicerPub)
+	got, found = keeper.GetValidatorByServicerAddress(context, servicerAddr)
+	assert.True(t, found)
+	assert.Equal(t, validator.Address, got.Address)
+	assert.True(t, got.GetServicerPublicKey().Equals(servicerPub))
+	// the consensus key no longer serves
+	_, found = keeper.GetValidatorByServicerAddress(context, validator.Address)
+	assert.False(t, found)
+	assert.Nil(t, keeper.ValidatorByServicerAddress(context, validator.Address))
+	// the servicer key can't be registered by another validator, nor be the consensus key of another validator
+	assert.Equal(t, types.ErrServicerKeyInUse(keeper.Codespace()), keeper.ValidateServicerKeyRotation(context, other.Address, servicerPub))
+	assert.Equal(t, types.ErrServicerKeyInUse(keeper.Codespace()), keeper.ValidateServicerKeyRotation(context, validator.Address, other.PublicKey))
+	// a validator that doesn't exist can't rotate
+	assert.Equal(t, types.ErrNoValidatorFound(keeper.Codespace()), keeper.ValidateServicerKeyRotation(context, getRandomValidatorAddress(), getRandomPubKey()))
+	// rotating to the consensus key removes the servicer key and its index
+	assert.Nil(t, keeper.ValidateServicerKeyRotation(context, validator.Address, validator.PublicKey))
+	keeper.RotateServicerKey(context, validator.Address, validator.PublicKey)
+	_, found = keeper.GetValidatorByServicerAddress(context, servicerAddr)
+	assert.False(t, found)
+	got, found = keeper.GetValidatorByServicerAddress(context, validator.Address)
+	assert.True(t, found)
+	assert.Nil(t, got.ServicerPublicKey)
+	// the retired servicer key can't be registered by another validator until its claims expire
+	assert.Equal(t, types.ErrServicerKeyInUse(keeper.Codespace()), keeper.ValidateServicerKeyRotation(context, other.Address, servicerPub))
+	keeper.DeleteExpiredRetiredServicerKeys(context.WithBlockHeight(context.BlockHeight() + 5*keeper.BlocksPerSession(context)))
+	assert.Nil(t, keeper.ValidateServicerKeyRotation(context, other.Address, servicerPub))
+}
+
+func TestKeeper_RetiredServicerKeys(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	firstPub, secondPub := getRandomPubKey(), getRandomPubKey()
+	firstAddr, secondAddr := sdk.Address(firstPub.Address()), sdk.Address(secondPub.Address())
+	keeper.RotateServicerKey(context, validator.Address, firstPub)
+	// the consensus key is retired and still maps to the validator
+	assert.Equal(t, []sdk.Address{validator.Address}, keeper.GetRetiredServicerAddresses(context, validator.Address))
+	keeper.RotateServicerKey(context, validator.Address, secondPub)
+	assert.ElementsMatch(t, []sdk.Address{validator.Address, firstAddr}, keeper.GetRetiredServicerAddresses(context, validator.Address))
+	got := keeper.ValidatorByRetiredServicerAddress(context, firstAddr)
+	assert.NotNil(t, got)
+	assert.Equal(t, validator.Address, got.GetAddress())
+	assert.Nil(t, keeper.ValidatorByRetiredServicerAddress(context, secondAddr))
+	// rotating back to a retired key makes it current again
+	keeper.RotateServicerKey(context, validator.Address, firstPub)
+	_, found := keeper.GetRetiredServicerKey(context, firstAddr)
+	assert.False(t, found)
+	assert.ElementsMatch(t, []sdk.Address{validator.Address, secondAddr}, keeper.GetRetiredServicerAddresses(context, validator.Address))
+	// the retired keys are kept until the claims of their sessions expire
+	retention := 5 * keeper.BlocksPerSession(context)
+	keeper.DeleteExpiredRetiredServicerKeys(context.WithBlockHeight(context.BlockHeight() + retention - 1))
+	assert.Len(t, keeper.GetAllRetiredServicerKeys(context), 2)
+	keeper.DeleteExpiredRetiredServicerKeys(context.WithBlockHeight(context.BlockHeight() + retention))
+	assert.Empty(t, keeper.GetAllRetiredServicerKeys(context))
+}
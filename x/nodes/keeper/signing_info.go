@@ -84,3 +84,23 @@ func (k Keeper) IterateAndExecuteOverMissedArray(ctx sdk.Ctx,
 		}
 	}
 }
+
+// get the servicer liveness information for the validator by address
+func (k Keeper) GetServicerLivenessInfo(ctx sdk.Ctx, addr sdk.Address) (info types.ServicerLivenessInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetServicerLivenessInfoKey(addr))
+	if bz == nil {
+		found = false
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &info)
+	found = true
+	return
+}
+
+// set the servicer liveness information for the validator by address
+func (k Keeper) SetServicerLivenessInfo(ctx sdk.Ctx, addr sdk.Address, info types.ServicerLivenessInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(info)
+	store.Set(types.GetServicerLivenessInfoKey(addr), bz)
+}
@@ -38,6 +38,8 @@ func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.Int) {
 	// cannot decrease balance below zero
 	tokensToBurn := sdk.MinInt(amount, validator.StakedTokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
+	// the delegations are burned in proportion to the validator stake
+	k.slashDelegations(ctx, validator, tokensToBurn)
 	validator = k.removeValidatorTokens(ctx, validator, tokensToBurn)
 	err := k.burnStakedTokens(ctx, tokensToBurn)
 	if err != nil {
@@ -91,11 +93,16 @@ func (k Keeper) slash(ctx sdk.Ctx, consAddr sdk.Address, infractionHeight, power
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
 	// Deduct from validator's staked tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
+	// The delegations are burned in proportion to the validator stake.
+	k.slashDelegations(ctx, validator, tokensToBurn)
 	validator = k.removeValidatorTokens(ctx, validator, tokensToBurn)
 	err := k.burnStakedTokens(ctx, tokensToBurn)
 	if err != nil {
 		panic(err)
 	}
+	// the stake undelegated or partially unstaked since the infraction is slashed as well
+	k.slashUnbondingDelegations(ctx, validator.Address, infractionHeight, slashFactor)
+	k.slashPartialUnstakes(ctx, validator.Address, infractionHeight, slashFactor)
 	// if falls below minimum force burn all of the stake
 	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		err := k.ForceValidatorUnstake(ctx, validator)
@@ -330,3 +337,75 @@ func (k Keeper) deleteValidatorBurn(ctx sdk.Ctx, address sdk.Address) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.KeyForValidatorBurn(address))
 }
+
+// aggregate a failed liveness probe of the service url of a validator, and jail the servicer
+// once enough distinct validators reported it unreachable within the same session
+// the reporter is the address of the servicer key the prober of the reporting validator signs with
+// the probe is the relay the reporter signed and sent to a chain of the servicer in the current session
+func (k Keeper) HandleServicerLivenessReport(ctx sdk.Ctx, reporter, servicer sdk.Address, probe []byte) (info types.ServicerLivenessInfo, jailed bool, err sdk.Error) {
+	rep, found := k.GetValidatorByServicerAddress(ctx, reporter)
+	if found && rep.Address.Equals(servicer) || reporter.Equals(servicer) {
+		return info, false, types.ErrSelfLivenessReport(k.Codespace())
+	}
+	if !found || !rep.IsStaked() || rep.IsJailed() {
+		return info, false, types.ErrLivenessReporterNotStaked(k.Codespace())
+	}
+	reporterKey := reporter
+	reporter = rep.Address
+	validator, found := k.GetValidator(ctx, servicer)
+	if !found {
+		return info, false, types.ErrNoValidatorFound(k.Codespace())
+	}
+	if !validator.IsStaked() {
+		return info, false, types.ErrValidatorStatus(k.Codespace())
+	}
+	if validator.IsJailed() {
+		return info, false, types.ErrValidatorJailed(k.Codespace())
+	}
+	// the reporters are only counted within the current session
+	height := ctx.BlockHeight()
+	sessionHeight := height - (height-1)%k.BlocksPerSession(ctx)
+	// the probe must be a relay of the reporter to a chain of the servicer in the session
+	if err := k.PocketKeeper.ValidateLivenessProbe(ctx, probe, reporterKey, validator.GetServicerPublicKey().RawString(), validator.Chains, sessionHeight); err != nil {
+		return info, false, err
+	}
+	info, found = k.GetServicerLivenessInfo(ctx, servicer)
+	if !found {
+		info = types.ServicerLivenessInfo{Address: servicer}
+	}
+	if info.SessionBlockHeight != sessionHeight {
+		info.SessionBlockHeight = sessionHeight
+		info.Reporters = make([]sdk.Address, 0)
+	}
+	for _, r := range info.Reporters {
+		if r.Equals(reporter) {
+			return info, false, types.ErrDuplicateLivenessReport(k.Codespace())
+		}
+	}
+	info.Reporters = append(info.Reporters, reporter)
+	info.FailureReports++
+	threshold := k.ServicerLivenessJailThreshold(ctx)
+	if threshold > 0 && int64(len(info.Reporters)) >= threshold {
+		// liveness failure confirmed: jail the servicer
+		k.Logger(ctx).Info(fmt.Sprintf("Servicer %s reported unreachable by %d validators in session %d",
+			servicer, len(info.Reporters), sessionHeight))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeServicerLiveness,
+				sdk.NewAttribute(types.AttributeKeyAddress, servicer.String()),
+				sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueServicerUnreachable),
+				sdk.NewAttribute(types.AttributeKeyJailed, servicer.String()),
+			),
+		)
+		k.JailValidator(ctx, servicer)
+		if signInfo, found := k.GetValidatorSigningInfo(ctx, servicer); found {
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.ServicerLivenessJailDuration(ctx))
+			k.SetValidatorSigningInfo(ctx, servicer, signInfo)
+		}
+		// reset the reporters so the servicer won't be immediately jailed upon unjailing
+		info.Reporters = make([]sdk.Address, 0)
+		jailed = true
+	}
+	k.SetServicerLivenessInfo(ctx, servicer, info)
+	return info, jailed, nil
+}
@@ -707,3 +707,69 @@ func TestKeeper_BurnValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleServicerLivenessReport(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockHeight(2)
+	servicer := getStakedValidator()
+	keeper.SetValidator(context, servicer)
+	keeper.SetStakedValidator(context, servicer)
+	keeper.SetValidatorSigningInfo(context, servicer.Address, types.ValidatorSigningInfo{Address: servicer.Address})
+	threshold := int(keeper.ServicerLivenessJailThreshold(context))
+	reporters := make([]types.Validator, threshold)
+	for i := range reporters {
+		reporters[i] = getStakedValidator()
+		keeper.SetValidator(context, reporters[i])
+		keeper.SetStakedValidator(context, reporters[i])
+	}
+	probe := []byte("probe")
+	// a validator cannot report itself
+	_, _, err := keeper.HandleServicerLivenessReport(context, servicer.Address, servicer.Address, probe)
+	assert.Equal(t, types.ErrSelfLivenessReport(keeper.Codespace()), err)
+	// an unstaked reporter is rejected
+	_, _, err = keeper.HandleServicerLivenessReport(context, getRandomValidatorAddress(), servicer.Address, probe)
+	assert.Equal(t, types.ErrLivenessReporterNotStaked(keeper.Codespace()), err)
+	// a report without a probe is rejected
+	_, _, err = keeper.HandleServicerLivenessReport(context, reporters[0].Address, servicer.Address, nil)
+	assert.NotNil(t, err)
+	for i, reporter := range reporters[:threshold-1] {
+		info, jailed, err := keeper.HandleServicerLivenessReport(context, reporter.Address, servicer.Address, probe)
+		assert.Nil(t, err)
+		assert.False(t, jailed)
+		assert.Equal(t, int64(i+1), info.FailureReports)
+	}
+	// the same reporter cannot report twice in a session
+	_, _, err = keeper.HandleServicerLivenessReport(context, reporters[0].Address, servicer.Address, probe)
+	assert.Equal(t, types.ErrDuplicateLivenessReport(keeper.Codespace()), err)
+	// the threshold is reached and the servicer is jailed
+	info, jailed, err := keeper.HandleServicerLivenessReport(context, reporters[threshold-1].Address, servicer.Address, probe)
+	assert.Nil(t, err)
+	assert.True(t, jailed)
+	assert.Empty(t, info.Reporters)
+	assert.Equal(t, int64(threshold), info.FailureReports)
+	got, _ := keeper.GetValidator(context, servicer.Address)
+	assert.True(t, got.IsJailed())
+	signInfo, _ := keeper.GetValidatorSigningInfo(context, servicer.Address)
+	assert.Equal(t, context.BlockHeader().Time.Add(keeper.ServicerLivenessJailDuration(context)), signInfo.JailedUntil)
+}
+
+func TestHandleServicerLivenessReport_NewSession(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	context = context.WithBlockHeight(2)
+	servicer := getStakedValidator()
+	reporter := getStakedValidator()
+	for _, v := range []types.Validator{servicer, reporter} {
+		keeper.SetValidator(context, v)
+		keeper.SetStakedValidator(context, v)
+	}
+	probe := []byte("probe")
+	_, _, err := keeper.HandleServicerLivenessReport(context, reporter.Address, servicer.Address, probe)
+	assert.Nil(t, err)
+	// the reporters are reset in the next session, but the failure reports keep counting
+	context = context.WithBlockHeight(2 + keeper.BlocksPerSession(context))
+	info, jailed, err := keeper.HandleServicerLivenessReport(context, reporter.Address, servicer.Address, probe)
+	assert.Nil(t, err)
+	assert.False(t, jailed)
+	assert.Len(t, info.Reporters, 1)
+	assert.Equal(t, int64(2), info.FailureReports)
+}
@@ -69,3 +69,32 @@ func (k Keeper) IterateAndExecuteOverStakedVals(
 		}
 	}
 }
+
+// store the edited chains and service url of a validator until the end of the session
+func (k Keeper) SetWaitingEditStake(ctx sdk.Ctx, val types.Validator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForValWaitingToEditStake(val.Address), types.MustMarshalValidator(k.cdc, val))
+}
+
+// is the validator waiting to edit its chains and service url
+func (k Keeper) IsWaitingEditStake(ctx sdk.Ctx, valAddr sdk.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyForValWaitingToEditStake(valAddr))
+}
+
+// get all of the validators waiting to edit their chains and service url
+func (k Keeper) GetWaitingEditStakes(ctx sdk.Ctx) (validators []types.Validator) {
+	validators = make([]types.Validator, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.WaitingToEditStakeKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		validators = append(validators, types.MustUnmarshalValidator(k.cdc, iterator.Value()))
+	}
+	return validators
+}
+
+func (k Keeper) DeleteWaitingEditStake(ctx sdk.Ctx, valAddr sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyForValWaitingToEditStake(valAddr))
+}
@@ -21,6 +21,7 @@ func (k Keeper) UpdateTendermintValidators(ctx sdk.Ctx) (updates []abci.Validato
 	// allow all waiting to begin unstaking to begin unstaking
 	if ctx.BlockHeight()%k.BlocksPerSession(ctx) == 0 { // one block before new session (mod 1 would be session block)
 		k.ReleaseWaitingValidators(ctx)
+		k.ReleaseWaitingEditStakes(ctx)
 	}
 	maxValidators := k.GetParams(ctx).MaxValidators
 	totalPower := sdk.ZeroInt()
@@ -81,7 +82,7 @@ func (k Keeper) UpdateTendermintValidators(ctx sdk.Ctx) (updates []abci.Validato
 }
 
 // validate check called before staking
-func (k Keeper) ValidateValidatorStaking(ctx sdk.Ctx, validator types.Validator, amount sdk.Int) sdk.Error {
+func (k Keeper) ValidateValidatorStaking(ctx sdk.Ctx, validator types.Validator, amount sdk.Int, signers []sdk.Address) sdk.Error {
 	coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
 	// check to see if teh public key has already been register for that validator
 	val, found := k.GetValidator(ctx, validator.Address)
@@ -92,7 +93,15 @@ func (k Keeper) ValidateValidatorStaking(ctx sdk.Ctx, validator types.Validator,
 		if validator.IsJailed() {
 			return types.ErrValidatorJailed(k.codespace)
 		}
+		// once set, the output address can only be changed with the signature of the output address
+		if !val.OutputAddress.Empty() && !val.OutputAddress.Equals(validator.OutputAddress) && !containsAddress(signers, val.OutputAddress) {
+			return types.ErrUnauthorizedOutputAddress(k.codespace)
+		}
 	} else {
+		// the key of a new validator can't be the servicer key of another validator
+		if _, found := k.getServicerKeyIndex(ctx, validator.Address); found {
+			return types.ErrServicerKeyInUse(k.codespace)
+		}
 		// check the consensus params
 		if ctx.ConsensusParams() != nil {
 			tmPubKey, err := crypto.CheckConsensusPubKey(validator.PublicKey.PubKey())
@@ -111,6 +120,10 @@ func (k Keeper) ValidateValidatorStaking(ctx sdk.Ctx, validator types.Validator,
 	if amount.LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		return types.ErrMinimumStake(k.codespace)
 	}
+	// ensure the chains are supported and not deprecated in the chain registry
+	if err := k.PocketKeeper.ValidateStakingChains(ctx, validator.Chains); err != nil {
+		return err
+	}
 	if !k.AccountKeeper.HasCoins(ctx, validator.Address, coin) {
 		return types.ErrNotEnoughCoins(k.codespace)
 	}
@@ -146,6 +159,108 @@ func (k Keeper) StakeValidator(ctx sdk.Ctx, validator types.Validator, amount sd
 	return nil
 }
 
+// validates an edit of the stake of a validator
+// the stake can only be increased and the validator must be staked, not jailed and not waiting to unstake
+func (k Keeper) ValidateValidatorEditStake(ctx sdk.Ctx, validator types.Validator, amount sdk.Int) sdk.Error {
+	val, found := k.GetValidator(ctx, validator.Address)
+	if !found {
+		return types.ErrNoValidatorFound(k.codespace)
+	}
+	if !val.IsStaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	if val.IsJailed() {
+		return types.ErrValidatorJailed(k.codespace)
+	}
+	// can't dodge the unstaking wait by editing the stake
+	if k.IsWaitingValidator(ctx, val.Address) {
+		return types.ErrValidatorWaitingToUnstake(k.codespace)
+	}
+	if amount.LT(val.StakedTokens) {
+		return types.ErrStakeDecrease(k.codespace)
+	}
+	// ensure the chains are supported and not deprecated in the chain registry
+	if err := k.PocketKeeper.ValidateStakingChains(ctx, validator.Chains); err != nil {
+		return err
+	}
+	if diff := amount.Sub(val.StakedTokens); diff.IsPositive() {
+		coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), diff))
+		if !k.AccountKeeper.HasCoins(ctx, validator.Address, coin) {
+			return types.ErrNotEnoughCoins(k.codespace)
+		}
+	}
+	return nil
+}
+
+// store ops when a validator edits its stake
+// the added stake is applied immediately while the chains and service url wait until the end of the session,
+// so a validator can't leave a session in progress
+func (k Keeper) EditStakeValidator(ctx sdk.Ctx, validator types.Validator, amount sdk.Int) sdk.Error {
+	val, found := k.GetValidator(ctx, validator.Address)
+	if !found {
+		return types.ErrNoValidatorFound(k.codespace)
+	}
+	if diff := amount.Sub(val.StakedTokens); diff.IsPositive() {
+		// send the added coins from address to staked module account
+		if err := k.coinsFromUnstakedToStaked(ctx, val, diff); err != nil {
+			return err
+		}
+		val = k.setValidatorTokens(ctx, val, amount)
+	}
+	if !sameChains(val.Chains, validator.Chains) || val.ServiceURL != validator.ServiceURL {
+		k.SetWaitingEditStake(ctx, validator)
+		ctx.Logger().Info("Validator " + validator.Address.String() + " is waiting to edit its chains and service url until session is over")
+	}
+	ctx.Logger().Info("Successfully edited the stake of validator: " + val.Address.String())
+	return nil
+}
+
+// applies the edited chains and service url of the validators at the end of the session
+func (k Keeper) ReleaseWaitingEditStakes(ctx sdk.Ctx) {
+	for _, edit := range k.GetWaitingEditStakes(ctx) {
+		k.DeleteWaitingEditStake(ctx, edit.Address)
+		val, found := k.GetValidator(ctx, edit.Address)
+		// the edit is dropped if the validator left the staked set in the meantime
+		if !found || !val.IsStaked() {
+			ctx.Logger().Error("Unable to edit the stake of validator " + edit.Address.String() + ": the validator is no longer staked")
+			continue
+		}
+		val.Chains = edit.Chains
+		val.ServiceURL = edit.ServiceURL
+		k.SetValidator(ctx, val)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEditStake,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, val.Address.String()),
+			),
+		)
+	}
+}
+
+// returns true if both lists contain the same chains in the same order
+func sameChains(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// returns true if the address is one of the addresses
+func containsAddress(addrs []sdk.Address, addr sdk.Address) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 func (k Keeper) ValidateValidatorBeginUnstaking(ctx sdk.Ctx, validator types.Validator) sdk.Error {
 	// must be staked to begin unstaking
 	if !validator.IsStaked() {
@@ -212,6 +327,46 @@ func (k Keeper) BeginUnstakingValidator(ctx sdk.Ctx, validator types.Validator)
 	ctx.Logger().Info("Began unstaking validator " + validator.Address.String())
 }
 
+// validates the partial unstake of a validator
+// only the stake not delegated to the validator can be unstaked and the rest must stay above the minimum
+func (k Keeper) ValidateValidatorPartialUnstake(ctx sdk.Ctx, validator types.Validator, amount sdk.Int) sdk.Error {
+	if !validator.IsStaked() {
+		return types.ErrValidatorStatus(k.codespace)
+	}
+	if validator.IsJailed() {
+		return types.ErrValidatorJailed(k.codespace)
+	}
+	// can't dodge the unstaking wait by partially unstaking
+	if k.IsWaitingValidator(ctx, validator.Address) {
+		return types.ErrValidatorWaitingToUnstake(k.codespace)
+	}
+	selfStake := validator.StakedTokens.Sub(k.getValidatorDelegatedTokens(ctx, validator.Address))
+	if amount.GT(selfStake) {
+		return types.ErrInsufficientSelfStake(k.codespace)
+	}
+	if validator.StakedTokens.Sub(amount).LT(sdk.NewInt(k.MinimumStake(ctx))) {
+		return types.ErrMinimumStake(k.codespace)
+	}
+	return nil
+}
+
+// store ops when a validator partially unstakes -> starts the unstaking timer for the amount
+// the amount waits in the partial unstakes queue so it is still slashable for infractions before the unstake
+func (k Keeper) PartialUnstakeValidator(ctx sdk.Ctx, validator types.Validator, amount sdk.Int) (completionTime time.Time) {
+	// remove the coins from the staked field of the validator
+	validator = k.setValidatorTokens(ctx, validator, validator.StakedTokens.Sub(amount))
+	// the tokens remain in the staked pool until the unstaking time is up
+	completionTime = ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx))
+	k.SetPartialUnstake(ctx, types.PartialUnstake{
+		Address:        validator.Address,
+		Amount:         amount,
+		CreationHeight: ctx.BlockHeight(),
+		CompletionTime: completionTime,
+	})
+	ctx.Logger().Info("Began partially unstaking " + amount.String() + " of validator " + validator.Address.String())
+	return completionTime
+}
+
 func (k Keeper) ValidateValidatorFinishUnstaking(ctx sdk.Ctx, validator types.Validator) sdk.Error {
 	if !validator.IsUnstaking() {
 		return types.ErrValidatorStatus(k.codespace)
@@ -230,9 +385,12 @@ func (k Keeper) ValidateValidatorFinishUnstaking(ctx sdk.Ctx, validator types.Va
 func (k Keeper) FinishUnstakingValidator(ctx sdk.Ctx, validator types.Validator) {
 	// delete the validator from the unstaking queue
 	k.deleteUnstakingValidator(ctx, validator)
+	// send the delegated tokens back to the delegators
+	released := k.releaseDelegations(ctx, validator)
+	validator = validator.RemoveStakedTokens(released)
 	// amount unstaked = stakedTokens
 	amount := sdk.NewInt(validator.StakedTokens.Int64())
-	// send the tokens from staking module account to validator account
+	// send the tokens from staking module account to the validator output account
 	k.coinsFromStakedToUnstaked(ctx, validator)
 	// removed the staked tokens field from validator structure
 	validator = validator.RemoveStakedTokens(amount)
@@ -247,6 +405,7 @@ func (k Keeper) FinishUnstakingValidator(ctx sdk.Ctx, validator types.Validator)
 			types.EventTypeUnstake,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, validator.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyOutputAddress, validator.GetOutputAddress().String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -267,6 +426,9 @@ func (k Keeper) ForceValidatorUnstake(ctx sdk.Ctx, validator types.Validator) sd
 	default:
 		panic(sdk.ErrInternal("trying to force unstake an already unstaked validator"))
 	}
+	// send the delegated tokens back to the delegators, they were already slashed with the validator
+	released := k.releaseDelegations(ctx, validator)
+	validator = validator.RemoveStakedTokens(released)
 	// amount unstaked = stakedTokens
 	err := k.burnStakedTokens(ctx, validator.StakedTokens)
 	if err != nil {
@@ -278,13 +440,14 @@ func (k Keeper) ForceValidatorUnstake(ctx sdk.Ctx, validator types.Validator) sd
 	validator = validator.UpdateStatus(sdk.Unstaked)
 	// set the validator in store
 	k.SetValidator(ctx, validator)
-	ctx.Logger().Info("Force Unstaked validator " + validator.Address.String())
+	ctx.Logger().Info("Force Unstaked validator " + validator.Address.String() + " with output address " + validator.GetOutputAddress().String())
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeUnstake,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, validator.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyOutputAddress, validator.GetOutputAddress().String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -7,6 +7,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestKeeper_FinishUnstakingValidator(t *testing.T) {
@@ -291,7 +292,7 @@ func TestKeeper_ValidateValidatorStaking(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			k := tt.fields.keeper
-			if got := k.ValidateValidatorStaking(tt.args.ctx, tt.args.validator, tt.args.amount); !reflect.DeepEqual(got, tt.want) {
+			if got := k.ValidateValidatorStaking(tt.args.ctx, tt.args.validator, tt.args.amount, nil); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("ValidateValidatorStaking() = %v, want %v", got, tt.want)
 			}
 		})
@@ -330,3 +331,157 @@ func TestKeeper_WaitToBeginUnstakingValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestKeeper_ValidateValidatorStakingOutputAddress(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getUnstakedValidator()
+	validator.OutputAddress = getRandomValidatorAddress()
+	keeper.SetValidator(context, validator)
+	// the operator can't change the output address
+	restake := validator
+	restake.OutputAddress = getRandomValidatorAddress()
+	operator := []sdk.Address{validator.Address}
+	err := keeper.ValidateValidatorStaking(context, restake, sdk.NewInt(1000000), operator)
+	assert.Equal(t, types.ErrUnauthorizedOutputAddress(types.DefaultCodespace).Code(), err.Code())
+	restake.OutputAddress = nil
+	err = keeper.ValidateValidatorStaking(context, restake, sdk.NewInt(1000000), operator)
+	assert.Equal(t, types.ErrUnauthorizedOutputAddress(types.DefaultCodespace).Code(), err.Code())
+	// re-staking with the same output address passes the output check
+	err = keeper.ValidateValidatorStaking(context, validator, sdk.NewInt(1000000), operator)
+	assert.Equal(t, types.ErrNotEnoughCoins(types.DefaultCodespace).Code(), err.Code())
+	// the current output address authorizes the change
+	restake.OutputAddress = getRandomValidatorAddress()
+	err = keeper.ValidateValidatorStaking(context, restake, sdk.NewInt(1000000), []sdk.Address{validator.Address, restake.OutputAddress, validator.OutputAddress})
+	assert.Equal(t, types.ErrNotEnoughCoins(types.DefaultCodespace).Code(), err.Code())
+}
+
+func TestKeeper_FinishUnstakingValidatorToOutputAddress(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	validator.OutputAddress = getRandomValidatorAddress()
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	keeper.SetValidator(context, validator)
+	keeper.SetStakedValidator(context, validator)
+	keeper.BeginUnstakingValidator(context, validator)
+	validator, _ = keeper.GetValidator(context, validator.Address)
+	keeper.FinishUnstakingValidator(context, validator)
+	// the unstaked tokens are sent to the output address
+	assert.True(t, validator.StakedTokens.Equal(keeper.GetBalance(context, validator.OutputAddress)))
+	assert.True(t, keeper.GetBalance(context, validator.Address).IsZero())
+}
+
+func TestKeeper_ValidateValidatorEditStake(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	keeper.SetStakedValidator(context, validator)
+	// the stake can't be lowered
+	err := keeper.ValidateValidatorEditStake(context, validator, validator.StakedTokens.Sub(sdk.OneInt()))
+	assert.Equal(t, types.ErrStakeDecrease(types.DefaultCodespace).Code(), err.Code())
+	// the added stake must be covered by the account
+	err = keeper.ValidateValidatorEditStake(context, validator, validator.StakedTokens.Add(sdk.OneInt()))
+	assert.Equal(t, types.ErrNotEnoughCoins(types.DefaultCodespace).Code(), err.Code())
+	assert.Nil(t, keeper.ValidateValidatorEditStake(context, validator, validator.StakedTokens))
+	// a validator waiting to unstake can't edit its stake
+	keeper.SetWaitingValidator(context, validator)
+	err = keeper.ValidateValidatorEditStake(context, validator, validator.StakedTokens)
+	assert.Equal(t, types.ErrValidatorWaitingToUnstake(types.DefaultCodespace).Code(), err.Code())
+	// an unknown validator can't edit its stake
+	err = keeper.ValidateValidatorEditStake(context, getStakedValidator(), validator.StakedTokens)
+	assert.Equal(t, types.ErrNoValidatorFound(types.DefaultCodespace).Code(), err.Code())
+}
+
+func TestKeeper_EditStakeValidator(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	sendFromModuleToAccount(t, context, &keeper, types.StakedPoolName, validator.Address, sdk.NewInt(100))
+	keeper.SetValidator(context, validator)
+	keeper.SetStakedValidator(context, validator)
+	edit := validator
+	edit.Chains = []string{"01"}
+	edit.ServiceURL = "https://www.pokt.network:443"
+	err := keeper.EditStakeValidator(context, edit, validator.StakedTokens.Add(sdk.NewInt(100)))
+	assert.Nil(t, err)
+	// the added stake is applied immediately
+	got, _ := keeper.GetValidator(context, validator.Address)
+	assert.True(t, got.StakedTokens.Equal(validator.StakedTokens.Add(sdk.NewInt(100))))
+	// the chains and service url wait until the end of the session
+	assert.Equal(t, validator.Chains, got.Chains)
+	assert.Equal(t, validator.ServiceURL, got.ServiceURL)
+	assert.True(t, keeper.IsWaitingEditStake(context, validator.Address))
+	keeper.ReleaseWaitingEditStakes(context)
+	got, _ = keeper.GetValidator(context, validator.Address)
+	assert.Equal(t, edit.Chains, got.Chains)
+	assert.Equal(t, edit.ServiceURL, got.ServiceURL)
+	assert.False(t, keeper.IsWaitingEditStake(context, validator.Address))
+}
+
+func TestKeeper_ValidateValidatorPartialUnstake(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	keeper.SetStakedValidator(context, validator)
+	minimum := sdk.NewInt(keeper.MinimumStake(context))
+	excess := validator.StakedTokens.Sub(minimum)
+	assert.Nil(t, keeper.ValidateValidatorPartialUnstake(context, validator, excess))
+	// the stake left must stay above the minimum
+	err := keeper.ValidateValidatorPartialUnstake(context, validator, excess.Add(sdk.OneInt()))
+	assert.Equal(t, types.ErrMinimumStake(types.DefaultCodespace).Code(), err.Code())
+	// delegated tokens can't be unstaked by the validator
+	keeper.SetDelegation(context, types.NewDelegation(getRandomValidatorAddress(), validator.Address, excess))
+	err = keeper.ValidateValidatorPartialUnstake(context, validator, excess)
+	assert.Equal(t, types.ErrInsufficientSelfStake(types.DefaultCodespace).Code(), err.Code())
+	// a validator waiting to unstake can't partially unstake
+	keeper.SetWaitingValidator(context, validator)
+	err = keeper.ValidateValidatorPartialUnstake(context, validator, sdk.OneInt())
+	assert.Equal(t, types.ErrValidatorWaitingToUnstake(types.DefaultCodespace).Code(), err.Code())
+	err = keeper.ValidateValidatorPartialUnstake(context, getUnstakedValidator(), sdk.OneInt())
+	assert.Equal(t, types.ErrValidatorStatus(types.DefaultCodespace).Code(), err.Code())
+}
+
+func TestKeeper_PartialUnstakeValidator(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	validator.OutputAddress = getRandomValidatorAddress()
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	keeper.SetValidator(context, validator)
+	keeper.SetStakedValidator(context, validator)
+	amount := sdk.NewInt(100)
+	completionTime := keeper.PartialUnstakeValidator(context, validator, amount)
+	// the amount is removed from the stake right away
+	got, _ := keeper.GetValidator(context, validator.Address)
+	assert.True(t, validator.StakedTokens.Sub(amount).Equal(got.StakedTokens))
+	assert.True(t, got.IsStaked())
+	// the amount waits in the partial unstakes queue, not with the unbonding delegations
+	assert.Len(t, keeper.GetAllPartialUnstakes(context), 1)
+	assert.Empty(t, keeper.GetAllUnbondingDelegations(context))
+	// the tokens are not released before the completion time
+	keeper.releaseAllMaturePartialUnstakes(context)
+	assert.True(t, keeper.GetBalance(context, validator.OutputAddress).IsZero())
+	// the tokens are released to the output address after the completion time
+	context = context.WithBlockTime(completionTime.Add(time.Second))
+	keeper.releaseAllMaturePartialUnstakes(context)
+	assert.True(t, amount.Equal(keeper.GetBalance(context, validator.OutputAddress)))
+	assert.Empty(t, keeper.GetAllPartialUnstakes(context))
+	assert.Empty(t, keeper.getValidatorPartialUnstakeTimes(context, validator.Address))
+}
+
+func TestKeeper_SlashPartialUnstakes(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	keeper.SetValidator(context, validator)
+	keeper.SetStakedValidator(context, validator)
+	amount := sdk.NewInt(1000000)
+	context = context.WithBlockHeight(10)
+	keeper.PartialUnstakeValidator(context, validator, amount)
+	stakedPool := keeper.GetStakedTokens(context)
+	// an infraction after the partial unstake doesn't slash it
+	keeper.slashPartialUnstakes(context, validator.Address, 11, sdk.NewDecWithPrec(1, 1))
+	assert.True(t, amount.Equal(keeper.GetAllPartialUnstakes(context)[0].Amount))
+	// an infraction before the partial unstake slashes it by the fraction
+	keeper.slashPartialUnstakes(context, validator.Address, 5, sdk.NewDecWithPrec(1, 1))
+	assert.True(t, sdk.NewInt(900000).Equal(keeper.GetAllPartialUnstakes(context)[0].Amount))
+	assert.True(t, stakedPool.Sub(sdk.NewInt(100000)).Equal(keeper.GetStakedTokens(context)))
+}
@@ -153,3 +153,130 @@ func (k Keeper) unstakeAllMatureValidators(ctx sdk.Ctx) {
 		store.Delete(unstakingValidatorsIterator.Key())
 	}
 }
+
+// Insert a partial unstake to the appropriate position in the partial unstakes queue
+func (k Keeper) SetPartialUnstake(ctx sdk.Ctx, partialUnstake types.PartialUnstake) {
+	entries := k.getPartialUnstakes(ctx, partialUnstake.CompletionTime)
+	entries = append(entries, partialUnstake)
+	k.setPartialUnstakes(ctx, partialUnstake.CompletionTime, entries)
+	// index the completion time by validator
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyForValidatorPartialUnstake(partialUnstake.Address, partialUnstake.CompletionTime), sdk.FormatTimeBytes(partialUnstake.CompletionTime))
+}
+
+// get all of the partial unstakes in the queue
+func (k Keeper) GetAllPartialUnstakes(ctx sdk.Ctx) (entries types.PartialUnstakes) {
+	entries = make(types.PartialUnstakes, 0)
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PartialUnstakesKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pus types.PartialUnstakes
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &pus)
+		entries = append(entries, pus...)
+	}
+	return entries
+}
+
+// get the completion times of the partial unstakes of a validator
+func (k Keeper) getValidatorPartialUnstakeTimes(ctx sdk.Ctx, valAddr sdk.Address) (completionTimes []time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyForValidatorPartialUnstakes(valAddr))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		completionTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+		completionTimes = append(completionTimes, completionTime)
+	}
+	return
+}
+
+// gets all of the partial unstakes that complete at exactly this time
+func (k Keeper) getPartialUnstakes(ctx sdk.Ctx, completionTime time.Time) (entries types.PartialUnstakes) {
+	entries = make(types.PartialUnstakes, 0)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyForPartialUnstakes(completionTime))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &entries)
+	return
+}
+
+// Sets partial unstakes in the queue at a certain completion time
+func (k Keeper) setPartialUnstakes(ctx sdk.Ctx, completionTime time.Time, entries types.PartialUnstakes) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(entries)
+	store.Set(types.KeyForPartialUnstakes(completionTime), bz)
+}
+
+// iterator for all partial unstakes up to a certain time
+func (k Keeper) partialUnstakesIterator(ctx sdk.Ctx, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.PartialUnstakesKey, sdk.InclusiveEndBytes(types.KeyForPartialUnstakes(endTime)))
+}
+
+// burn the partial unstakes of a validator created at or after the infraction height by the slash factor
+func (k Keeper) slashPartialUnstakes(ctx sdk.Ctx, valAddr sdk.Address, infractionHeight int64, slashFactor sdk.Dec) {
+	totalBurn := sdk.ZeroInt()
+	for _, completionTime := range k.getValidatorPartialUnstakeTimes(ctx, valAddr) {
+		entries := k.getPartialUnstakes(ctx, completionTime)
+		burned := sdk.ZeroInt()
+		for i, entry := range entries {
+			// only stake that contributed to the infraction is slashed
+			if entry.Address.Equals(valAddr) && entry.CreationHeight >= infractionHeight {
+				burn := sdk.MinInt(entry.Amount.ToDec().Mul(slashFactor).TruncateInt(), entry.Amount)
+				entries[i].Amount = entry.Amount.Sub(burn)
+				burned = burned.Add(burn)
+			}
+		}
+		if burned.IsPositive() {
+			k.setPartialUnstakes(ctx, completionTime, entries)
+			totalBurn = totalBurn.Add(burned)
+		}
+	}
+	if totalBurn.IsZero() {
+		return
+	}
+	if err := k.burnStakedTokens(ctx, totalBurn); err != nil {
+		panic(err)
+	}
+}
+
+// Releases all the partial unstakes that have finished their unstaking period to the output address of the validator
+func (k Keeper) releaseAllMaturePartialUnstakes(ctx sdk.Ctx) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := k.partialUnstakesIterator(ctx, ctx.BlockHeader().Time)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entries types.PartialUnstakes
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &entries)
+		for _, pu := range entries {
+			output := pu.Address
+			if validator, found := k.GetValidator(ctx, pu.Address); found {
+				output = validator.GetOutputAddress()
+			}
+			if pu.Amount.IsPositive() {
+				coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), pu.Amount))
+				err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, output, coins)
+				if err != nil {
+					panic(err)
+				}
+			}
+			store.Delete(types.KeyForValidatorPartialUnstake(pu.Address, pu.CompletionTime))
+			ctx.Logger().Info("Finished partially unstaking " + pu.Amount.String() + " of validator " + pu.Address.String())
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCompletePartialUnstake,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyValidator, pu.Address.String()),
+					sdk.NewAttribute(types.AttributeKeyOutputAddress, output.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, pu.Amount.String()),
+				),
+			)
+		}
+		store.Delete(iterator.Key())
+	}
+}
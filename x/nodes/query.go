@@ -105,6 +105,50 @@ func QueryValidators(cdc *codec.Codec, tmNode rpcclient.Client, height int64, op
 	return validatorsPage, nil
 }
 
+func QueryValidatorDelegations(cdc *codec.Codec, tmNode rpcclient.Client, valAddr sdk.Address, height int64) (types.Delegations, error) {
+	return queryDelegations(cdc, tmNode, types.QueryValidatorDelegations, types.NewQueryValidatorParams(valAddr), height)
+}
+
+func QueryDelegatorDelegations(cdc *codec.Codec, tmNode rpcclient.Client, delAddr sdk.Address, height int64) (types.Delegations, error) {
+	return queryDelegations(cdc, tmNode, types.QueryDelegatorDelegations, types.NewQueryDelegatorParams(delAddr), height)
+}
+
+func queryDelegations(cdc *codec.Codec, tmNode rpcclient.Client, route string, params interface{}, height int64) (types.Delegations, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf(customQuery, types.StoreKey, route), bz)
+	if err != nil {
+		return nil, err
+	}
+	var delegations types.Delegations
+	err = cdc.UnmarshalJSON(res, &delegations)
+	if err != nil {
+		return nil, err
+	}
+	return delegations, nil
+}
+
+func QueryUnbondingDelegations(cdc *codec.Codec, tmNode rpcclient.Client, delAddr sdk.Address, height int64) (types.UnbondingDelegations, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+	if err != nil {
+		return nil, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf(customQuery, types.StoreKey, types.QueryUnbondingDelegations), bz)
+	if err != nil {
+		return nil, err
+	}
+	var entries types.UnbondingDelegations
+	err = cdc.UnmarshalJSON(res, &entries)
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
 func QuerySigningInfo(cdc *codec.Codec, tmNode rpcclient.Client, height int64, consAddr sdk.Address) (types.ValidatorSigningInfo, error) {
 	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
 	key := types.GetValidatorSigningInfoKey(consAddr)
@@ -234,3 +278,18 @@ func QueryNodeStatus(tmNode rpcclient.Client) (*ctypes.ResultStatus, error) {
 	}
 	return res, nil
 }
+
+func QueryParamChange(cdc *codec.Codec, tmNode rpcclient.Client, height int64, key string, value []byte) (types.ParamChangeReport, error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	bz, err := cdc.MarshalJSON(types.QueryParamChangeParams{Key: key, Value: string(value)})
+	if err != nil {
+		return types.ParamChangeReport{}, err
+	}
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf(customQuery, types.StoreKey, types.QueryParamChange), bz)
+	if err != nil {
+		return types.ParamChangeReport{}, err
+	}
+	var report types.ParamChangeReport
+	err = cdc.UnmarshalJSON(res, &report)
+	return report, err
+}
@@ -9,15 +9,55 @@ import (
 	"github.com/pokt-network/posmint/crypto/keys/mintkey"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/pokt-network/posmint/x/auth"
+	authTypes "github.com/pokt-network/posmint/x/auth/types"
 	"github.com/pokt-network/posmint/x/auth/util"
+	"github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/rpc/client"
 )
 
-func StakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chains []string, serviceURL string, amount sdk.Int, kp keys.KeyPair, passphrase string) (*sdk.TxResponse, error) {
+func StakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chains []string, serviceURL string, amount sdk.Int, commissionRate int64, kp keys.KeyPair, passphrase string) (*sdk.TxResponse, error) {
+	return StakeWithOutputTx(cdc, tmNode, keybase, chains, serviceURL, amount, commissionRate, kp, passphrase, nil, "", nil, "")
+}
+
+// stakes a node with a separate output address receiving the rewards and unstaked tokens
+// the output key must be in the keybase as it co-signs the transaction, as does the current output key
+// of a restaking node changing its output address
+func StakeWithOutputTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chains []string, serviceURL string, amount sdk.Int, commissionRate int64, kp keys.KeyPair, passphrase string, output sdk.Address, outputPassphrase string, currentOutput sdk.Address, currentOutputPassphrase string) (*sdk.TxResponse, error) {
 	fromAddr := kp.GetAddress()
 	msg := types.MsgStake{
+		PublicKey:      kp.PublicKey,
+		Value:          amount,
+		ServiceURL:     serviceURL,     // url where pocket service api is hosted
+		Chains:         chains,         // non native blockchains
+		CommissionRate: commissionRate, // percentage of the delegator rewards kept by the node
+		Output:         output,         // address receiving the rewards and unstaked tokens
+		CurrentOutput:  currentOutput,  // the output address being changed
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, fromAddr, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	// the co-signers in the order of the msg signers
+	var coSigners []coSigner
+	for _, signer := range msg.GetSigners()[1:] {
+		if signer.Equals(output) {
+			coSigners = append(coSigners, coSigner{address: output, passphrase: outputPassphrase})
+		} else {
+			coSigners = append(coSigners, coSigner{address: currentOutput, passphrase: currentOutputPassphrase})
+		}
+	}
+	if len(coSigners) == 0 {
+		return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+	}
+	return completeAndBroadcastCoSignedTx(txBuilder, cliCtx, []sdk.Msg{msg}, coSigners...)
+}
+
+func EditStakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chains []string, serviceURL string, amount sdk.Int, kp keys.KeyPair, passphrase string) (*sdk.TxResponse, error) {
+	fromAddr := kp.GetAddress()
+	msg := types.MsgEditStake{
 		PublicKey:  kp.PublicKey,
-		Value:      amount,
+		Value:      amount,     // the new total stake of the node
 		ServiceURL: serviceURL, // url where pocket service api is hosted
 		Chains:     chains,     // non native blockchains
 	}
@@ -29,6 +69,23 @@ func StakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, chain
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
+// rotates the servicer key of a node, the servicer key must be in the keybase as it co-signs the transaction
+func RotateServicerKeyTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, passphrase string, servicer keys.KeyPair, servicerPassphrase string) (*sdk.TxResponse, error) {
+	msg := types.MsgRotateServicerKey{
+		Address:           address,
+		ServicerPublicKey: servicer.PublicKey,
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, address, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	if servicer.GetAddress().Equals(address) {
+		return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+	}
+	return completeAndBroadcastCoSignedTx(txBuilder, cliCtx, []sdk.Msg{msg}, coSigner{address: servicer.GetAddress(), passphrase: servicerPassphrase})
+}
+
 func UnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, passphrase string) (*sdk.TxResponse, error) {
 	msg := types.MsgBeginUnstake{Address: address}
 	txBuilder, cliCtx := newTx(cdc, msg, address, tmNode, keybase, passphrase)
@@ -39,6 +96,16 @@ func UnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, add
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
+func PartialUnstakeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	msg := types.MsgPartialUnstake{Address: address, Amount: amount}
+	txBuilder, cliCtx := newTx(cdc, msg, address, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
 func UnjailTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, address sdk.Address, passphrase string) (*sdk.TxResponse, error) {
 	msg := types.MsgUnjail{ValidatorAddr: address}
 	txBuilder, cliCtx := newTx(cdc, msg, address, tmNode, keybase, passphrase)
@@ -63,6 +130,72 @@ func Send(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, fromAddr
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
+func DelegateTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, delegatorAddr, validatorAddr sdk.Address, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	msg := types.MsgDelegate{
+		DelegatorAddr: delegatorAddr,
+		ValidatorAddr: validatorAddr,
+		Amount:        amount,
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, delegatorAddr, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+func UndelegateTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, delegatorAddr, validatorAddr sdk.Address, passphrase string, amount sdk.Int) (*sdk.TxResponse, error) {
+	msg := types.MsgUndelegate{
+		DelegatorAddr: delegatorAddr,
+		ValidatorAddr: validatorAddr,
+		Amount:        amount,
+	}
+	txBuilder, cliCtx := newTx(cdc, msg, delegatorAddr, tmNode, keybase, passphrase)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
+// a key of the keybase co-signing a transaction
+type coSigner struct {
+	address    sdk.Address
+	passphrase string
+}
+
+// signs the transaction with the sender key and the co-signer keys from the keybase, then broadcasts it
+// the signatures follow the order of the signers: the sender pays the fees and signs first
+func completeAndBroadcastCoSignedTx(txBuilder auth.TxBuilder, cliCtx util.CLIContext, msgs []sdk.Msg, coSigners ...coSigner) (*sdk.TxResponse, error) {
+	txBuilder, err := util.PrepareTxBuilder(txBuilder, cliCtx)
+	if err != nil {
+		return nil, err
+	}
+	entropy := common.RandInt64()
+	bytesToSign := auth.StdSignBytes(txBuilder.ChainID(), entropy, txBuilder.Fees(), msgs, txBuilder.Memo())
+	sig, err := cliCtx.PrivateKey.Sign(bytesToSign)
+	if err != nil {
+		return nil, err
+	}
+	sigs := []auth.StdSignature{{PublicKey: cliCtx.PrivateKey.PublicKey(), Signature: sig}}
+	for _, cs := range coSigners {
+		coSig, coSignerPubKey, err := txBuilder.Keybase().Sign(cs.address, cs.passphrase, bytesToSign)
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, auth.StdSignature{PublicKey: coSignerPubKey, Signature: coSig})
+	}
+	txBytes, err := txBuilder.TxEncoder()(authTypes.NewStdTx(msgs, txBuilder.Fees(), sigs, txBuilder.Memo(), entropy))
+	if err != nil {
+		return nil, err
+	}
+	tx, err := cliCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
 func RawTx(cdc *codec.Codec, tmNode client.Client, fromAddr sdk.Address, txBytes []byte) (sdk.TxResponse, error) {
 	cliCtx := util.CLIContext{
 		Codec:       cdc,
@@ -98,7 +231,15 @@ func newTx(cdc *codec.Codec, msg sdk.Msg, fromAddr sdk.Address, tmNode client.Cl
 	if err != nil {
 		panic(err)
 	}
+	// the fee of the message is a governance param
+	params, err := QueryPOSParams(cdc, tmNode, 0)
+	if err != nil {
+		panic(err)
+	}
 	fee := msg.GetFee()
+	if f, ok := params.MessageFees.Fee(msg.Type()); ok {
+		fee = sdk.NewInt(f)
+	}
 	if account.GetCoins().AmountOf(sdk.DefaultStakeDenom).LTE(fee) { // todo get stake denom
 		panic(fmt.Sprintf("insufficient funds: the fee needed is %v", fee))
 	}
@@ -10,6 +10,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBeginUnstake{}, "pos/MsgBeginUnstake", nil)
 	cdc.RegisterConcrete(MsgUnjail{}, "pos/MsgUnjail", nil)
 	cdc.RegisterConcrete(MsgSend{}, "pos/Send", nil)
+	cdc.RegisterConcrete(MsgDelegate{}, "pos/MsgDelegate", nil)
+	cdc.RegisterConcrete(MsgUndelegate{}, "pos/MsgUndelegate", nil)
+	cdc.RegisterConcrete(MsgEditStake{}, "pos/MsgEditStake", nil)
+	cdc.RegisterConcrete(MsgPartialUnstake{}, "pos/MsgPartialUnstake", nil)
+	cdc.RegisterConcrete(MsgReportServicerLiveness{}, "pos/MsgReportServicerLiveness", nil)
+	cdc.RegisterConcrete(MsgRotateServicerKey{}, "pos/MsgRotateServicerKey", nil)
 }
 
 var ModuleCdc *codec.Codec // generic sealed codec to be used throughout this module
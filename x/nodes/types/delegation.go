@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// Delegation - the tokens of a delegator staked with a validator
+type Delegation struct {
+	DelegatorAddress sdk.Address `json:"delegator_address" yaml:"delegator_address"` // the address of the delegator
+	ValidatorAddress sdk.Address `json:"validator_address" yaml:"validator_address"` // the address of the validator delegated to
+	Amount           sdk.Int     `json:"amount" yaml:"amount"`                       // the amount of tokens delegated
+}
+
+// NewDelegation - initialize a new delegation
+func NewDelegation(delegatorAddr, validatorAddr sdk.Address, amount sdk.Int) Delegation {
+	return Delegation{
+		DelegatorAddress: delegatorAddr,
+		ValidatorAddress: validatorAddr,
+		Amount:           amount,
+	}
+}
+
+// String returns a human readable string representation of a delegation
+func (d Delegation) String() string {
+	return fmt.Sprintf("Delegator:\t\t%s\nValidator:\t\t%s\nAmount:\t\t\t%s\n", d.DelegatorAddress, d.ValidatorAddress, d.Amount)
+}
+
+// Delegations is a collection of Delegation
+type Delegations []Delegation
+
+func (d Delegations) String() (out string) {
+	for _, del := range d {
+		out += del.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// total amount of tokens delegated
+func (d Delegations) Total() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, del := range d {
+		total = total.Add(del.Amount)
+	}
+	return total
+}
+
+// UnbondingDelegation - the tokens of a delegator waiting to be released from the staked pool
+type UnbondingDelegation struct {
+	DelegatorAddress sdk.Address `json:"delegator_address" yaml:"delegator_address"` // the address of the delegator
+	ValidatorAddress sdk.Address `json:"validator_address" yaml:"validator_address"` // the address of the validator undelegated from
+	Amount           sdk.Int     `json:"amount" yaml:"amount"`                       // the amount of tokens unbonding
+	CreationHeight   int64       `json:"creation_height" yaml:"creation_height"`     // the height the undelegation was submitted (used in slashing)
+	CompletionTime   time.Time   `json:"completion_time" yaml:"completion_time"`     // the time the tokens are released to the delegator
+}
+
+// String returns a human readable string representation of an unbonding delegation
+func (u UnbondingDelegation) String() string {
+	return fmt.Sprintf("Delegator:\t\t%s\nValidator:\t\t%s\nAmount:\t\t\t%s\nCreation Height:\t%d\nCompletion Time:\t%v\n",
+		u.DelegatorAddress, u.ValidatorAddress, u.Amount, u.CreationHeight, u.CompletionTime)
+}
+
+// UnbondingDelegations is a collection of UnbondingDelegation
+type UnbondingDelegations []UnbondingDelegation
+
+func (u UnbondingDelegations) String() (out string) {
+	for _, ubd := range u {
+		out += ubd.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// PartialUnstake - part of the self stake of a validator waiting to be released from the staked pool
+type PartialUnstake struct {
+	Address        sdk.Address `json:"address" yaml:"address"`                 // the address of the validator
+	Amount         sdk.Int     `json:"amount" yaml:"amount"`                   // the amount of tokens unstaking
+	CreationHeight int64       `json:"creation_height" yaml:"creation_height"` // the height the partial unstake was submitted (used in slashing)
+	CompletionTime time.Time   `json:"completion_time" yaml:"completion_time"` // the time the tokens are released to the output address
+}
+
+// String returns a human readable string representation of a partial unstake
+func (p PartialUnstake) String() string {
+	return fmt.Sprintf("Address:\t\t%s\nAmount:\t\t\t%s\nCreation Height:\t%d\nCompletion Time:\t%v\n",
+		p.Address, p.Amount, p.CreationHeight, p.CompletionTime)
+}
+
+// PartialUnstakes is a collection of PartialUnstake
+type PartialUnstakes []PartialUnstake
+
+func (p PartialUnstakes) String() (out string) {
+	for _, pu := range p {
+		out += pu.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -29,6 +29,14 @@ const (
 	CodeWaitingValidator         CodeType          = 117
 	CodeInvalidServiceURL        CodeType          = 118
 	CodeInvalidNetworkIdentifier CodeType          = 119
+	CodeNoDelegation             CodeType          = 120
+	CodeInvalidCommissionRate    CodeType          = 121
+	CodeUnauthorizedOutput       CodeType          = 122
+	CodeStakeDecrease            CodeType          = 123
+	CodeInsufficientSelfStake    CodeType          = 124
+	CodeInvalidLivenessReport    CodeType          = 125
+	CodeInvalidParamChange       CodeType          = 126
+	CodeInvalidServicerKey       CodeType          = 127
 )
 
 func ErrValidatorWaitingToUnstake(codespace sdk.CodespaceType) sdk.Error {
@@ -119,3 +127,67 @@ func ErrInvalidServiceURL(codespace sdk.CodespaceType, err error) sdk.Error {
 func ErrInvalidNetworkIdentifier(codespace sdk.CodespaceType, err error) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidNetworkIdentifier, fmt.Sprintf("the network Identifier is not valid: "+err.Error()))
 }
+
+func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "delegator address is nil")
+}
+
+func ErrSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "a validator cannot delegate to itself, stake instead")
+}
+
+func ErrNoDelegationFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDelegation, "no delegation found for the delegator and validator")
+}
+
+func ErrInsufficientDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegation, "the amount to undelegate is greater than the delegation")
+}
+
+func ErrInvalidCommissionRate(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCommissionRate, "the commission rate must be a percentage between 0 and 100")
+}
+
+func ErrUnauthorizedOutputAddress(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedOutput, "the output address of a validator can only be changed by the output address")
+}
+
+func ErrStakeDecrease(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeStakeDecrease, "the stake of a validator can't be decreased by editing the stake, unstake instead")
+}
+
+func ErrInsufficientSelfStake(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientSelfStake, "the amount to unstake is greater than the stake of the validator not delegated to it")
+}
+
+func ErrSelfLivenessReport(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidLivenessReport, "a validator cannot report its own service url")
+}
+
+func ErrLivenessReporterNotStaked(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidLivenessReport, "only staked and unjailed validators can report the liveness of a servicer")
+}
+
+func ErrDuplicateLivenessReport(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidLivenessReport, "the reporter already reported the servicer in this session")
+}
+
+func ErrInvalidLivenessProbe(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidLivenessReport, "the liveness probe is invalid: "+err.Error())
+}
+
+func ErrInvalidParamChange(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamChange, "the param change is invalid: "+err.Error())
+}
+
+func ErrNilServicerKey(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidServicerKey, "the servicer public key is nil")
+}
+
+func ErrServicerKeyTypeNotSupported(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidServicerKey, "the servicer public key must be an ed25519 public key")
+}
+
+func ErrServicerKeyInUse(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidServicerKey, "the servicer public key is already used by another validator")
+}
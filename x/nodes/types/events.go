@@ -2,24 +2,42 @@ package types
 
 // pos module event types
 const (
-	EventTypeCompleteUnstaking       = "complete_unstaking"
-	EventTypeCreateValidator         = "create_validator"
-	EventTypeStake                   = "stake"
-	EventTypeBeginUnstake            = "begin_unstake"
-	EventTypeWaitingToBeginUnstaking = "waiting_to_begin_unstaking"
-	EventTypeUnstake                 = "unstake"
-	EventTypeProposerReward          = "proposer_reward"
-	EventTypeDAOAllocation           = "dao_allocation"
-	EventTypeSlash                   = "slash"
-	EventTypeLiveness                = "liveness"
-	AttributeKeyAddress              = "address"
-	AttributeKeyHeight               = "height"
-	AttributeKeyPower                = "power"
-	AttributeKeyReason               = "reason"
-	AttributeKeyJailed               = "jailed"
-	AttributeKeyMissedBlocks         = "missed_blocks"
-	AttributeValueDoubleSign         = "double_sign"
-	AttributeValueMissingSignature   = "missing_signature"
-	AttributeKeyValidator            = "validator"
-	AttributeValueCategory           = ModuleName
+	EventTypeCompleteUnstaking        = "complete_unstaking"
+	EventTypeCreateValidator          = "create_validator"
+	EventTypeStake                    = "stake"
+	EventTypeEditStake                = "edit_stake"
+	EventTypeWaitingToEditStake       = "waiting_to_edit_stake"
+	EventTypeBeginUnstake             = "begin_unstake"
+	EventTypeWaitingToBeginUnstaking  = "waiting_to_begin_unstaking"
+	EventTypeUnstake                  = "unstake"
+	EventTypePartialUnstake           = "partial_unstake"
+	EventTypeCompletePartialUnstake   = "complete_partial_unstake"
+	EventTypeProposerReward           = "proposer_reward"
+	EventTypeDAOAllocation            = "dao_allocation"
+	EventTypeSlash                    = "slash"
+	EventTypeLiveness                 = "liveness"
+	EventTypeServicerLiveness         = "servicer_liveness"
+	EventTypeDelegate                 = "delegate"
+	EventTypeUndelegate               = "undelegate"
+	EventTypeCompleteUndelegation     = "complete_undelegation"
+	EventTypeDelegatorReward          = "delegator_reward"
+	EventTypeRotateServicerKey        = "rotate_servicer_key"
+	AttributeKeyAddress               = "address"
+	AttributeKeyServicerPublicKey     = "servicer_public_key"
+	AttributeKeyHeight                = "height"
+	AttributeKeyPower                 = "power"
+	AttributeKeyReason                = "reason"
+	AttributeKeyJailed                = "jailed"
+	AttributeKeyMissedBlocks          = "missed_blocks"
+	AttributeValueDoubleSign          = "double_sign"
+	AttributeValueMissingSignature    = "missing_signature"
+	AttributeValueServicerUnreachable = "servicer_unreachable"
+	AttributeKeyReporter              = "reporter"
+	AttributeKeyFailureReports        = "failure_reports"
+	AttributeKeyProbeFailure          = "probe_failure"
+	AttributeKeyValidator             = "validator"
+	AttributeKeyDelegator             = "delegator"
+	AttributeKeyCompletionTime        = "completion_time"
+	AttributeKeyOutputAddress         = "output_address"
+	AttributeValueCategory            = ModuleName
 )
@@ -59,3 +59,13 @@ type ValidatorSet interface {
 	// MaxValidators returns the maximum amount of staked validators
 	MaxValidators(sdk.Ctx) uint64
 }
+
+// PocketKeeper defines the expected pocketcore keeper (noalias)
+type PocketKeeper interface {
+	// validate the chains of a stake against the pocketcore chain registry
+	ValidateStakingChains(ctx sdk.Ctx, chains []string) sdk.Error
+	// validate the probe relay of a liveness report: signed by the reporter for a chain of the servicer in the session
+	ValidateLivenessProbe(ctx sdk.Ctx, probe []byte, reporter sdk.Address, servicerPubKey string, servicerChains []string, sessionBlockHeight int64) sdk.Error
+	// the # of sessions the claims and proofs of a session can be sent for, after the session
+	ClaimSessions(ctx sdk.Ctx) int64
+}
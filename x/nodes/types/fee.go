@@ -1,17 +1,40 @@
 package types
 
+import (
+	coreTypes "github.com/pokt-network/pocket-core/types"
+)
+
+// default fees of the messages (in uPOKT), the fees in state are the MessageFees param
 const (
-	StakeFee   = 100000
-	UnstakeFee = 100000
-	UnjailFee  = 100000
-	SendFee    = 100000
+	StakeFee                  = 100000
+	UnstakeFee                = 100000
+	UnjailFee                 = 100000
+	SendFee                   = 100000
+	DelegateFee               = 100000
+	UndelegateFee             = 100000
+	EditStakeFee              = 100000
+	PartialUnstakeFee         = 100000
+	ReportServicerLivenessFee = 10000
+	RotateServicerKeyFee      = 100000
 )
 
 var (
-	NodeFeeMap = map[string]int64{
-		MsgStakeName:   StakeFee,
-		MsgUnstakeName: UnstakeFee,
-		MsgUnjailName:  UnjailFee,
-		MsgSendName:    SendFee,
+	DefaultMessageFees = MessageFees{
+		{MsgType: MsgStakeName, Fee: StakeFee},
+		{MsgType: MsgUnstakeName, Fee: UnstakeFee},
+		{MsgType: MsgUnjailName, Fee: UnjailFee},
+		{MsgType: MsgSendName, Fee: SendFee},
+		{MsgType: MsgDelegateName, Fee: DelegateFee},
+		{MsgType: MsgUndelegateName, Fee: UndelegateFee},
+		{MsgType: MsgEditStakeName, Fee: EditStakeFee},
+		{MsgType: MsgPartialUnstakeName, Fee: PartialUnstakeFee},
+		{MsgType: MsgReportServicerLivenessName, Fee: ReportServicerLivenessFee},
+		{MsgType: MsgRotateServicerKeyName, Fee: RotateServicerKeyFee},
 	}
 )
+
+// the fee of a message type (in uPOKT)
+type MessageFee = coreTypes.MessageFee
+
+// the fees of the messages of the module
+type MessageFees = coreTypes.MessageFees
@@ -14,6 +14,10 @@ type GenesisState struct {
 	SigningInfos             map[string]ValidatorSigningInfo `json:"signing_infos" yaml:"signing_infos"`
 	MissedBlocks             map[string][]MissedBlock        `json:"missed_blocks" yaml:"missed_blocks"`
 	PreviousProposer         sdk.Address                     `json:"previous_proposer" yaml:"previous_proposer"`
+	Delegations              []Delegation                    `json:"delegations" yaml:"delegations"`
+	UnbondingDelegations     []UnbondingDelegation           `json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	PartialUnstakes          PartialUnstakes                 `json:"partial_unstakes" yaml:"partial_unstakes"`
+	RetiredServicerKeys      RetiredServicerKeys             `json:"retired_servicer_keys" yaml:"retired_servicer_keys"`
 }
 
 // PrevState validator power, needed for validator set update logic
@@ -19,25 +19,48 @@ var ( // Keys for store prefixes
 	ProposerKey                     = []byte{0x01} // key for the proposer address used for rewards
 	ValidatorSigningInfoKey         = []byte{0x11} // Prefix for signing info used in slashing
 	ValidatorMissedBlockBitArrayKey = []byte{0x12} // Prefix for missed block bit array used in slashing
+	ServicerLivenessInfoKey         = []byte{0x13} // Prefix for the servicer liveness info used in jailing unreachable servicers
 	AllValidatorsKey                = []byte{0x21} // prefix for each key to a validator
 	StakedValidatorsKey             = []byte{0x23} // prefix for each key to a staked validator index, sorted by power
+	ServicerKeyIndexKey             = []byte{0x24} // prefix for each key to a validator address, by the address of its servicer key
+	RetiredServicerKeyKey           = []byte{0x25} // prefix for each key to a retired servicer key, by the address of the servicer key
 	PrevStateValidatorsPowerKey     = []byte{0x31} // prefix for the key to the validators of the prevState state
 	PrevStateTotalPowerKey          = []byte{0x32} // prefix for the total power of the prevState state
 	UnstakingValidatorsKey          = []byte{0x41} // prefix for unstaking validator
 	AwardValidatorKey               = []byte{0x51} // prefix for awarding validators
 	BurnValidatorKey                = []byte{0x52} // prefix for awarding validators
 	WaitingToBeginUnstakingKey      = []byte{0x43} // prefix for waiting validators
+	WaitingToEditStakeKey           = []byte{0x44} // prefix for validators waiting to edit their chains and service url
+	DelegationKey                   = []byte{0x61} // prefix for each key to a delegation, by validator then delegator
+	UnbondingDelegationsKey         = []byte{0x62} // prefix for the unbonding delegations queue
+	ValidatorUnbondingKey           = []byte{0x63} // prefix for each key to a completion time of the unbonding delegations queue, by validator
+	PartialUnstakesKey              = []byte{0x64} // prefix for the partial unstakes queue
+	ValidatorPartialUnstakeKey      = []byte{0x65} // prefix for each key to a completion time of the partial unstakes queue, by validator
 )
 
 func KeyForValWaitingToBeginUnstaking(addr sdk.Address) []byte {
 	return append(WaitingToBeginUnstakingKey, addr.Bytes()...)
 }
 
+func KeyForValWaitingToEditStake(addr sdk.Address) []byte {
+	return append(WaitingToEditStakeKey, addr.Bytes()...)
+}
+
 // generates the key for the validator with address
 func KeyForValByAllVals(addr sdk.Address) []byte {
 	return append(AllValidatorsKey, addr.Bytes()...)
 }
 
+// generates the key for the validator index by the address of the servicer key
+func KeyForServicerKey(servicerAddr sdk.Address) []byte {
+	return append(ServicerKeyIndexKey, servicerAddr.Bytes()...)
+}
+
+// generates the key for the retired servicer key with the address
+func KeyForRetiredServicerKey(servicerAddr sdk.Address) []byte {
+	return append(RetiredServicerKeyKey, servicerAddr.Bytes()...)
+}
+
 // generates the key for unstaking validators by the unstakingtime
 func KeyForUnstakingValidators(unstakingTime time.Time) []byte {
 	bz := sdk.FormatTimeBytes(unstakingTime)
@@ -64,6 +87,48 @@ func KeyForValidatorBurn(address sdk.Address) []byte {
 	return append(BurnValidatorKey, address...)
 }
 
+// generates the prefix key for the delegations of a validator
+func KeyForValidatorDelegations(valAddr sdk.Address) []byte {
+	return append(DelegationKey, valAddr.Bytes()...)
+}
+
+// generates the key for a delegation of a delegator to a validator
+func KeyForDelegation(valAddr, delAddr sdk.Address) []byte {
+	return append(KeyForValidatorDelegations(valAddr), delAddr.Bytes()...)
+}
+
+// generates the key for unbonding delegations by the completion time
+func KeyForUnbondingDelegations(completionTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(completionTime)
+	return append(UnbondingDelegationsKey, bz...) // use the completion time as part of the key
+}
+
+// generates the prefix key for the completion times of the unbonding delegations of a validator
+func KeyForValidatorUnbondings(valAddr sdk.Address) []byte {
+	return append(ValidatorUnbondingKey, valAddr.Bytes()...)
+}
+
+// generates the key for a completion time of the unbonding delegations of a validator
+func KeyForValidatorUnbonding(valAddr sdk.Address, completionTime time.Time) []byte {
+	return append(KeyForValidatorUnbondings(valAddr), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// generates the key for partial unstakes by the completion time
+func KeyForPartialUnstakes(completionTime time.Time) []byte {
+	bz := sdk.FormatTimeBytes(completionTime)
+	return append(PartialUnstakesKey, bz...) // use the completion time as part of the key
+}
+
+// generates the prefix key for the completion times of the partial unstakes of a validator
+func KeyForValidatorPartialUnstakes(valAddr sdk.Address) []byte {
+	return append(ValidatorPartialUnstakeKey, valAddr.Bytes()...)
+}
+
+// generates the key for a completion time of the partial unstakes of a validator
+func KeyForValidatorPartialUnstake(valAddr sdk.Address, completionTime time.Time) []byte {
+	return append(KeyForValidatorPartialUnstakes(valAddr), sdk.FormatTimeBytes(completionTime)...)
+}
+
 // Removes the prefix bytes from a key to expose true address
 func AddressFromKey(key []byte) []byte {
 	return key[1:] // remove prefix bytes
@@ -113,6 +178,11 @@ func GetValidatorSigningInfoKey(v sdk.Address) []byte {
 	return append(ValidatorSigningInfoKey, v.Bytes()...)
 }
 
+// generates the key for the servicer liveness info of the validator
+func GetServicerLivenessInfoKey(v sdk.Address) []byte {
+	return append(ServicerLivenessInfoKey, v.Bytes()...)
+}
+
 // extract the address from a validator signing info key
 func GetValidatorSigningInfoAddress(key []byte) (v sdk.Address) {
 	addr := key[1:]
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 )
@@ -11,28 +13,52 @@ var (
 	_ sdk.Msg = &MsgBeginUnstake{}
 	_ sdk.Msg = &MsgUnjail{}
 	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgDelegate{}
+	_ sdk.Msg = &MsgUndelegate{}
+	_ sdk.Msg = &MsgEditStake{}
+	_ sdk.Msg = &MsgPartialUnstake{}
+	_ sdk.Msg = &MsgReportServicerLiveness{}
+	_ sdk.Msg = &MsgRotateServicerKey{}
 )
 
 const (
-	MsgStakeName   = "stake_validator"
-	MsgUnstakeName = "begin_unstake_validator"
-	MsgUnjailName  = "unjail_validator"
-	MsgSendName    = "send"
+	MsgStakeName                  = "stake_validator"
+	MsgUnstakeName                = "begin_unstake_validator"
+	MsgUnjailName                 = "unjail_validator"
+	MsgSendName                   = "send"
+	MsgDelegateName               = "delegate_validator"
+	MsgUndelegateName             = "undelegate_validator"
+	MsgEditStakeName              = "edit_stake_validator"
+	MsgPartialUnstakeName         = "partial_unstake_validator"
+	MsgReportServicerLivenessName = "report_servicer_liveness"
+	MsgRotateServicerKeyName      = "rotate_servicer_key"
 )
 
 //----------------------------------------------------------------------------------------------------------------------
 
 // MsgStake - struct for staking transactions
 type MsgStake struct {
-	PublicKey  crypto.PublicKey `json:"public_key" yaml:"public_key"`
-	Chains     []string         `json:"chains" yaml:"chains"`
-	Value      sdk.Int          `json:"value" yaml:"value"`
-	ServiceURL string           `json:"service_url" yaml:"service_url"`
+	PublicKey      crypto.PublicKey `json:"public_key" yaml:"public_key"`
+	Chains         []string         `json:"chains" yaml:"chains"`
+	Value          sdk.Int          `json:"value" yaml:"value"`
+	ServiceURL     string           `json:"service_url" yaml:"service_url"`
+	CommissionRate int64            `json:"commission_rate" yaml:"commission_rate"`
+	Output         sdk.Address      `json:"output_address,omitempty" yaml:"output_address"`
+	CurrentOutput  sdk.Address      `json:"current_output_address,omitempty" yaml:"current_output_address"`
 }
 
 // GetSigners retrun address(es) that must sign over msg.GetSignBytes()
+// the output address must co-sign when it differs from the operator
+// the current output address co-signs a change of the output address of a restaking validator
 func (msg MsgStake) GetSigners() []sdk.Address {
-	addrs := []sdk.Address{sdk.Address(msg.PublicKey.Address())}
+	operator := sdk.Address(msg.PublicKey.Address())
+	addrs := []sdk.Address{operator}
+	if !msg.Output.Empty() && !msg.Output.Equals(operator) {
+		addrs = append(addrs, msg.Output)
+	}
+	if !msg.CurrentOutput.Empty() && !msg.CurrentOutput.Equals(operator) && !msg.CurrentOutput.Equals(msg.Output) {
+		addrs = append(addrs, msg.CurrentOutput)
+	}
 	return addrs
 }
 
@@ -62,6 +88,9 @@ func (msg MsgStake) ValidateBasic() sdk.Error {
 	if err := ValidateServiceURL(msg.ServiceURL); err != nil {
 		return err
 	}
+	if msg.CommissionRate < 0 || msg.CommissionRate > 100 {
+		return ErrInvalidCommissionRate(DefaultCodespace)
+	}
 	return nil
 }
 
@@ -73,7 +102,60 @@ func (msg MsgStake) Type() string { return MsgStakeName }
 
 // GetFee get fee for msg
 func (msg MsgStake) GetFee() sdk.Int {
-	return sdk.NewInt(NodeFeeMap[msg.Type()])
+	return DefaultMessageFees.Amount(msg.Type())
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgEditStake - struct for editing the stake of a staked validator
+// the value is the new total stake of the validator and can't be lower than the current stake
+type MsgEditStake struct {
+	PublicKey  crypto.PublicKey `json:"public_key" yaml:"public_key"`
+	Chains     []string         `json:"chains" yaml:"chains"`
+	Value      sdk.Int          `json:"value" yaml:"value"`
+	ServiceURL string           `json:"service_url" yaml:"service_url"`
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgEditStake) GetSigners() []sdk.Address {
+	return []sdk.Address{sdk.Address(msg.PublicKey.Address())}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgEditStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgEditStake) ValidateBasic() sdk.Error {
+	if msg.PublicKey == nil || msg.PublicKey.RawString() == "" {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Value.LTE(sdk.ZeroInt()) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	if len(msg.Chains) == 0 {
+		return ErrNoChains(DefaultCodespace)
+	}
+	for _, chain := range msg.Chains {
+		err := ValidateNetworkIdentifier(chain)
+		if err != nil {
+			return err
+		}
+	}
+	return ValidateServiceURL(msg.ServiceURL)
+}
+
+// Route provides router key for msg
+func (msg MsgEditStake) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgEditStake) Type() string { return MsgEditStakeName }
+
+// GetFee get fee for msg
+func (msg MsgEditStake) GetFee() sdk.Int {
+	return DefaultMessageFees.Amount(msg.Type())
 }
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -110,7 +192,7 @@ func (msg MsgBeginUnstake) Type() string { return MsgUnstakeName }
 
 // GetFee get fee for msg
 func (msg MsgBeginUnstake) GetFee() sdk.Int {
-	return sdk.NewInt(NodeFeeMap[msg.Type()])
+	return DefaultMessageFees.Amount(msg.Type())
 }
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -147,7 +229,7 @@ func (msg MsgUnjail) Type() string { return MsgUnjailName }
 
 // GetFee get fee for msg
 func (msg MsgUnjail) GetFee() sdk.Int {
-	return sdk.NewInt(NodeFeeMap[msg.Type()])
+	return DefaultMessageFees.Amount(msg.Type())
 }
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -192,5 +274,255 @@ func (msg MsgSend) Type() string { return MsgSendName }
 
 // GetFee get fee for msg
 func (msg MsgSend) GetFee() sdk.Int {
-	return sdk.NewInt(NodeFeeMap[msg.Type()])
+	return DefaultMessageFees.Amount(msg.Type())
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgDelegate - struct for delegating tokens to a staked validator
+type MsgDelegate struct {
+	DelegatorAddr sdk.Address `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddr sdk.Address `json:"validator_address" yaml:"validator_address"`
+	Amount        sdk.Int     `json:"amount" yaml:"amount"`
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgDelegate) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.DelegatorAddr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgDelegate) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddr.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.DelegatorAddr.Equals(msg.ValidatorAddr) {
+		return ErrSelfDelegation(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgDelegate) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgDelegate) Type() string { return MsgDelegateName }
+
+// GetFee get fee for msg
+func (msg MsgDelegate) GetFee() sdk.Int {
+	return DefaultMessageFees.Amount(msg.Type())
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgUndelegate - struct for undelegating tokens from a validator
+type MsgUndelegate struct {
+	DelegatorAddr sdk.Address `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddr sdk.Address `json:"validator_address" yaml:"validator_address"`
+	Amount        sdk.Int     `json:"amount" yaml:"amount"`
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgUndelegate) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.DelegatorAddr}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUndelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgUndelegate) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddr.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgUndelegate) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgUndelegate) Type() string { return MsgUndelegateName }
+
+// GetFee get fee for msg
+func (msg MsgUndelegate) GetFee() sdk.Int {
+	return DefaultMessageFees.Amount(msg.Type())
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgPartialUnstake - struct for unstaking part of the stake of a validator
+// the amount goes through the unstaking queue while the validator stays staked with the rest
+type MsgPartialUnstake struct {
+	Address sdk.Address `json:"validator_address" yaml:"validator_address"`
+	Amount  sdk.Int     `json:"amount" yaml:"amount"`
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgPartialUnstake) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Address}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgPartialUnstake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgPartialUnstake) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Amount.LTE(sdk.ZeroInt()) {
+		return ErrBadDelegationAmount(DefaultCodespace)
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgPartialUnstake) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgPartialUnstake) Type() string { return MsgPartialUnstakeName }
+
+// GetFee get fee for msg
+func (msg MsgPartialUnstake) GetFee() sdk.Int {
+	return DefaultMessageFees.Amount(msg.Type())
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgReportServicerLiveness - struct for reporting the service url of a validator as unreachable
+// sent by the liveness prober of a staked validator after a failed probe, with the probe relay to a chain of the
+// servicer signed by the prober; the reporter attests the failure of the probe by signing the msg
+type MsgReportServicerLiveness struct {
+	Reporter sdk.Address `json:"reporter_address" yaml:"reporter_address"`
+	Servicer sdk.Address `json:"servicer_address" yaml:"servicer_address"`
+	Probe    []byte      `json:"probe" yaml:"probe"`     // the json encoded probe relay sent to the servicer
+	Failure  string      `json:"failure" yaml:"failure"` // the failure of the probe
+}
+
+// the failures of a liveness probe
+const (
+	ProbeFailureTimeout     = "timeout"     // the servicer didn't answer within the probe timeout
+	ProbeFailureUnreachable = "unreachable" // the service url couldn't be reached
+	ProbeFailureRejected    = "rejected"    // the servicer rejected the probe relay
+	ProbeFailureResponse    = "response"    // the servicer answered with an invalid or unsigned response
+	MaxProbeSize            = 4096          // the max size of the probe relay in a report
+)
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgReportServicerLiveness) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Reporter}
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgReportServicerLiveness) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgReportServicerLiveness) ValidateBasic() sdk.Error {
+	if msg.Reporter.Empty() || msg.Servicer.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Reporter.Equals(msg.Servicer) {
+		return ErrSelfLivenessReport(DefaultCodespace)
+	}
+	if len(msg.Probe) == 0 || len(msg.Probe) > MaxProbeSize {
+		return ErrInvalidLivenessProbe(DefaultCodespace, fmt.Errorf("the probe must be between 1 and %d bytes", MaxProbeSize))
+	}
+	switch msg.Failure {
+	case ProbeFailureTimeout, ProbeFailureUnreachable, ProbeFailureRejected, ProbeFailureResponse:
+	default:
+		return ErrInvalidLivenessProbe(DefaultCodespace, fmt.Errorf("unknown probe failure %q", msg.Failure))
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgReportServicerLiveness) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgReportServicerLiveness) Type() string { return MsgReportServicerLivenessName }
+
+// GetFee get fee for msg
+func (msg MsgReportServicerLiveness) GetFee() sdk.Int {
+	return DefaultMessageFees.Amount(msg.Type())
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgRotateServicerKey - struct for registering the key a validator signs relays, claims and proofs with
+// separate from the consensus key of the validator; rotating to the consensus key removes the servicer key
+type MsgRotateServicerKey struct {
+	Address           sdk.Address      `json:"validator_address" yaml:"validator_address"`
+	ServicerPublicKey crypto.PublicKey `json:"servicer_public_key" yaml:"servicer_public_key"`
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+// the servicer key must co-sign to prove it is held by the validator
+func (msg MsgRotateServicerKey) GetSigners() []sdk.Address {
+	addrs := []sdk.Address{msg.Address}
+	if msg.ServicerPublicKey != nil {
+		if servicer := sdk.Address(msg.ServicerPublicKey.Address()); !servicer.Equals(msg.Address) {
+			addrs = append(addrs, servicer)
+		}
+	}
+	return addrs
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRotateServicerKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgRotateServicerKey) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.ServicerPublicKey == nil || msg.ServicerPublicKey.RawString() == "" {
+		return ErrNilServicerKey(DefaultCodespace)
+	}
+	// relays, claims and proofs are verified with ed25519 keys
+	if _, ok := msg.ServicerPublicKey.(crypto.Ed25519PublicKey); !ok {
+		return ErrServicerKeyTypeNotSupported(DefaultCodespace)
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgRotateServicerKey) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgRotateServicerKey) Type() string { return MsgRotateServicerKeyName }
+
+// GetFee get fee for msg
+func (msg MsgRotateServicerKey) GetFee() sdk.Int {
+	return DefaultMessageFees.Amount(msg.Type())
 }
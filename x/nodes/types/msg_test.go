@@ -419,15 +419,23 @@ func TestMsgStake_GetSignBytes(t *testing.T) {
 
 func TestMsgStake_GetSigners(t *testing.T) {
 	type fields struct {
-		Address    sdk.Address
-		PubKey     crypto.PublicKey
-		Chains     []string
-		Value      sdk.Int
-		ServiceURL string
+		Address       sdk.Address
+		PubKey        crypto.PublicKey
+		Chains        []string
+		Value         sdk.Int
+		ServiceURL    string
+		Output        sdk.Address
+		CurrentOutput sdk.Address
 	}
 
 	var pub crypto.Ed25519PublicKey
 	rand.Read(pub[:])
+	var outputPub crypto.Ed25519PublicKey
+	rand.Read(outputPub[:])
+	output := sdk.Address(outputPub.Address())
+	var currentOutputPub crypto.Ed25519PublicKey
+	rand.Read(currentOutputPub[:])
+	currentOutput := sdk.Address(currentOutputPub.Address())
 	chains := []string{"00"}
 	value := sdk.OneInt()
 	surl := "www.pokt.network"
@@ -443,14 +451,38 @@ func TestMsgStake_GetSigners(t *testing.T) {
 			Value:      value,
 			ServiceURL: surl,
 		}, []sdk.Address{sdk.Address(pub.Address())}},
+		{"Test GetSigners With Output Address", fields{
+			PubKey:     pub,
+			Chains:     chains,
+			Value:      value,
+			ServiceURL: surl,
+			Output:     output,
+		}, []sdk.Address{sdk.Address(pub.Address()), output}},
+		{"Test GetSigners With Operator As Output Address", fields{
+			PubKey:     pub,
+			Chains:     chains,
+			Value:      value,
+			ServiceURL: surl,
+			Output:     sdk.Address(pub.Address()),
+		}, []sdk.Address{sdk.Address(pub.Address())}},
+		{"Test GetSigners With Current Output Address", fields{
+			PubKey:        pub,
+			Chains:        chains,
+			Value:         value,
+			ServiceURL:    surl,
+			Output:        output,
+			CurrentOutput: currentOutput,
+		}, []sdk.Address{sdk.Address(pub.Address()), output, currentOutput}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			msg := MsgStake{
-				PublicKey:  tt.fields.PubKey,
-				Chains:     tt.fields.Chains,
-				Value:      tt.fields.Value,
-				ServiceURL: tt.fields.ServiceURL,
+				PublicKey:     tt.fields.PubKey,
+				Chains:        tt.fields.Chains,
+				Value:         tt.fields.Value,
+				ServiceURL:    tt.fields.ServiceURL,
+				Output:        tt.fields.Output,
+				CurrentOutput: tt.fields.CurrentOutput,
 			}
 			if got := msg.GetSigners(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetSigners() = %v, want %v", got, tt.want)
@@ -550,6 +582,7 @@ func TestMsgStake_ValidateBasic(t *testing.T) {
 		Chains     []string
 		Value      sdk.Int
 		ServiceURL string
+		Commission int64
 	}
 
 	var pub crypto.Ed25519PublicKey
@@ -593,14 +626,22 @@ func TestMsgStake_ValidateBasic(t *testing.T) {
 			Value:      value,
 			ServiceURL: "",
 		}, ErrInvalidServiceURL(DefaultCodespace, fmt.Errorf("parse : empty url"))},
+		{"Test Validate Basic bad commission rate", fields{
+			PubKey:     pub,
+			Chains:     chains,
+			Value:      value,
+			ServiceURL: surl,
+			Commission: 101,
+		}, ErrInvalidCommissionRate(DefaultCodespace)},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			msg := MsgStake{
-				PublicKey:  tt.fields.PubKey,
-				Chains:     tt.fields.Chains,
-				Value:      tt.fields.Value,
-				ServiceURL: tt.fields.ServiceURL,
+				PublicKey:      tt.fields.PubKey,
+				Chains:         tt.fields.Chains,
+				Value:          tt.fields.Value,
+				ServiceURL:     tt.fields.ServiceURL,
+				CommissionRate: tt.fields.Commission,
 			}
 			if got := msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
@@ -753,3 +794,247 @@ func TestMsgUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgDelegate_ValidateBasic(t *testing.T) {
+	type fields struct {
+		DelegatorAddr sdk.Address
+		ValidatorAddr sdk.Address
+		Amount        sdk.Int
+	}
+
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	da := sdk.Address(pub.Address())
+	rand.Read(pub[:])
+	va := sdk.Address(pub.Address())
+
+	tests := []struct {
+		name   string
+		fields fields
+		want   sdk.Error
+	}{
+		{"Test ValidateBasic ok", fields{
+			DelegatorAddr: da,
+			ValidatorAddr: va,
+			Amount:        sdk.OneInt(),
+		}, nil},
+		{"Test ValidateBasic empty delegator", fields{
+			DelegatorAddr: nil,
+			ValidatorAddr: va,
+			Amount:        sdk.OneInt(),
+		}, ErrNilDelegatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic empty validator", fields{
+			DelegatorAddr: da,
+			ValidatorAddr: nil,
+			Amount:        sdk.OneInt(),
+		}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic self delegation", fields{
+			DelegatorAddr: va,
+			ValidatorAddr: va,
+			Amount:        sdk.OneInt(),
+		}, ErrSelfDelegation(DefaultCodespace)},
+		{"Test ValidateBasic bad amount", fields{
+			DelegatorAddr: da,
+			ValidatorAddr: va,
+			Amount:        sdk.ZeroInt(),
+		}, ErrBadDelegationAmount(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := MsgDelegate{
+				DelegatorAddr: tt.fields.DelegatorAddr,
+				ValidatorAddr: tt.fields.ValidatorAddr,
+				Amount:        tt.fields.Amount,
+			}
+			if got := msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgUndelegate_ValidateBasic(t *testing.T) {
+	type fields struct {
+		DelegatorAddr sdk.Address
+		ValidatorAddr sdk.Address
+		Amount        sdk.Int
+	}
+
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	da := sdk.Address(pub.Address())
+	rand.Read(pub[:])
+	va := sdk.Address(pub.Address())
+
+	tests := []struct {
+		name   string
+		fields fields
+		want   sdk.Error
+	}{
+		{"Test ValidateBasic ok", fields{
+			DelegatorAddr: da,
+			ValidatorAddr: va,
+			Amount:        sdk.OneInt(),
+		}, nil},
+		{"Test ValidateBasic empty delegator", fields{
+			DelegatorAddr: nil,
+			ValidatorAddr: va,
+			Amount:        sdk.OneInt(),
+		}, ErrNilDelegatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic empty validator", fields{
+			DelegatorAddr: da,
+			ValidatorAddr: nil,
+			Amount:        sdk.OneInt(),
+		}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic bad amount", fields{
+			DelegatorAddr: da,
+			ValidatorAddr: va,
+			Amount:        sdk.NewInt(-1),
+		}, ErrBadDelegationAmount(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := MsgUndelegate{
+				DelegatorAddr: tt.fields.DelegatorAddr,
+				ValidatorAddr: tt.fields.ValidatorAddr,
+				Amount:        tt.fields.Amount,
+			}
+			if got := msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgEditStake_ValidateBasic(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	tests := []struct {
+		name string
+		msg  MsgEditStake
+		want sdk.Error
+	}{
+		{"Test ValidateBasic ok", MsgEditStake{
+			PublicKey:  pub,
+			Chains:     []string{"00"},
+			Value:      sdk.OneInt(),
+			ServiceURL: "https://www.pokt.network:443",
+		}, nil},
+		{"Test ValidateBasic empty public key", MsgEditStake{
+			Chains:     []string{"00"},
+			Value:      sdk.OneInt(),
+			ServiceURL: "https://www.pokt.network:443",
+		}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic bad amount", MsgEditStake{
+			PublicKey:  pub,
+			Chains:     []string{"00"},
+			Value:      sdk.ZeroInt(),
+			ServiceURL: "https://www.pokt.network:443",
+		}, ErrBadDelegationAmount(DefaultCodespace)},
+		{"Test ValidateBasic no chains", MsgEditStake{
+			PublicKey:  pub,
+			Chains:     []string{},
+			Value:      sdk.OneInt(),
+			ServiceURL: "https://www.pokt.network:443",
+		}, ErrNoChains(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgPartialUnstake_ValidateBasic(t *testing.T) {
+	var pub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	va := sdk.Address(pub.Address())
+	tests := []struct {
+		name string
+		msg  MsgPartialUnstake
+		want sdk.Error
+	}{
+		{"Test ValidateBasic ok", MsgPartialUnstake{Address: va, Amount: sdk.OneInt()}, nil},
+		{"Test ValidateBasic empty address", MsgPartialUnstake{Amount: sdk.OneInt()}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic bad amount", MsgPartialUnstake{Address: va, Amount: sdk.ZeroInt()}, ErrBadDelegationAmount(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgReportServicerLiveness_ValidateBasic(t *testing.T) {
+	var pub, pub2 crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(pub2[:])
+	reporter := sdk.Address(pub.Address())
+	servicer := sdk.Address(pub2.Address())
+	probe := []byte(`{"proof":{}}`)
+	tests := []struct {
+		name string
+		msg  MsgReportServicerLiveness
+		want sdk.Error
+	}{
+		{"Test ValidateBasic ok", MsgReportServicerLiveness{Reporter: reporter, Servicer: servicer, Probe: probe, Failure: ProbeFailureTimeout}, nil},
+		{"Test ValidateBasic no probe", MsgReportServicerLiveness{Reporter: reporter, Servicer: servicer, Failure: ProbeFailureTimeout}, ErrInvalidLivenessProbe(DefaultCodespace, fmt.Errorf("the probe must be between 1 and %d bytes", MaxProbeSize))},
+		{"Test ValidateBasic unknown failure", MsgReportServicerLiveness{Reporter: reporter, Servicer: servicer, Probe: probe, Failure: "down"}, ErrInvalidLivenessProbe(DefaultCodespace, fmt.Errorf("unknown probe failure %q", "down"))},
+		{"Test ValidateBasic empty reporter", MsgReportServicerLiveness{Servicer: servicer}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic empty servicer", MsgReportServicerLiveness{Reporter: reporter}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic self report", MsgReportServicerLiveness{Reporter: reporter, Servicer: reporter}, ErrSelfLivenessReport(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgRotateServicerKey_ValidateBasic(t *testing.T) {
+	var pub, pub2 crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(pub2[:])
+	addr := sdk.Address(pub.Address())
+	tests := []struct {
+		name string
+		msg  MsgRotateServicerKey
+		want sdk.Error
+	}{
+		{"Test ValidateBasic ok", MsgRotateServicerKey{Address: addr, ServicerPublicKey: pub2}, nil},
+		{"Test ValidateBasic rotate to the consensus key", MsgRotateServicerKey{Address: addr, ServicerPublicKey: pub}, nil},
+		{"Test ValidateBasic empty address", MsgRotateServicerKey{ServicerPublicKey: pub2}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic nil servicer key", MsgRotateServicerKey{Address: addr}, ErrNilServicerKey(DefaultCodespace)},
+		{"Test ValidateBasic multisig servicer key", MsgRotateServicerKey{Address: addr, ServicerPublicKey: crypto.PublicKeyMultiSignature{PublicKeys: []crypto.PublicKey{pub, pub2}}}, ErrServicerKeyTypeNotSupported(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgRotateServicerKey_GetSigners(t *testing.T) {
+	var pub, pub2 crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(pub2[:])
+	addr := sdk.Address(pub.Address())
+	// the servicer key co-signs the rotation
+	msg := MsgRotateServicerKey{Address: addr, ServicerPublicKey: pub2}
+	if got := msg.GetSigners(); !reflect.DeepEqual(got, []sdk.Address{addr, sdk.Address(pub2.Address())}) {
+		t.Errorf("GetSigners() = %v, want the validator and the servicer", got)
+	}
+	// rotating to the consensus key is signed by the validator only
+	msg = MsgRotateServicerKey{Address: addr, ServicerPublicKey: pub}
+	if got := msg.GetSigners(); !reflect.DeepEqual(got, []sdk.Address{addr}) {
+		t.Errorf("GetSigners() = %v, want the validator", got)
+	}
+}
@@ -12,37 +12,42 @@ import (
 // POS params default values
 const (
 	// DefaultParamspace for params keeper
-	DefaultParamspace                  = ModuleName
-	DefaultUnstakingTime               = time.Hour * 24 * 7 * 3
-	DefaultMaxValidators        uint64 = 100000
-	DefaultMinStake             int64  = 1000000
-	DefaultMaxEvidenceAge              = 60 * 2 * time.Second
-	DefaultSignedBlocksWindow          = int64(100)
-	DefaultDowntimeJailDuration        = 60 * 10 * time.Second
-	DefaultSessionBlocktime            = 25
-	DefaultProposerAllocation          = 1
-	DefaultDAOAllocation               = 10
+	DefaultParamspace                           = ModuleName
+	DefaultUnstakingTime                        = time.Hour * 24 * 7 * 3
+	DefaultMaxValidators                 uint64 = 100000
+	DefaultMinStake                      int64  = 1000000
+	DefaultMaxEvidenceAge                       = 60 * 2 * time.Second
+	DefaultSignedBlocksWindow                   = int64(100)
+	DefaultDowntimeJailDuration                 = 60 * 10 * time.Second
+	DefaultSessionBlocktime                     = 25
+	DefaultProposerAllocation                   = 1
+	DefaultDAOAllocation                        = 10
+	DefaultServicerLivenessJailThreshold        = int64(5)
+	DefaultServicerLivenessJailDuration         = time.Hour
 )
 
 // nolint - Keys for parameter access
 var (
-	KeyUnstakingTime               = []byte("UnstakingTime")
-	KeyMaxValidators               = []byte("MaxValidators")
-	KeyStakeDenom                  = []byte("StakeDenom")
-	KeyStakeMinimum                = []byte("StakeMinimum")
-	KeyMaxEvidenceAge              = []byte("MaxEvidenceAge")
-	KeySignedBlocksWindow          = []byte("SignedBlocksWindow")
-	KeyMinSignedPerWindow          = []byte("MinSignedPerWindow")
-	KeyDowntimeJailDuration        = []byte("DowntimeJailDuration")
-	KeySlashFractionDoubleSign     = []byte("SlashFractionDoubleSign")
-	KeySlashFractionDowntime       = []byte("SlashFractionDowntime")
-	KeySessionBlock                = []byte("BlocksPerSession")
-	KeyDAOAllocation               = []byte("DAOAllocation")
-	KeyProposerAllocation          = []byte("ProposerPercentage")
-	DoubleSignJailEndTime          = time.Unix(253402300799, 0) // forever
-	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
-	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
-	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	KeyUnstakingTime                 = []byte("UnstakingTime")
+	KeyMaxValidators                 = []byte("MaxValidators")
+	KeyStakeDenom                    = []byte("StakeDenom")
+	KeyStakeMinimum                  = []byte("StakeMinimum")
+	KeyMaxEvidenceAge                = []byte("MaxEvidenceAge")
+	KeySignedBlocksWindow            = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow            = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration          = []byte("DowntimeJailDuration")
+	KeySlashFractionDoubleSign       = []byte("SlashFractionDoubleSign")
+	KeySlashFractionDowntime         = []byte("SlashFractionDowntime")
+	KeySessionBlock                  = []byte("BlocksPerSession")
+	KeyDAOAllocation                 = []byte("DAOAllocation")
+	KeyProposerAllocation            = []byte("ProposerPercentage")
+	KeyServicerLivenessJailThreshold = []byte("ServicerLivenessJailThreshold")
+	KeyServicerLivenessJailDuration  = []byte("ServicerLivenessJailDuration")
+	KeyMessageFees                   = []byte("MessageFees")
+	DoubleSignJailEndTime            = time.Unix(253402300799, 0) // forever
+	DefaultMinSignedPerWindow        = sdk.NewDecWithPrec(5, 1)
+	DefaultSlashFractionDoubleSign   = sdk.NewDec(1).Quo(sdk.NewDec(20))
+	DefaultSlashFractionDowntime     = sdk.NewDec(1).Quo(sdk.NewDec(100))
 )
 
 var _ sdk.ParamSet = (*Params)(nil)
@@ -57,12 +62,16 @@ type Params struct {
 	DAOAllocation         int64         `json:"dao_allocation" yaml:"dao_allocation"`
 	ProposerAllocation    int64         `json:"proposer_allocation" yaml:"proposer_allocation"`
 	// slashing params
-	MaxEvidenceAge          time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`                     // maximum age of tendermint evidence that is still valid (currently not implemented in Cosmos or Pocket-Core)
-	SignedBlocksWindow      int64         `json:"signed_blocks_window" yaml:"signed_blocks_window"`             // window of time in blocks (unit) used for signature verification -> specifically in not signing (missing) blocks
-	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window" yaml:"min_signed_per_window"`           // minimum number of blocks the node must sign per window
-	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`         // minimum amount of time node must spend in jail after missing blocks
-	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"` // the factor of which a node is slashed for a double sign
-	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`       // the factor of which a node is slashed for missing blocks
+	MaxEvidenceAge                time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`                                 // maximum age of tendermint evidence that is still valid (currently not implemented in Cosmos or Pocket-Core)
+	SignedBlocksWindow            int64         `json:"signed_blocks_window" yaml:"signed_blocks_window"`                         // window of time in blocks (unit) used for signature verification -> specifically in not signing (missing) blocks
+	MinSignedPerWindow            sdk.Dec       `json:"min_signed_per_window" yaml:"min_signed_per_window"`                       // minimum number of blocks the node must sign per window
+	DowntimeJailDuration          time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`                     // minimum amount of time node must spend in jail after missing blocks
+	SlashFractionDoubleSign       sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`             // the factor of which a node is slashed for a double sign
+	SlashFractionDowntime         sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`                   // the factor of which a node is slashed for missing blocks
+	ServicerLivenessJailThreshold int64         `json:"servicer_liveness_jail_threshold" yaml:"servicer_liveness_jail_threshold"` // the # of validators reporting a servicer unreachable in a session to jail it (zero disables jailing)
+	ServicerLivenessJailDuration  time.Duration `json:"servicer_liveness_jail_duration" yaml:"servicer_liveness_jail_duration"`   // minimum amount of time a servicer must spend in jail after being reported unreachable
+	// fee params
+	MessageFees MessageFees `json:"message_fees" yaml:"message_fees"` // the fees of the messages of the module (in uPOKT)
 }
 
 // Implements sdk.ParamSet
@@ -81,25 +90,31 @@ func (p *Params) ParamSetPairs() sdk.ParamSetPairs {
 		{Key: KeySessionBlock, Value: &p.SessionBlockFrequency},
 		{Key: KeyDAOAllocation, Value: &p.DAOAllocation},
 		{Key: KeyProposerAllocation, Value: &p.ProposerAllocation},
+		{Key: KeyServicerLivenessJailThreshold, Value: &p.ServicerLivenessJailThreshold},
+		{Key: KeyServicerLivenessJailDuration, Value: &p.ServicerLivenessJailDuration},
+		{Key: KeyMessageFees, Value: &p.MessageFees},
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		UnstakingTime:           DefaultUnstakingTime,
-		MaxValidators:           DefaultMaxValidators,
-		StakeMinimum:            DefaultMinStake,
-		StakeDenom:              sdk.DefaultStakeDenom,
-		MaxEvidenceAge:          DefaultMaxEvidenceAge,
-		SignedBlocksWindow:      DefaultSignedBlocksWindow,
-		MinSignedPerWindow:      DefaultMinSignedPerWindow,
-		DowntimeJailDuration:    DefaultDowntimeJailDuration,
-		SlashFractionDoubleSign: DefaultSlashFractionDoubleSign,
-		SlashFractionDowntime:   DefaultSlashFractionDowntime,
-		SessionBlockFrequency:   DefaultSessionBlocktime,
-		DAOAllocation:           DefaultDAOAllocation,
-		ProposerAllocation:      DefaultProposerAllocation,
+		UnstakingTime:                 DefaultUnstakingTime,
+		MaxValidators:                 DefaultMaxValidators,
+		StakeMinimum:                  DefaultMinStake,
+		StakeDenom:                    sdk.DefaultStakeDenom,
+		MaxEvidenceAge:                DefaultMaxEvidenceAge,
+		SignedBlocksWindow:            DefaultSignedBlocksWindow,
+		MinSignedPerWindow:            DefaultMinSignedPerWindow,
+		DowntimeJailDuration:          DefaultDowntimeJailDuration,
+		SlashFractionDoubleSign:       DefaultSlashFractionDoubleSign,
+		SlashFractionDowntime:         DefaultSlashFractionDowntime,
+		SessionBlockFrequency:         DefaultSessionBlocktime,
+		DAOAllocation:                 DefaultDAOAllocation,
+		ProposerAllocation:            DefaultProposerAllocation,
+		ServicerLivenessJailThreshold: DefaultServicerLivenessJailThreshold,
+		ServicerLivenessJailDuration:  DefaultServicerLivenessJailDuration,
+		MessageFees:                   append(MessageFees(nil), DefaultMessageFees...),
 	}
 }
 
@@ -126,6 +141,15 @@ func (p Params) Validate() error {
 	if p.ProposerAllocation+p.DAOAllocation > 100 {
 		return fmt.Errorf("the combo of proposer allocation and dao allocation mnust not be greater than 100")
 	}
+	if p.ServicerLivenessJailThreshold < 0 {
+		return fmt.Errorf("the servicer liveness jail threshold must not be negative")
+	}
+	if p.ServicerLivenessJailDuration < 0 {
+		return fmt.Errorf("the servicer liveness jail duration must not be negative")
+	}
+	if err := p.MessageFees.Validate(DefaultMessageFees); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -151,7 +175,10 @@ func (p Params) String() string {
   SlashFractionDowntime:   %s
   BlocksPerSession    %d
   Proposer Allocation      %d
-  DAO allocation           %d`,
+  DAO allocation           %d
+  ServicerLivenessJailThreshold %d
+  ServicerLivenessJailDuration  %s
+  MessageFees              %s`,
 		p.UnstakingTime,
 		p.MaxValidators,
 		p.StakeDenom,
@@ -164,7 +191,10 @@ func (p Params) String() string {
 		p.SlashFractionDowntime,
 		p.SessionBlockFrequency,
 		p.ProposerAllocation,
-		p.DAOAllocation)
+		p.DAOAllocation,
+		p.ServicerLivenessJailThreshold,
+		p.ServicerLivenessJailDuration,
+		p.MessageFees)
 }
 
 // unmarshal the current pos params value from store key or panic
@@ -17,19 +17,22 @@ func TestDefaultParams(t *testing.T) {
 	}{
 		{"Default Test",
 			Params{
-				UnstakingTime:           DefaultUnstakingTime,
-				MaxValidators:           DefaultMaxValidators,
-				StakeMinimum:            DefaultMinStake,
-				StakeDenom:              types.DefaultStakeDenom,
-				MaxEvidenceAge:          DefaultMaxEvidenceAge,
-				SignedBlocksWindow:      DefaultSignedBlocksWindow,
-				MinSignedPerWindow:      DefaultMinSignedPerWindow,
-				DowntimeJailDuration:    DefaultDowntimeJailDuration,
-				SlashFractionDoubleSign: DefaultSlashFractionDoubleSign,
-				SlashFractionDowntime:   DefaultSlashFractionDowntime,
-				SessionBlockFrequency:   DefaultSessionBlocktime,
-				DAOAllocation:           DefaultDAOAllocation,
-				ProposerAllocation:      DefaultProposerAllocation,
+				UnstakingTime:                 DefaultUnstakingTime,
+				MaxValidators:                 DefaultMaxValidators,
+				StakeMinimum:                  DefaultMinStake,
+				StakeDenom:                    types.DefaultStakeDenom,
+				MaxEvidenceAge:                DefaultMaxEvidenceAge,
+				SignedBlocksWindow:            DefaultSignedBlocksWindow,
+				MinSignedPerWindow:            DefaultMinSignedPerWindow,
+				DowntimeJailDuration:          DefaultDowntimeJailDuration,
+				SlashFractionDoubleSign:       DefaultSlashFractionDoubleSign,
+				SlashFractionDowntime:         DefaultSlashFractionDowntime,
+				SessionBlockFrequency:         DefaultSessionBlocktime,
+				DAOAllocation:                 DefaultDAOAllocation,
+				ProposerAllocation:            DefaultProposerAllocation,
+				ServicerLivenessJailThreshold: DefaultServicerLivenessJailThreshold,
+				ServicerLivenessJailDuration:  DefaultServicerLivenessJailDuration,
+				MessageFees:                   DefaultMessageFees,
 			},
 		}}
 	for _, tt := range tests {
@@ -297,6 +300,7 @@ func TestParams_Validate(t *testing.T) {
 				DowntimeJailDuration:    tt.fields.DowntimeJailDuration,
 				SlashFractionDoubleSign: tt.fields.SlashFractionDoubleSign,
 				SlashFractionDowntime:   tt.fields.SlashFractionDowntime,
+				MessageFees:             DefaultMessageFees,
 			}
 			if err := p.Validate(); (err != nil) != tt.wantErr {
 				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
@@ -386,19 +390,21 @@ func TestParams_ParamSetPairs(t *testing.T) {
 
 func TestParams_String(t *testing.T) {
 	type fields struct {
-		UnstakingTime           time.Duration
-		MaxValidators           uint64
-		StakeDenom              string
-		StakeMinimum            int64
-		ProposerAllocation      int64
-		SessionBlockFrequency   int64
-		DaoAllocation           int64
-		MaxEvidenceAge          time.Duration
-		SignedBlocksWindow      int64
-		MinSignedPerWindow      types.Dec
-		DowntimeJailDuration    time.Duration
-		SlashFractionDoubleSign types.Dec
-		SlashFractionDowntime   types.Dec
+		UnstakingTime                 time.Duration
+		MaxValidators                 uint64
+		StakeDenom                    string
+		StakeMinimum                  int64
+		ProposerAllocation            int64
+		SessionBlockFrequency         int64
+		DaoAllocation                 int64
+		MaxEvidenceAge                time.Duration
+		SignedBlocksWindow            int64
+		MinSignedPerWindow            types.Dec
+		DowntimeJailDuration          time.Duration
+		SlashFractionDoubleSign       types.Dec
+		SlashFractionDowntime         types.Dec
+		ServicerLivenessJailThreshold int64
+		ServicerLivenessJailDuration  time.Duration
 	}
 	tests := []struct {
 		name   string
@@ -406,19 +412,21 @@ func TestParams_String(t *testing.T) {
 		want   string
 	}{
 		{"String Test", fields{
-			UnstakingTime:           DefaultUnstakingTime,
-			MaxValidators:           DefaultMaxValidators,
-			StakeMinimum:            DefaultMinStake,
-			StakeDenom:              types.DefaultStakeDenom,
-			ProposerAllocation:      DefaultProposerAllocation,
-			MaxEvidenceAge:          DefaultMaxEvidenceAge,
-			SignedBlocksWindow:      DefaultSignedBlocksWindow,
-			MinSignedPerWindow:      DefaultMinSignedPerWindow,
-			DowntimeJailDuration:    DefaultDowntimeJailDuration,
-			SlashFractionDoubleSign: DefaultSlashFractionDoubleSign,
-			SlashFractionDowntime:   DefaultSlashFractionDowntime,
-			SessionBlockFrequency:   DefaultSessionBlocktime,
-			DaoAllocation:           DefaultDAOAllocation,
+			UnstakingTime:                 DefaultUnstakingTime,
+			MaxValidators:                 DefaultMaxValidators,
+			StakeMinimum:                  DefaultMinStake,
+			StakeDenom:                    types.DefaultStakeDenom,
+			ProposerAllocation:            DefaultProposerAllocation,
+			MaxEvidenceAge:                DefaultMaxEvidenceAge,
+			SignedBlocksWindow:            DefaultSignedBlocksWindow,
+			MinSignedPerWindow:            DefaultMinSignedPerWindow,
+			DowntimeJailDuration:          DefaultDowntimeJailDuration,
+			SlashFractionDoubleSign:       DefaultSlashFractionDoubleSign,
+			SlashFractionDowntime:         DefaultSlashFractionDowntime,
+			SessionBlockFrequency:         DefaultSessionBlocktime,
+			DaoAllocation:                 DefaultDAOAllocation,
+			ServicerLivenessJailThreshold: DefaultServicerLivenessJailThreshold,
+			ServicerLivenessJailDuration:  DefaultServicerLivenessJailDuration,
 		}, fmt.Sprintf(`Params:
   Unstaking Time:          %s
   Max Validators:          %d
@@ -432,7 +440,10 @@ func TestParams_String(t *testing.T) {
   SlashFractionDowntime:   %s
   BlocksPerSession    %d
   Proposer Allocation      %d
-  DAO allocation           %d`,
+  DAO allocation           %d
+  ServicerLivenessJailThreshold %d
+  ServicerLivenessJailDuration  %s
+  MessageFees              %s`,
 			DefaultUnstakingTime,
 			DefaultMaxValidators,
 			types.DefaultStakeDenom,
@@ -445,24 +456,30 @@ func TestParams_String(t *testing.T) {
 			DefaultSlashFractionDowntime,
 			DefaultSessionBlocktime,
 			DefaultProposerAllocation,
-			DefaultDAOAllocation)},
+			DefaultDAOAllocation,
+			DefaultServicerLivenessJailThreshold,
+			DefaultServicerLivenessJailDuration,
+			DefaultMessageFees)},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := Params{
-				UnstakingTime:           tt.fields.UnstakingTime,
-				MaxValidators:           tt.fields.MaxValidators,
-				StakeDenom:              tt.fields.StakeDenom,
-				StakeMinimum:            tt.fields.StakeMinimum,
-				ProposerAllocation:      tt.fields.ProposerAllocation,
-				SessionBlockFrequency:   tt.fields.SessionBlockFrequency,
-				DAOAllocation:           tt.fields.DaoAllocation,
-				MaxEvidenceAge:          tt.fields.MaxEvidenceAge,
-				SignedBlocksWindow:      tt.fields.SignedBlocksWindow,
-				MinSignedPerWindow:      tt.fields.MinSignedPerWindow,
-				DowntimeJailDuration:    tt.fields.DowntimeJailDuration,
-				SlashFractionDoubleSign: tt.fields.SlashFractionDoubleSign,
-				SlashFractionDowntime:   tt.fields.SlashFractionDowntime,
+				UnstakingTime:                 tt.fields.UnstakingTime,
+				MaxValidators:                 tt.fields.MaxValidators,
+				StakeDenom:                    tt.fields.StakeDenom,
+				StakeMinimum:                  tt.fields.StakeMinimum,
+				ProposerAllocation:            tt.fields.ProposerAllocation,
+				SessionBlockFrequency:         tt.fields.SessionBlockFrequency,
+				DAOAllocation:                 tt.fields.DaoAllocation,
+				MaxEvidenceAge:                tt.fields.MaxEvidenceAge,
+				SignedBlocksWindow:            tt.fields.SignedBlocksWindow,
+				MinSignedPerWindow:            tt.fields.MinSignedPerWindow,
+				DowntimeJailDuration:          tt.fields.DowntimeJailDuration,
+				SlashFractionDoubleSign:       tt.fields.SlashFractionDoubleSign,
+				SlashFractionDowntime:         tt.fields.SlashFractionDowntime,
+				ServicerLivenessJailThreshold: tt.fields.ServicerLivenessJailThreshold,
+				ServicerLivenessJailDuration:  tt.fields.ServicerLivenessJailDuration,
+				MessageFees:                   DefaultMessageFees,
 			}
 			if got := p.String(); got != tt.want {
 				t.Errorf("String() = %v, want %v", got, tt.want)
@@ -532,3 +549,34 @@ func TestMustUnmarshalParams(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ChangeParam(t *testing.T) {
+	fees := append(MessageFees(nil), DefaultMessageFees...)
+	fees[0].Fee = 1
+	feesJSON := string(ModuleCdc.MustMarshalJSON(fees))
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		want    interface{}
+		wantErr bool
+	}{
+		{"changes an int64 param", string(KeyStakeMinimum), `"2000000"`, int64(2000000), false},
+		{"changes a dec param", string(KeySlashFractionDowntime), `"0.5"`, types.NewDecWithPrec(5, 1), false},
+		{"changes the message fees", string(KeyMessageFees), feesJSON, fees, false},
+		{"errors if the params are invalid", string(KeySessionBlock), `"0"`, nil, true},
+		{"errors if the value is not of the param type", string(KeyStakeMinimum), `"abc"`, nil, true},
+		{"errors if the param is unknown", "UnknownParam", `"1"`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, got, err := DefaultParams().ChangeParam(tt.key, []byte(tt.value))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ChangeParam() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChangeParam() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -6,15 +6,19 @@ import (
 
 // query endpoints supported by the staking Querier
 const (
-	QueryValidators     = "validators"
-	QueryValidator      = "validator"
-	QueryStakedPool     = "stakedPool"
-	QueryUnstakedPool   = "unstakedPool"
-	QueryParameters     = "parameters"
-	QuerySigningInfo    = "signingInfo"
-	QuerySigningInfos   = "signingInfos"
-	QueryAccountBalance = "account_balance"
-	QueryAccount        = "account"
+	QueryValidators           = "validators"
+	QueryValidator            = "validator"
+	QueryStakedPool           = "stakedPool"
+	QueryUnstakedPool         = "unstakedPool"
+	QueryParameters           = "parameters"
+	QuerySigningInfo          = "signingInfo"
+	QuerySigningInfos         = "signingInfos"
+	QueryAccountBalance       = "account_balance"
+	QueryAccount              = "account"
+	QueryValidatorDelegations = "validator_delegations"
+	QueryDelegatorDelegations = "delegator_delegations"
+	QueryUnbondingDelegations = "unbonding_delegations"
+	QueryParamChange          = "paramChange"
 )
 
 type QueryValidatorParams struct {
@@ -27,6 +31,12 @@ func NewQueryValidatorParams(validatorAddr sdk.Address) QueryValidatorParams {
 	}
 }
 
+// the param and amino json value of a simulated param change
+type QueryParamChangeParams struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type QueryValidatorsParams struct {
 	StakingStatus sdk.StakeStatus `json:"staking_status"`
 	JailedStatus  int             `json:"jailed_status"`
@@ -77,6 +87,16 @@ type QueryAccountParams struct {
 	sdk.Address
 }
 
+type QueryDelegatorParams struct {
+	Address sdk.Address
+}
+
+func NewQueryDelegatorParams(delegatorAddr sdk.Address) QueryDelegatorParams {
+	return QueryDelegatorParams{
+		Address: delegatorAddr,
+	}
+}
+
 type QueryUnstakingValidatorsParams struct {
 	Page, Limit int
 }
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// a servicer key rotated out by a validator, kept until the claims and proofs of the sessions it served are settled
+type RetiredServicerKey struct {
+	ServicerAddress  sdk.Address `json:"servicer_address" yaml:"servicer_address"`   // the address of the retired servicer key
+	ValidatorAddress sdk.Address `json:"validator_address" yaml:"validator_address"` // the validator that served with the key
+	RetiredHeight    int64       `json:"retired_height" yaml:"retired_height"`       // the height the key was rotated out
+}
+
+// Return human readable retired servicer key
+func (r RetiredServicerKey) String() string {
+	return fmt.Sprintf(`Retired Servicer Key:
+  Servicer Address:  %s
+  Validator Address: %s
+  Retired Height:    %d`,
+		r.ServicerAddress, r.ValidatorAddress, r.RetiredHeight)
+}
+
+type RetiredServicerKeys []RetiredServicerKey
+
+// Return human readable retired servicer keys
+func (rs RetiredServicerKeys) String() (out string) {
+	for _, r := range rs {
+		out += r.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
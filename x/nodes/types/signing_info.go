@@ -29,3 +29,21 @@ func (i ValidatorSigningInfo) String() string {
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
 		i.Tombstoned, i.MissedBlocksCounter)
 }
+
+// Liveness information of the service url of the validator, reported by the other validators probing it
+type ServicerLivenessInfo struct {
+	Address            sdk.Address   `json:"address" yaml:"address"`                           // validator address
+	SessionBlockHeight int64         `json:"session_block_height" yaml:"session_block_height"` // the session the reporters are counted in
+	Reporters          []sdk.Address `json:"reporters" yaml:"reporters"`                       // the validators that reported the servicer unreachable this session
+	FailureReports     int64         `json:"failure_reports" yaml:"failure_reports"`           // failure reports counter over the lifetime of the validator
+}
+
+// Return human readable servicer liveness info
+func (i ServicerLivenessInfo) String() string {
+	return fmt.Sprintf(`Servicer Liveness Info:
+  Address:               %s
+  Session Block Height:  %d
+  Session Reporters:     %d
+  Failure Reports:       %d`,
+		i.Address, i.SessionBlockHeight, len(i.Reporters), i.FailureReports)
+}
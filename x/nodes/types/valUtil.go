@@ -27,9 +27,9 @@ func (v Validators) String() (out string) {
 // String returns a human readable string representation of a validator.
 func (v Validator) String() string {
 	return fmt.Sprintf("Address:\t\t%s\nPublic Key:\t\t%s\nJailed:\t\t\t%v\nStatus:\t\t\t%s\nTokens:\t\t\t%s\n"+
-		"ServiceURL:\t\t%s\nChains:\t\t\t%v\nUnstaking Completion Time:\t\t%v"+
+		"ServiceURL:\t\t%s\nChains:\t\t\t%v\nUnstaking Completion Time:\t\t%v\nCommission Rate:\t\t%d%%\nOutput Address:\t\t%s\nServicer Public Key:\t\t%s"+
 		"\n----\n",
-		v.Address, v.PublicKey.RawString(), v.Jailed, v.Status, v.StakedTokens, v.ServiceURL, v.Chains, v.UnstakingCompletionTime,
+		v.Address, v.PublicKey.RawString(), v.Jailed, v.Status, v.StakedTokens, v.ServiceURL, v.Chains, v.UnstakingCompletionTime, v.CommissionRate, v.GetOutputAddress(), v.GetServicerPublicKey().RawString(),
 	)
 }
 
@@ -55,14 +55,17 @@ func UnmarshalValidator(cdc *codec.Codec, valBytes []byte) (validator Validator,
 
 // this is a helper struct used for JSON de- and encoding only
 type hexValidator struct {
-	Address                 sdk.Address     `json:"address" yaml:"address"`               // the hex address of the validator
-	PublicKey               string          `json:"public_key" yaml:"public_key"`         // the hex consensus public key of the validator
-	Jailed                  bool            `json:"jailed" yaml:"jailed"`                 // has the validator been jailed from staked status?
-	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                 // validator status (staked/unstaking/unstaked)
-	StakedTokens            sdk.Int         `json:"tokens" yaml:"tokens"`                 // how many staked tokens
-	ServiceURL              string          `json:"service_url" yaml:"service_url"`       // the url of the pocket-api
-	Chains                  []string        `json:"chains" yaml:"chains"`                 // the non-native (external) chains hosted
-	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the validator to complete unstaking
+	Address                 sdk.Address     `json:"address" yaml:"address"`                         // the hex address of the validator
+	PublicKey               string          `json:"public_key" yaml:"public_key"`                   // the hex consensus public key of the validator
+	Jailed                  bool            `json:"jailed" yaml:"jailed"`                           // has the validator been jailed from staked status?
+	Status                  sdk.StakeStatus `json:"status" yaml:"status"`                           // validator status (staked/unstaking/unstaked)
+	StakedTokens            sdk.Int         `json:"tokens" yaml:"tokens"`                           // how many staked tokens
+	ServiceURL              string          `json:"service_url" yaml:"service_url"`                 // the url of the pocket-api
+	Chains                  []string        `json:"chains" yaml:"chains"`                           // the non-native (external) chains hosted
+	UnstakingCompletionTime time.Time       `json:"unstaking_time" yaml:"unstaking_time"`           // if unstaking, min time for the validator to complete unstaking
+	CommissionRate          int64           `json:"commission_rate" yaml:"commission_rate"`         // the percentage of the delegator rewards kept by the validator
+	OutputAddress           sdk.Address     `json:"output_address" yaml:"output_address"`           // the address receiving the rewards and unstaked tokens
+	ServicerPublicKey       string          `json:"servicer_public_key" yaml:"servicer_public_key"` // the hex public key signing relays, claims and proofs
 }
 
 // Marshals struct into JSON
@@ -82,6 +85,9 @@ func (v Validator) MarshalJSON() ([]byte, error) {
 		Chains:                  v.Chains,
 		StakedTokens:            v.StakedTokens,
 		UnstakingCompletionTime: v.UnstakingCompletionTime,
+		CommissionRate:          v.CommissionRate,
+		OutputAddress:           v.OutputAddress,
+		ServicerPublicKey:       v.GetServicerPublicKey().RawString(),
 	})
 }
 
@@ -95,6 +101,18 @@ func (v *Validator) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
+	// keep an unset output address nil, so it defaults to the operator address
+	if bv.OutputAddress.Empty() {
+		bv.OutputAddress = nil
+	}
+	// keep a servicer key equal to the consensus key nil, so it defaults to the consensus key
+	var servicerPublicKey crypto.PublicKey
+	if bv.ServicerPublicKey != "" && bv.ServicerPublicKey != bv.PublicKey {
+		servicerPublicKey, err = crypto.NewPublicKey(bv.ServicerPublicKey)
+		if err != nil {
+			return err
+		}
+	}
 	*v = Validator{
 		Address:                 bv.Address,
 		PublicKey:               publicKey,
@@ -104,6 +122,9 @@ func (v *Validator) UnmarshalJSON(data []byte) error {
 		StakedTokens:            bv.StakedTokens,
 		Status:                  bv.Status,
 		UnstakingCompletionTime: bv.UnstakingCompletionTime,
+		CommissionRate:          bv.CommissionRate,
+		OutputAddress:           bv.OutputAddress,
+		ServicerPublicKey:       servicerPublicKey,
 	}
 	return nil
 }
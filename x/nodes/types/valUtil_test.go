@@ -77,9 +77,9 @@ func TestValidators_String(t *testing.T) {
 		wantOut string
 	}{
 		{"String Test", v, fmt.Sprintf("Address:\t\t%s\nPublic Key:\t\t%s\nJailed:\t\t\t%v\nStatus:\t\t\t%s\nTokens:\t\t\t%s\n"+
-			"ServiceURL:\t\t%s\nChains:\t\t\t%v\nUnstaking Completion Time:\t\t%v"+
+			"ServiceURL:\t\t%s\nChains:\t\t\t%v\nUnstaking Completion Time:\t\t%v\nCommission Rate:\t\t%d%%\nOutput Address:\t\t%s\nServicer Public Key:\t\t%s"+
 			"\n----",
-			sdk.Address(pub.Address()), pub.RawString(), false, sdk.Staked, sdk.ZeroInt(), "https://www.google.com:443", []string{"00"}, time.Unix(0, 0).UTC(),
+			sdk.Address(pub.Address()), pub.RawString(), false, sdk.Staked, sdk.ZeroInt(), "https://www.google.com:443", []string{"00"}, time.Unix(0, 0).UTC(), 0, sdk.Address(pub.Address()), pub.RawString(),
 		)},
 	}
 	for _, tt := range tests {
@@ -226,6 +226,36 @@ func TestValidator_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestValidator_ServicerPublicKeyJSON(t *testing.T) {
+	var pub, servicerPub crypto.Ed25519PublicKey
+	rand.Read(pub[:])
+	rand.Read(servicerPub[:])
+	v := Validator{
+		Address:      sdk.Address(pub.Address()),
+		PublicKey:    pub,
+		Status:       sdk.Staked,
+		Chains:       []string{"00"},
+		ServiceURL:   "https://www.pokt.network:443",
+		StakedTokens: sdk.ZeroInt(),
+	}
+	// without a servicer key the validator serves with its consensus key
+	bz, err := v.MarshalJSON()
+	assert.Nil(t, err)
+	var got Validator
+	assert.Nil(t, got.UnmarshalJSON(bz))
+	assert.Nil(t, got.ServicerPublicKey)
+	assert.True(t, got.GetServicerPublicKey().Equals(pub))
+	assert.Equal(t, v.Address, got.GetServicerAddress())
+	// with a servicer key
+	v.ServicerPublicKey = servicerPub
+	bz, err = v.MarshalJSON()
+	assert.Nil(t, err)
+	got = Validator{}
+	assert.Nil(t, got.UnmarshalJSON(bz))
+	assert.True(t, got.GetServicerPublicKey().Equals(servicerPub))
+	assert.Equal(t, sdk.Address(servicerPub.Address()), got.GetServicerAddress())
+}
+
 func TestValidateServiceURL(t *testing.T) {
 	validURL := "https://foo.bar:8080"
 	// missing prefix
@@ -14,14 +14,17 @@ import (
 )
 
 type Validator struct {
-	Address                 sdk.Address      `json:"address" yaml:"address"`               // address of the validator; hex encoded in JSON
-	PublicKey               crypto.PublicKey `json:"public_key" yaml:"public_key"`         // the consensus public key of the validator; hex encoded in JSON
-	Jailed                  bool             `json:"jailed" yaml:"jailed"`                 // has the validator been jailed from staked status?
-	Status                  sdk.StakeStatus  `json:"status" yaml:"status"`                 // validator status (staked/unstaking/unstaked)
-	Chains                  []string         `json:"chains" yaml:"chains"`                 // validator non native blockchains
-	ServiceURL              string           `json:"service_url" yaml:"service_url"`       // url where the pocket service api is hosted
-	StakedTokens            sdk.Int          `json:"tokens" yaml:"tokens"`                 // tokens staked in the network
-	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"` // if unstaking, min time for the validator to complete unstaking
+	Address                 sdk.Address      `json:"address" yaml:"address"`                         // address of the validator; hex encoded in JSON
+	PublicKey               crypto.PublicKey `json:"public_key" yaml:"public_key"`                   // the consensus public key of the validator; hex encoded in JSON
+	Jailed                  bool             `json:"jailed" yaml:"jailed"`                           // has the validator been jailed from staked status?
+	Status                  sdk.StakeStatus  `json:"status" yaml:"status"`                           // validator status (staked/unstaking/unstaked)
+	Chains                  []string         `json:"chains" yaml:"chains"`                           // validator non native blockchains
+	ServiceURL              string           `json:"service_url" yaml:"service_url"`                 // url where the pocket service api is hosted
+	StakedTokens            sdk.Int          `json:"tokens" yaml:"tokens"`                           // tokens staked in the network
+	UnstakingCompletionTime time.Time        `json:"unstaking_time" yaml:"unstaking_time"`           // if unstaking, min time for the validator to complete unstaking
+	CommissionRate          int64            `json:"commission_rate" yaml:"commission_rate"`         // the percentage of the delegator rewards kept by the validator
+	OutputAddress           sdk.Address      `json:"output_address" yaml:"output_address"`           // the address receiving the rewards and unstaked tokens of the validator
+	ServicerPublicKey       crypto.PublicKey `json:"servicer_public_key" yaml:"servicer_public_key"` // the key signing relays, claims and proofs, if separate from the consensus key
 }
 
 type ValidatorsPage struct {
@@ -120,3 +123,26 @@ func (v Validator) GetAddress() sdk.Address        { return v.Address }
 func (v Validator) GetPublicKey() crypto.PublicKey { return v.PublicKey }
 func (v Validator) GetTokens() sdk.Int             { return v.StakedTokens }
 func (v Validator) GetConsensusPower() int64       { return v.ConsensusPower() }
+
+// GetOutputAddress returns the address receiving the rewards and unstaked tokens of the validator
+// defaults to the operator address if no output address is set
+func (v Validator) GetOutputAddress() sdk.Address {
+	if v.OutputAddress.Empty() {
+		return v.Address
+	}
+	return v.OutputAddress
+}
+
+// GetServicerPublicKey returns the public key signing relays, claims and proofs of the validator
+// defaults to the consensus public key if no servicer key is registered
+func (v Validator) GetServicerPublicKey() crypto.PublicKey {
+	if v.ServicerPublicKey == nil {
+		return v.PublicKey
+	}
+	return v.ServicerPublicKey
+}
+
+// GetServicerAddress returns the address of the servicer key of the validator
+func (v Validator) GetServicerAddress() sdk.Address {
+	return sdk.Address(v.GetServicerPublicKey().Address())
+}
@@ -96,6 +96,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Ctx, nodesKeeper.Keeper,
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName:     nil,
 		appsTypes.StakedPoolName:  {auth.Burner, auth.Staking, auth.Minter},
+		appsTypes.EscrowPoolName:  nil,
 		nodesTypes.StakedPoolName: {auth.Burner, auth.Staking},
 		govTypes.DAOAccountName:   {auth.Burner, auth.Staking},
 	}
@@ -120,8 +121,9 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Ctx, nodesKeeper.Keeper,
 	appSubspace := sdk.NewSubspace(types.DefaultParamspace)
 	pocketSubspace := sdk.NewSubspace(types.DefaultParamspace)
 	ak := auth.NewKeeper(cdc, keyAcc, accSubspace, maccPerms)
-	nk := nodesKeeper.NewKeeper(cdc, nodesKey, ak, nodesSubspace, "pos")
-	appk := appsKeeper.NewKeeper(cdc, appsKey, nk, ak, appSubspace, appsTypes.ModuleName)
+	chainValidator := keep.NewChainValidator(pocketSubspace)
+	nk := nodesKeeper.NewKeeper(cdc, nodesKey, ak, chainValidator, nodesSubspace, "pos")
+	appk := appsKeeper.NewKeeper(cdc, appsKey, nk, ak, chainValidator, appSubspace, appsTypes.ModuleName)
 	keeper := keep.NewKeeper(pocketKey, cdc, nk, appk, &hb, pocketSubspace)
 	kb := NewTestKeybase()
 	_, err = kb.Create("test")
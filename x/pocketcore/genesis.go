@@ -15,14 +15,17 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, data types.GenesisState) []a
 	keeper.SetReceipts(ctx, data.Receipts)
 	// set the claim objects in store
 	keeper.SetClaims(ctx, data.Claims)
+	// set the app usage objects in store
+	keeper.SetAppUsages(ctx, data.AppUsages)
 	return []abci.ValidatorUpdate{}
 }
 
 // "ExportGenesis" - Exports the state in a genesis state object
 func ExportGenesis(ctx sdk.Ctx, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Params:   k.GetParams(ctx),
-		Receipts: k.GetAllReceipts(ctx),
-		Claims:   k.GetAllClaims(ctx),
+		Params:    k.GetParams(ctx),
+		Receipts:  k.GetAllReceipts(ctx),
+		Claims:    k.GetAllClaims(ctx),
+		AppUsages: k.GetAllAppUsages(ctx),
 	}
 }
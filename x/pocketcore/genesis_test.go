@@ -11,7 +11,7 @@ func TestInitExportGenesis(t *testing.T) {
 	p := types.Params{
 		SessionNodeCount:      10,
 		ClaimSubmissionWindow: 22,
-		SupportedBlockchains:  []string{"eth"},
+		ChainRegistry:         types.NewLegacyChainRegistry([]string{"eth"}),
 		ClaimExpiration:       55,
 	}
 	genesisState := types.GenesisState{
@@ -30,6 +30,8 @@ func handleClaimMsg(ctx sdk.Ctx, k keeper.Keeper, msg types.MsgClaim) sdk.Result
 	if err := k.ValidateClaim(ctx, msg); err != nil {
 		return err.Result()
 	}
+	// prepaid applications reserve the claimed relays in their escrow
+	k.ReserveClaimRelays(ctx, msg)
 	// set the claim in the world state
 	err := k.SetClaim(ctx, msg)
 	if err != nil {
@@ -40,6 +42,7 @@ func handleClaimMsg(ctx sdk.Ctx, k keeper.Keeper, msg types.MsgClaim) sdk.Result
 		sdk.NewEvent(
 			types.EventTypeClaim,
 			sdk.NewAttribute(types.AttributeKeyValidator, msg.FromAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyChain, msg.SessionHeader.Chain),
 		),
 	})
 	return sdk.Result{Events: ctx.EventManager().Events()}
@@ -76,6 +79,7 @@ func handleProofMsg(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof) sdk.Resu
 		sdk.NewEvent(
 			types.EventTypeProof,
 			sdk.NewAttribute(types.AttributeKeyValidator, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyChain, claim.SessionHeader.Chain),
 		),
 	})
 	return sdk.Result{Events: ctx.EventManager().Events()}
@@ -12,17 +12,43 @@ import (
 // a client public key hex string, a passphrase and a keybase. The contract is that the keybase contains the app pub key
 // and the passphrase corresponds to the app public key keypair.
 func AATGeneration(appPubKey string, clientPubKey string, passphrase string, keybase keys.Keybase) (pc.AAT, sdk.Error) {
-	// get the public key from string
-	pk, err := crypto.NewPublicKey(appPubKey)
-	if err != nil {
-		return pc.AAT{}, pc.NewPubKeyError(pc.ModuleName, err)
+	// create the aat object
+	aat := pc.AAT{
+		Version:              pc.AATVersion,
+		ApplicationPublicKey: appPubKey,
+		ClientPublicKey:      clientPubKey,
+		ApplicationSignature: "",
 	}
+	return signAAT(aat, passphrase, keybase)
+}
+
+// "ScopedAATGeneration" - Generates a scoped application authentication token, that expires at the expiration height
+// (0 = never), is only valid for the chains (empty = every app chain) and is limited to max relays per servicer per session
+// (0 = no token limit). The keybase and passphrase contract is the same as AATGeneration.
+func ScopedAATGeneration(appPubKey string, clientPubKey string, expirationHeight int64, chains []string, maxRelays int64, passphrase string, keybase keys.Keybase) (pc.AAT, sdk.Error) {
 	// create the aat object
 	aat := pc.AAT{
-		Version:              pc.SupportedTokenVersions[0],
+		Version:              pc.ScopedAATVersion,
 		ApplicationPublicKey: appPubKey,
 		ClientPublicKey:      clientPubKey,
 		ApplicationSignature: "",
+		ExpirationHeight:     expirationHeight,
+		Chains:               chains,
+		MaxRelays:            maxRelays,
+	}
+	// validate the fields before signing
+	if err := aat.ValidateMessage(); err != nil {
+		return pc.AAT{}, pc.NewInvalidTokenError(pc.ModuleName, err)
+	}
+	return signAAT(aat, passphrase, keybase)
+}
+
+// "signAAT" - Signs the aat with the application keypair in the keybase
+func signAAT(aat pc.AAT, passphrase string, keybase keys.Keybase) (pc.AAT, sdk.Error) {
+	// get the public key from string
+	pk, err := crypto.NewPublicKey(aat.ApplicationPublicKey)
+	if err != nil {
+		return pc.AAT{}, pc.NewPubKeyError(pc.ModuleName, err)
 	}
 	// marshal aat using json
 	sig, _, err := (keybase).Sign(sdk.Address(pk.Address()), passphrase, aat.Hash())
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -17,3 +18,19 @@ func TestAATGeneration(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.Nil(t, res.Validate())
 }
+
+func TestScopedAATGeneration(t *testing.T) {
+	passphrase := "test"
+	kb := NewTestKeybase()
+	kp, err := kb.Create(passphrase)
+	assert.Nil(t, err)
+	appPubKey := kp.PublicKey
+	res, err := ScopedAATGeneration(appPubKey.RawString(),
+		appPubKey.RawString(), 10, []string{"0001"}, 5, passphrase, kb)
+	assert.Nil(t, err)
+	assert.Equal(t, pc.ScopedAATVersion, res.Version)
+	assert.Nil(t, res.Validate())
+	_, err = ScopedAATGeneration(appPubKey.RawString(),
+		appPubKey.RawString(), -1, nil, 0, passphrase, kb)
+	assert.NotNil(t, err)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"github.com/pokt-network/pocket-core/x/apps/exported"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 )
@@ -24,3 +25,12 @@ func (k Keeper) GetAppFromPublicKey(ctx sdk.Ctx, pubKey string) (app exported.Ap
 	}
 	return k.GetApp(ctx, sdk.Address(pk.Address()))
 }
+
+// "IsTokenRevoked" - Returns true if the application revoked the tokens of the client public key of the aat
+func (k Keeper) IsTokenRevoked(ctx sdk.Ctx, aat pc.AAT) bool {
+	pk, err := crypto.NewPublicKey(aat.ApplicationPublicKey)
+	if err != nil {
+		return false
+	}
+	return k.appKeeper.IsAATRevoked(ctx, sdk.Address(pk.Address()), aat.ClientPublicKey)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -25,3 +27,16 @@ func TestGetAppFromPublicKey(t *testing.T) {
 	a, found = keeper.GetAppFromPublicKey(ctx, randomPubKey)
 	assert.False(t, found)
 }
+
+func TestIsTokenRevoked(t *testing.T) {
+	ctx, _, apps, _, keeper, _ := createTestInput(t, false)
+	ak := keeper.appKeeper.(appsKeeper.Keeper)
+	aat := pc.AAT{
+		Version:              pc.AATVersion,
+		ApplicationPublicKey: apps[0].PublicKey.RawString(),
+		ClientPublicKey:      getRandomPubKey().RawString(),
+	}
+	assert.False(t, keeper.IsTokenRevoked(ctx, aat))
+	assert.Nil(t, ak.RevokeAAT(ctx, apps[0].Address, aat.ClientPublicKey))
+	assert.True(t, keeper.IsTokenRevoked(ctx, aat))
+}
@@ -2,9 +2,70 @@ package keeper
 
 import (
 	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
 )
 
 // "GetHostedBlockchains" returns the non native chains hosted locally on this node
 func (k Keeper) GetHostedBlockchains() *pc.HostedBlockchains {
 	return k.hostedBlockchains
 }
+
+// "GetRegisteredBlockchain" - Returns the chain registry entry for the network identifier
+func (k Keeper) GetRegisteredBlockchain(ctx sdk.Ctx, chain string) (blockchain pc.Blockchain, found bool) {
+	return k.ChainRegistry(ctx).Get(chain)
+}
+
+// "ValidateStakingChains" - Returns an error if any of the chains are not pocket supported or deprecated
+func (k Keeper) ValidateStakingChains(ctx sdk.Ctx, chains []string) sdk.Error {
+	return NewChainValidator(k.Paramstore).ValidateStakingChains(ctx, chains)
+}
+
+// "ChainValidator" - Validates the chains of the stake messages against the chain registry
+// Only depends on the pocketcore params, so the nodes and apps keepers (which the pocketcore keeper depends on)
+// are created with it
+type ChainValidator struct {
+	paramstore sdk.Subspace
+}
+
+// "NewChainValidator" - Returns a chain validator reading the chain registry of the pocketcore paramstore
+func NewChainValidator(paramstore sdk.Subspace) ChainValidator {
+	return ChainValidator{paramstore: paramstore}
+}
+
+// "ValidateStakingChains" - Returns an error if any of the chains are not pocket supported or deprecated
+// Used by the nodes and apps modules to validate the chains of a stake message
+func (cv ChainValidator) ValidateStakingChains(ctx sdk.Ctx, chains []string) sdk.Error {
+	registry := chainRegistry(ctx, cv.paramstore)
+	for _, chain := range chains {
+		blockchain, found := registry.Get(chain)
+		// ensure the chain is supported by pocket network
+		if !found {
+			return pc.NewChainNotSupportedErr(pc.ModuleName)
+		}
+		// ensure the chain is not deprecated in the chain registry
+		if blockchain.Deprecated {
+			return pc.NewDeprecatedBlockchainError(pc.ModuleName)
+		}
+	}
+	return nil
+}
+
+// "ValidateLivenessProbe" - Returns an error if the probe of a liveness report is not a relay signed by the reporter
+// to a registered chain of the servicer in the session
+// Used by the nodes module to validate the evidence of a liveness report
+func (cv ChainValidator) ValidateLivenessProbe(ctx sdk.Ctx, probe []byte, reporter sdk.Address, servicerPubKey string, servicerChains []string, sessionBlockHeight int64) sdk.Error {
+	relay, err := pc.ValidateLivenessProbe(probe, reporter, servicerPubKey, servicerChains, sessionBlockHeight)
+	if err != nil {
+		return err
+	}
+	return cv.ValidateStakingChains(ctx, []string{relay.Proof.Blockchain})
+}
+
+// "ClaimSessions" - Returns the # of sessions the claims and proofs of a session can be sent for, after the session
+// Used by the nodes module to keep the retired servicer keys until their claims are proven or expired
+func (cv ChainValidator) ClaimSessions(ctx sdk.Ctx) int64 {
+	var submissionWindow, expiration int64
+	cv.paramstore.Get(ctx, pc.KeyClaimSubmissionWindow, &submissionWindow)
+	cv.paramstore.Get(ctx, pc.KeyClaimExpiration, &expiration)
+	return submissionWindow + expiration
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -24,3 +26,67 @@ func TestKeeper_GetHostedBlockchains(t *testing.T) {
 	assert.True(t, hb.Contains(eth.ID))
 	assert.False(t, hb.Contains(btc.ID))
 }
+
+func TestKeeper_ValidateStakingChains(t *testing.T) {
+	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	supported := getTestSupportedBlockchain()
+	unsupported := hex.EncodeToString([]byte{0xFF, 0xFF})
+	assert.Nil(t, keeper.ValidateStakingChains(ctx, []string{supported}))
+	assert.NotNil(t, keeper.ValidateStakingChains(ctx, []string{supported, unsupported}))
+	// deprecate the supported chain in the registry
+	p := keeper.GetParams(ctx)
+	p.ChainRegistry = types.ChainRegistry{{ID: supported, Name: "test", Type: types.JSONRPCBlockchainType, Deprecated: true}}
+	keeper.SetParams(ctx, p)
+	blockchain, found := keeper.GetRegisteredBlockchain(ctx, supported)
+	assert.True(t, found)
+	assert.True(t, blockchain.Deprecated)
+	assert.NotNil(t, keeper.ValidateStakingChains(ctx, []string{supported}))
+}
+
+func TestKeeper_ChainRegistryMigration(t *testing.T) {
+	ctx, _, _, _, _, _ := createTestInput(t, false)
+	// a state from before the chain registry only has the supported blockchains param
+	paramstore := sdk.NewSubspace("legacy").WithKeyTable(sdk.NewKeyTable().RegisterType(types.KeySupportedBlockchains, []string{}))
+	paramstore.Set(ctx, types.KeySupportedBlockchains, []string{getTestSupportedBlockchain()})
+	registry := chainRegistry(ctx, paramstore)
+	assert.Equal(t, types.NewLegacyChainRegistry([]string{getTestSupportedBlockchain()}), registry)
+	assert.Nil(t, NewChainValidator(paramstore).ValidateStakingChains(ctx, []string{getTestSupportedBlockchain()}))
+	assert.NotNil(t, NewChainValidator(paramstore).ValidateStakingChains(ctx, []string{hex.EncodeToString([]byte{0xFF, 0xFF})}))
+}
+
+func TestChainValidator_ValidateLivenessProbe(t *testing.T) {
+	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	cv := NewChainValidator(keeper.Paramstore)
+	proberPrivateKey := getRandomPrivateKey()
+	servicerPubKey := getRandomPrivateKey().PublicKey().RawString()
+	newProbe := func(chain string) []byte {
+		relay := types.Relay{
+			Payload: types.Payload{Data: types.ProbePayload},
+			Proof: types.RelayProof{
+				SessionBlockHeight: 1,
+				ServicerPubKey:     servicerPubKey,
+				Blockchain:         chain,
+				Token: types.AAT{
+					Version:              "0.0.1",
+					ApplicationPublicKey: proberPrivateKey.PublicKey().RawString(),
+					ClientPublicKey:      proberPrivateKey.PublicKey().RawString(),
+				},
+			},
+		}
+		relay.Proof.RequestHash = relay.RequestHashString()
+		sig, _ := proberPrivateKey.Sign(relay.Proof.Token.Hash())
+		relay.Proof.Token.ApplicationSignature = hex.EncodeToString(sig)
+		sig, _ = proberPrivateKey.Sign(relay.Proof.Hash())
+		relay.Proof.Signature = hex.EncodeToString(sig)
+		bz, _ := json.Marshal(relay)
+		return bz
+	}
+	reporter := sdk.Address(proberPrivateKey.PublicKey().Address())
+	supported := getTestSupportedBlockchain()
+	unsupported := hex.EncodeToString([]byte{0xFF, 0xFF})
+	assert.Nil(t, cv.ValidateLivenessProbe(ctx, newProbe(supported), reporter, servicerPubKey, []string{supported}, 1))
+	// the chain must be in the chain registry
+	assert.NotNil(t, cv.ValidateLivenessProbe(ctx, newProbe(unsupported), reporter, servicerPubKey, []string{unsupported}, 1))
+	// the probe must be signed by the reporter
+	assert.NotNil(t, cv.ValidateLivenessProbe(ctx, newProbe(supported), getRandomValidatorAddress(), servicerPubKey, []string{supported}, 1))
+}
@@ -14,7 +14,7 @@ import (
 // "SendClaimTx" - Automatically sends a claim of work/challenge based on relays or challenges stored.
 func (k Keeper) SendClaimTx(ctx sdk.Ctx, n client.Client, keybase keys.Keybase, claimTx func(pk crypto.PrivateKey, cliCtx util.CLIContext, txBuilder auth.TxBuilder, header pc.SessionHeader, totalProofs int64, root pc.HashSum, evidenceType pc.EvidenceType) (*sdk.TxResponse, error)) {
 	// get the private val key (main) account from the keybase
-	kp, err := k.GetPKFromFile(ctx)
+	kp, err := k.GetSignerKey(ctx)
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the private key from file for the claim transaction:\n%s", err.Error()))
 		return
@@ -89,7 +89,8 @@ func (k Keeper) ValidateClaim(ctx sdk.Ctx, claim pc.MsgClaim) (err sdk.Error) {
 		return pc.NewChainNotSupportedErr(pc.ModuleName)
 	}
 	// get the node from the keeper (at the state of the start of the session)
-	node, found := k.GetNode(sessionContext, claim.FromAddress)
+	// the claim is sent from the current servicer key of the node
+	node, found := k.GetNode(sessionContext, k.GetValidatorAddress(ctx, claim.FromAddress))
 	// if not found return not found error
 	if !found {
 		return pc.NewNodeNotFoundErr(pc.ModuleName)
@@ -127,6 +128,17 @@ func (k Keeper) ValidateClaim(ctx sdk.Ctx, claim pc.MsgClaim) (err sdk.Error) {
 	if k.ClaimIsMature(ctx, claim.SessionBlockHeight) {
 		return pc.NewExpiredProofsSubmissionError(pc.ModuleName)
 	}
+	// the node only claims the session once, even across a rotation of its servicer key
+	for _, addr := range k.GetServicerAddresses(ctx, claim.FromAddress) {
+		if addr.Equals(claim.FromAddress) {
+			continue
+		}
+		_, claimed := k.GetClaim(ctx, addr, claim.SessionHeader, claim.EvidenceType)
+		_, proven := k.GetReceipt(ctx, addr, claim.SessionHeader, claim.EvidenceType)
+		if claimed || proven {
+			return pc.NewDuplicateClaimError(pc.ModuleName)
+		}
+	}
 	return nil
 }
 
@@ -154,6 +166,31 @@ func (k Keeper) SetClaim(ctx sdk.Ctx, msg pc.MsgClaim) error {
 	return nil
 }
 
+// "ReserveClaimRelays" - Reserves the relays of a claim in the escrow of a prepaid application until the claim is proven or expires
+// A claim that replaces an earlier claim of the session releases the relays of the earlier claim
+func (k Keeper) ReserveClaimRelays(ctx sdk.Ctx, msg pc.MsgClaim) {
+	if msg.EvidenceType != pc.RelayEvidence {
+		return
+	}
+	appAddr, err := claimAppAddress(msg)
+	if err != nil {
+		return
+	}
+	if prev, found := k.GetClaim(ctx, msg.FromAddress, msg.SessionHeader, msg.EvidenceType); found {
+		k.appKeeper.ReleaseEscrowRelays(ctx, appAddr, prev.TotalProofs)
+	}
+	k.appKeeper.ReserveEscrowRelays(ctx, appAddr, msg.TotalProofs)
+}
+
+// "claimAppAddress" - Returns the address of the application of a claim
+func claimAppAddress(msg pc.MsgClaim) (sdk.Address, error) {
+	pk, err := crypto.NewPublicKey(msg.ApplicationPubKey)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.Address(pk.Address()), nil
+}
+
 // "GetClaim" - Retrieves the claim message from the store, requires the evidence type and header to return the proper claim message
 func (k Keeper) GetClaim(ctx sdk.Ctx, address sdk.Address, header pc.SessionHeader, evidenceType pc.EvidenceType) (msg pc.MsgClaim, found bool) {
 	// retrieve the store
@@ -278,6 +315,12 @@ func (k Keeper) DeleteExpiredClaims(ctx sdk.Ctx) {
 		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &msg)
 		// if more sessions has passed than the expiration of the claim's genesis, delete it from the set
 		if msg.ExpirationHeight <= ctx.BlockHeight() {
+			// the relays of an unproven claim are released from the escrow of a prepaid application
+			if msg.EvidenceType == pc.RelayEvidence {
+				if appAddr, err := claimAppAddress(msg); err == nil {
+					k.appKeeper.ReleaseEscrowRelays(ctx, appAddr, msg.TotalProofs)
+				}
+			}
 			store.Delete(iterator.Key())
 		}
 	}
@@ -122,6 +122,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Ctx, []nodesTypes.Valida
 	maccPerms := map[string][]string{
 		auth.FeeCollectorName:     nil,
 		appsTypes.StakedPoolName:  {auth.Burner, auth.Staking, auth.Minter},
+		appsTypes.EscrowPoolName:  nil,
 		nodesTypes.StakedPoolName: {auth.Burner, auth.Staking},
 		govTypes.DAOAccountName:   {auth.Burner, auth.Staking},
 	}
@@ -145,8 +146,9 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Ctx, []nodesTypes.Valida
 	appSubspace := sdk.NewSubspace(appsTypes.DefaultParamspace)
 	pocketSubspace := sdk.NewSubspace(types.DefaultParamspace)
 	ak := auth.NewKeeper(cdc, keyAcc, authSubspace, maccPerms)
-	nk := nodesKeeper.NewKeeper(cdc, nodesKey, ak, nodesSubspace, nodesTypes.ModuleName)
-	appk := appsKeeper.NewKeeper(cdc, appsKey, nk, ak, appSubspace, appsTypes.ModuleName)
+	chainValidator := NewChainValidator(pocketSubspace)
+	nk := nodesKeeper.NewKeeper(cdc, nodesKey, ak, chainValidator, nodesSubspace, nodesTypes.ModuleName)
+	appk := appsKeeper.NewKeeper(cdc, appsKey, nk, ak, chainValidator, appSubspace, appsTypes.ModuleName)
 	appk.SetApplication(ctx, getTestApplication())
 	keeper := NewKeeper(pocketKey, cdc, nk, appk, &hb, pocketSubspace)
 	assert.Nil(t, err)
@@ -165,7 +167,7 @@ func createTestInput(t *testing.T, isCheckTx bool) (sdk.Ctx, []nodesTypes.Valida
 	appk.SetParams(ctx, appsTypes.DefaultParams())
 	nk.SetParams(ctx, nodesTypes.DefaultParams())
 	defaultPocketParams := types.DefaultParams()
-	defaultPocketParams.SupportedBlockchains = []string{getTestSupportedBlockchain()}
+	defaultPocketParams.ChainRegistry = types.NewLegacyChainRegistry([]string{getTestSupportedBlockchain()})
 	keeper.SetParams(ctx, defaultPocketParams)
 	types.InitCache("data", "data", dbm.MemDBBackend, dbm.MemDBBackend, 100, 100)
 	return ctx, vals, ap, accs, keeper, keys
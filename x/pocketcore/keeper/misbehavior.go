@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	appexported "github.com/pokt-network/pocket-core/x/apps/exported"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// "StoreAppMisbehavior" - Stores a proof of the misbehavior of the client of an application, if the relay validation error
+// is attributable to the application; the misbehavior evidence is claimed and proven like relays to jail the application
+// Only the over service of an authorized client is attributable: a duplicate relay can be replayed by anyone, a relay
+// with an invalid aat was never authorized by the application and a challenge proves the misbehavior of a servicer
+// (see doc/application-auth-token.md)
+func (k Keeper) StoreAppMisbehavior(ctx sdk.Ctx, relay pc.Relay, selfAddr sdk.Address, app appexported.ApplicationI, sessionBlockHeight int64, sessionNodeCount int, relayErr sdk.Error) {
+	if relayErr.Codespace() != pc.ModuleName || relayErr.Code() != pc.CodeOverServiceError {
+		return
+	}
+	misbehavior := pc.OverServiceMisbehavior
+	proof := pc.AppMisbehaviorProof{
+		RelayProof:      relay.Proof,
+		Misbehavior:     misbehavior,
+		ReporterAddress: selfAddr,
+	}
+	// the misbehavior must be provable on chain
+	if err := proof.Validate(app.GetChains(), sessionNodeCount, sessionBlockHeight); err != nil {
+		return
+	}
+	header := proof.SessionHeader()
+	if header.ApplicationPubKey != app.GetPublicKey().RawString() || !pc.IsUniqueProof(header, proof) {
+		return
+	}
+	// the misbehavior evidence is capped like the relay evidence
+	if pc.GetTotalProofs(header, pc.MisbehaviorEvidence) >= sessionRelayAllowance(app, sessionNodeCount) {
+		return
+	}
+	ctx.Logger().Info(fmt.Sprintf("storing the proof of misbehavior %d of application %s", misbehavior, app.GetAddress()))
+	proof.Store()
+}
+
+// "sessionRelayAllowance" - Returns the max relays of the application a servicer serves on a chain in a session
+func sessionRelayAllowance(app appexported.ApplicationI, sessionNodeCount int) int64 {
+	return int64(math.Ceil(float64(app.GetMaxRelays().Int64())/float64(len(app.GetChains()))) / float64(sessionNodeCount))
+}
+
+// "ValidateMisbehaviorClaim" - Returns an error if the reporter of the over service misbehavior did not prove relays
+// for the full allowance of the session, so the over service is provable on chain
+func (k Keeper) ValidateMisbehaviorClaim(ctx sdk.Ctx, reporter sdk.Address, header pc.SessionHeader, app appexported.ApplicationI, sessionNodeCount int) sdk.Error {
+	receipt, found := k.GetReceipt(ctx, reporter, header, pc.RelayEvidence)
+	if !found || receipt.Total < sessionRelayAllowance(app, sessionNodeCount) {
+		return pc.NewInvalidMisbehaviorProofError(pc.ModuleName)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_ValidateMisbehaviorClaim(t *testing.T) {
+	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	app := getTestApplication()
+	reporter := sdk.Address(getRandomPubKey().Address())
+	header := types.SessionHeader{
+		ApplicationPubKey:  app.PublicKey.RawString(),
+		Chain:              getTestSupportedBlockchain(),
+		SessionBlockHeight: 976,
+	}
+	sessionNodeCount := 5
+	allowance := sessionRelayAllowance(app, sessionNodeCount)
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	mockCtx.On("Logger").Return(ctx.Logger())
+	// the reporter did not prove any relay of the session
+	assert.NotNil(t, keeper.ValidateMisbehaviorClaim(mockCtx, reporter, header, app, sessionNodeCount))
+	receipt := types.Receipt{
+		SessionHeader:   header,
+		ServicerAddress: reporter.String(),
+		Total:           allowance - 1,
+		EvidenceType:    types.RelayEvidence,
+	}
+	// the reporter did not serve the full allowance of the session
+	assert.Nil(t, keeper.SetReceipt(mockCtx, reporter, receipt))
+	assert.NotNil(t, keeper.ValidateMisbehaviorClaim(mockCtx, reporter, header, app, sessionNodeCount))
+	receipt.Total = allowance
+	assert.Nil(t, keeper.SetReceipt(mockCtx, reporter, receipt))
+	assert.Nil(t, keeper.ValidateMisbehaviorClaim(mockCtx, reporter, header, app, sessionNodeCount))
+}
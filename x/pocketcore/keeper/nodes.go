@@ -21,16 +21,48 @@ func (k Keeper) GetNode(ctx sdk.Ctx, address sdk.Address) (n exported.ValidatorI
 	return n, true
 }
 
-// "GetSelfNode" - Gets self node (private val key) from the world state
+// "GetNodeByServicerAddress" - Gets the node serving with the servicer key of the address from the state storage
+func (k Keeper) GetNodeByServicerAddress(ctx sdk.Ctx, servicerAddr sdk.Address) (n exported.ValidatorI, found bool) {
+	n = k.posKeeper.ValidatorByServicerAddress(ctx, servicerAddr)
+	if n == nil {
+		return n, false
+	}
+	return n, true
+}
+
+// "GetValidatorAddress" - Maps the address of a servicer key to the address of the node serving (or that served) with it,
+// falls back to the address itself when no node serves with it
+func (k Keeper) GetValidatorAddress(ctx sdk.Ctx, servicerAddr sdk.Address) sdk.Address {
+	if n, found := k.GetNodeByServicerAddress(ctx, servicerAddr); found {
+		return n.GetAddress()
+	}
+	// the claims of the sessions served before a rotation are held by the retired servicer key
+	if n := k.posKeeper.ValidatorByRetiredServicerAddress(ctx, servicerAddr); n != nil {
+		return n.GetAddress()
+	}
+	return servicerAddr
+}
+
+// "GetServicerAddresses" - Returns the addresses of every servicer key that may hold the claims of the node serving
+// (or that served) with the servicer key of the address: the current servicer key and the retired ones
+func (k Keeper) GetServicerAddresses(ctx sdk.Ctx, servicerAddr sdk.Address) []sdk.Address {
+	n, found := k.GetNode(ctx, k.GetValidatorAddress(ctx, servicerAddr))
+	if !found {
+		return []sdk.Address{servicerAddr}
+	}
+	return append([]sdk.Address{n.GetServicerAddress()}, k.posKeeper.GetRetiredServicerAddresses(ctx, n.GetAddress())...)
+}
+
+// "GetSelfNode" - Gets self node (servicer key) from the world state
 func (k Keeper) GetSelfNode(ctx sdk.Ctx) (node exported.ValidatorI, er sdk.Error) {
 	// get the Keybase address list
-	kp, err := k.GetPKFromFile(ctx)
+	kp, err := k.GetSignerKey(ctx)
 	if err != nil {
 		er = pc.NewKeybaseError(pc.ModuleName, err)
 		return nil, er
 	}
 	// get the node from the world state
-	self, found := k.GetNode(ctx, sdk.Address(kp.PublicKey().Address()))
+	self, found := k.GetNodeByServicerAddress(ctx, sdk.Address(kp.PublicKey().Address()))
 	if !found {
 		er = pc.NewSelfNotFoundError(pc.ModuleName)
 		return nil, er
@@ -43,6 +75,15 @@ func (k Keeper) AwardCoinsForRelays(ctx sdk.Ctx, relays int64, toAddr sdk.Addres
 	k.posKeeper.RewardForRelays(ctx, sdk.NewInt(relays), toAddr)
 }
 
+// "PayCoinsFromEscrow" - Pays a servicer for relays from the escrow of a prepaid application in the apps module
+// The payment is distributed by the nodes module like a relay reward (dao, proposer and delegator shares included)
+func (k Keeper) PayCoinsFromEscrow(ctx sdk.Ctx, appAddr sdk.Address, relays int64, toAddr sdk.Address) {
+	payment := k.appKeeper.PayRelaysFromEscrow(ctx, appAddr, toAddr, relays)
+	if payment.IsPositive() {
+		k.posKeeper.RewardForPrepaidRelays(ctx, payment, toAddr)
+	}
+}
+
 // "BurnCoinsForChallenges" - Executes the burn for challenge function in the nodes module
 func (k Keeper) BurnCoinsForChallenges(ctx sdk.Ctx, relays int64, toAddr sdk.Address) {
 	k.posKeeper.BurnForChallenge(ctx, sdk.NewInt(relays), toAddr)
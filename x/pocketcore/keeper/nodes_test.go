@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"testing"
+
+	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_GetSelfNode_ServicerKey(t *testing.T) {
+	ctx, vals, _, _, keeper, _ := createTestInput(t, false)
+	selfVal := vals[len(vals)-1]
+	// without a servicer key the node serves with its consensus key
+	self, err := keeper.GetSelfNode(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, selfVal.Address, self.GetAddress())
+	// register a servicer key for the node
+	servicerPK := getRandomPrivateKey()
+	servicerAddr := sdk.Address(servicerPK.PublicKey().Address())
+	keeper.posKeeper.(nodesKeeper.Keeper).RotateServicerKey(ctx, selfVal.Address, servicerPK.PublicKey())
+	// the consensus key no longer serves the node
+	_, err = keeper.GetSelfNode(ctx)
+	assert.NotNil(t, err)
+	// the servicer key does
+	types.InitSigner(types.NewFileSigner(servicerPK))
+	defer types.InitSigner(nil)
+	self, err = keeper.GetSelfNode(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, selfVal.Address, self.GetAddress())
+	assert.True(t, self.GetServicerPublicKey().Equals(servicerPK.PublicKey()))
+	// claims and proofs from the servicer key are accounted to the node
+	assert.Equal(t, selfVal.Address, keeper.GetValidatorAddress(ctx, servicerAddr))
+	// an unknown address maps to itself
+	other := sdk.Address(getRandomPrivateKey().PublicKey().Address())
+	assert.Equal(t, other, keeper.GetValidatorAddress(ctx, other))
+}
+
+func TestKeeper_RetiredServicerKey(t *testing.T) {
+	ctx, vals, _, _, keeper, keys := createTestInput(t, false)
+	selfVal := vals[len(vals)-1]
+	oldPK, newPK := getRandomPrivateKey(), getRandomPrivateKey()
+	oldAddr, newAddr := sdk.Address(oldPK.PublicKey().Address()), sdk.Address(newPK.PublicKey().Address())
+	keeper.posKeeper.(nodesKeeper.Keeper).RotateServicerKey(ctx, selfVal.Address, oldPK.PublicKey())
+	keeper.posKeeper.(nodesKeeper.Keeper).RotateServicerKey(ctx, selfVal.Address, newPK.PublicKey())
+	// the claims of the retired servicer key are still accounted to the node
+	assert.Equal(t, selfVal.Address, keeper.GetValidatorAddress(ctx, oldAddr))
+	assert.Equal(t, selfVal.Address, keeper.GetValidatorAddress(ctx, newAddr))
+	assert.ElementsMatch(t, []sdk.Address{newAddr, oldAddr, selfVal.Address}, keeper.GetServicerAddresses(ctx, oldAddr))
+	// the claim made with one servicer key of the node is found from the others
+	header := types.SessionHeader{ApplicationPubKey: getRandomPubKey().RawString(), Chain: "0001", SessionBlockHeight: 1}
+	claim := types.MsgClaim{SessionHeader: header, MerkleRoot: types.HashSum{Hash: []byte("root")}, TotalProofs: 5, FromAddress: newAddr, EvidenceType: types.RelayEvidence}
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys[nodesTypes.StoreKey]).Return(ctx.KVStore(keys[nodesTypes.StoreKey]))
+	mockCtx.On("KVStore", keys[sdk.ParamsKey.Name()]).Return(ctx.KVStore(keys[sdk.ParamsKey.Name()]))
+	mockCtx.On("BlockHeight").Return(int64(1))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	assert.Nil(t, keeper.SetClaim(mockCtx, claim))
+	got, found := keeper.getServicerClaim(mockCtx, oldAddr, header, types.RelayEvidence)
+	assert.True(t, found)
+	assert.Equal(t, newAddr, got.FromAddress)
+	// the node signs the proofs of the retired servicer key with it
+	types.InitSigner(types.NewFileSigner(newPK))
+	defer types.InitSigner(nil)
+	_, err := keeper.GetServicerSignerKey(ctx, oldAddr)
+	assert.NotNil(t, err)
+	types.InitRetiredSigners(types.NewFileSigner(oldPK))
+	defer types.InitRetiredSigners()
+	kp, err := keeper.GetServicerSignerKey(ctx, oldAddr)
+	assert.Nil(t, err)
+	assert.True(t, kp.PublicKey().Equals(oldPK.PublicKey()))
+	kp, err = keeper.GetServicerSignerKey(ctx, newAddr)
+	assert.Nil(t, err)
+	assert.True(t, kp.PublicKey().Equals(newPK.PublicKey()))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	sdk "github.com/pokt-network/posmint/types"
 )
@@ -45,10 +47,53 @@ func (k Keeper) ClaimSubmissionWindow(ctx sdk.Ctx) (res int64) {
 	return
 }
 
-// "SupportedBlockchains" - Returns a supported blockchain parameter from the paramstore
+// "SupportedBlockchains" - Returns the network identifiers of the chain registry parameter
 // What blockchains are supported in pocket network (list of network identifier hashes)
-func (k Keeper) SupportedBlockchains(ctx sdk.Ctx) (res []string) {
-	k.Paramstore.Get(ctx, types.KeySupportedBlockchains, &res)
+func (k Keeper) SupportedBlockchains(ctx sdk.Ctx) []string {
+	return k.ChainRegistry(ctx).SupportedBlockchains()
+}
+
+// "ChainRegistry" - Returns the chain registry parameter from the paramstore
+// The pocket supported blockchains and their metadata (name, api type, health check and deprecation)
+func (k Keeper) ChainRegistry(ctx sdk.Ctx) types.ChainRegistry {
+	return chainRegistry(ctx, k.Paramstore)
+}
+
+// "chainRegistry" - Returns the chain registry parameter from the paramstore, a state from before the chain registry
+// is migrated from the supported blockchains parameter
+func chainRegistry(ctx sdk.Ctx, paramstore sdk.Subspace) (res types.ChainRegistry) {
+	if !paramstore.Has(ctx, types.KeyChainRegistry) {
+		var supportedBlockchains []string
+		paramstore.GetIfExists(ctx, types.KeySupportedBlockchains, &supportedBlockchains)
+		return types.NewLegacyChainRegistry(supportedBlockchains)
+	}
+	paramstore.Get(ctx, types.KeyChainRegistry, &res)
+	return
+}
+
+// "MessageFees" - Returns the message fees parameter from the paramstore
+// The fees of the claim and proof messages, the default fees until set as a state from before the param doesn't hold it
+func (k Keeper) MessageFees(ctx sdk.Ctx) (res types.MessageFees) {
+	res = append(types.MessageFees(nil), types.DefaultMessageFees...)
+	k.Paramstore.GetIfExists(ctx, types.KeyMessageFees, &res)
+	return
+}
+
+// "MessageFee" - Returns the fee of the message type (in uPOKT) from the paramstore,
+// its default fee if the message is newer than the fees in state
+func (k Keeper) MessageFee(ctx sdk.Ctx, msgType string) sdk.Int {
+	if fee, found := k.MessageFees(ctx).Fee(msgType); found {
+		return sdk.NewInt(fee)
+	}
+	return types.DefaultMessageFees.Amount(msgType)
+}
+
+// "AppUsageRetention" - Returns the app usage retention parameter from the paramstore
+// Number of sessions the usage of an application is kept after the claims of the session expired,
+// the default until set as a state from before the param doesn't hold it
+func (k Keeper) AppUsageRetention(ctx sdk.Ctx) (res int64) {
+	res = types.DefaultAppUsageRetention
+	k.Paramstore.GetIfExists(ctx, types.KeyAppUsageRetention, &res)
 	return
 }
 
@@ -57,9 +102,11 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 	return types.Params{
 		SessionNodeCount:           k.SessionNodeCount(ctx),
 		ClaimSubmissionWindow:      k.ClaimSubmissionWindow(ctx),
-		SupportedBlockchains:       k.SupportedBlockchains(ctx),
 		ClaimExpiration:            k.ClaimExpiration(ctx),
 		ReplayAttackBurnMultiplier: k.ReplayAttackBurnMultiplier(ctx),
+		ChainRegistry:              k.ChainRegistry(ctx),
+		MessageFees:                k.MessageFees(ctx),
+		AppUsageRetention:          k.AppUsageRetention(ctx),
 	}
 }
 
@@ -67,3 +114,41 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 func (k Keeper) SetParams(ctx sdk.Ctx, params types.Params) {
 	k.Paramstore.SetParamSet(ctx, &params)
 }
+
+// "SimulateParamChange" - Simulates the change of a param against the current state without committing it, reporting
+// the nodes and applications staked for chains the change leaves unsupported or deprecated
+func (k Keeper) SimulateParamChange(ctx sdk.Ctx, key string, value []byte) (types.ParamChangeReport, sdk.Error) {
+	params, _, err := k.GetParams(ctx).ChangeParam(key, value)
+	if err != nil {
+		return types.ParamChangeReport{}, types.NewInvalidParamChangeError(types.ModuleName, err)
+	}
+	// the change is applied to a cached context, which is discarded
+	cacheCtx, _ := ctx.CacheContext()
+	k.SetParams(cacheCtx, params)
+	report := types.ParamChangeReport{
+		ParamKey:      key,
+		Params:        params,
+		AffectedNodes: make([]types.ParamChangeEffect, 0),
+		AffectedApps:  make([]types.ParamChangeEffect, 0),
+	}
+	for _, validator := range k.posKeeper.GetStakedValidators(cacheCtx) {
+		if k.ValidateStakingChains(cacheCtx, validator.GetChains()) != nil {
+			report.AffectedNodes = append(report.AffectedNodes, types.ParamChangeEffect{
+				Address: validator.GetAddress(),
+				Effect:  fmt.Sprintf("the staked chains %v are unsupported or deprecated", validator.GetChains()),
+			})
+		}
+	}
+	for _, application := range k.appKeeper.AllApplications(cacheCtx) {
+		if !application.IsStaked() {
+			continue
+		}
+		if k.ValidateStakingChains(cacheCtx, application.GetChains()) != nil {
+			report.AffectedApps = append(report.AffectedApps, types.ParamChangeEffect{
+				Address: application.GetAddress(),
+				Effect:  fmt.Sprintf("the staked chains %v are unsupported or deprecated", application.GetChains()),
+			})
+		}
+	}
+	return report, nil
+}
@@ -64,9 +64,11 @@ func TestKeeper_GetParams(t *testing.T) {
 	p := types.Params{
 		SessionNodeCount:           k.SessionNodeCount(ctx),
 		ClaimSubmissionWindow:      k.ClaimSubmissionWindow(ctx),
-		SupportedBlockchains:       k.SupportedBlockchains(ctx),
 		ClaimExpiration:            k.ClaimExpiration(ctx),
 		ReplayAttackBurnMultiplier: k.ReplayAttackBurnMultiplier(ctx),
+		ChainRegistry:              k.ChainRegistry(ctx),
+		MessageFees:                k.MessageFees(ctx),
+		AppUsageRetention:          k.AppUsageRetention(ctx),
 	}
 	paramz := k.GetParams(ctx)
 	assert.NotNil(t, paramz)
@@ -81,9 +83,42 @@ func TestKeeper_SetParams(t *testing.T) {
 	p := types.Params{
 		SessionNodeCount:      sessionNodeCount,
 		ClaimSubmissionWindow: pwp,
-		SupportedBlockchains:  sb,
+		ChainRegistry:         types.NewLegacyChainRegistry(sb),
 	}
 	k.SetParams(ctx, p)
 	paramz := k.GetParams(ctx)
 	assert.Equal(t, paramz, p)
 }
+
+func TestKeeper_SimulateParamChange(t *testing.T) {
+	ctx, _, _, _, k, _ := createTestInput(t, false)
+	// dropping the staked chain affects every staked node and app
+	report, err := k.SimulateParamChange(ctx, string(types.KeyChainRegistry), []byte(`[{"id":"0002","name":"bitcoin","type":"RAW"}]`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"0002"}, report.Params.ChainRegistry.SupportedBlockchains())
+	assert.Len(t, report.AffectedNodes, len(k.posKeeper.GetStakedValidators(ctx)))
+	assert.Len(t, report.AffectedApps, len(k.appKeeper.AllApplications(ctx)))
+	// the change is not committed
+	assert.Equal(t, []string{getTestSupportedBlockchain()}, k.SupportedBlockchains(ctx))
+	// a change that leaves the chains supported affects no one
+	report, err = k.SimulateParamChange(ctx, string(types.KeyClaimExpiration), []byte(`"50"`))
+	assert.Nil(t, err)
+	assert.Empty(t, report.AffectedNodes)
+	assert.Empty(t, report.AffectedApps)
+	// an invalid change is rejected
+	_, err = k.SimulateParamChange(ctx, string(types.KeySessionNodeCount), []byte(`"0"`))
+	assert.NotNil(t, err)
+}
+
+func TestKeeper_ParamsDefaultUntilSet(t *testing.T) {
+	ctx, _, _, _, keeper, keys := createTestInput(t, false)
+	// a state from before the params doesn't hold them
+	store := ctx.KVStore(keys[sdk.ParamsKey.Name()])
+	for _, key := range [][]byte{types.KeyMessageFees, types.KeyAppUsageRetention} {
+		store.Delete(append([]byte(keeper.Paramstore.Name()+"/"), key...))
+		assert.False(t, keeper.Paramstore.Has(ctx, key))
+	}
+	assert.Equal(t, types.DefaultMessageFees, keeper.MessageFees(ctx))
+	assert.Equal(t, types.DefaultAppUsageRetention, keeper.AppUsageRetention(ctx))
+	assert.Equal(t, sdk.NewInt(types.ClaimFee), keeper.MessageFee(ctx, types.MsgClaimName))
+}
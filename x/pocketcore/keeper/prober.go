@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+	"math/rand"
+
+	nodesexported "github.com/pokt-network/pocket-core/x/nodes/exported"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	"github.com/pokt-network/posmint/crypto/keys"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/pokt-network/posmint/x/auth"
+	"github.com/pokt-network/posmint/x/auth/util"
+	"github.com/tendermint/tendermint/rpc/client"
+)
+
+// "ProbeServicers" - Probes the service url of a random sample of staked servicers and reports the unreachable ones
+func (k Keeper) ProbeServicers(ctx sdk.Ctx, n client.Client, keybase keys.Keybase, reportTx func(pk crypto.PrivateKey, cliCtx util.CLIContext, txBuilder auth.TxBuilder, servicer sdk.Address, probe []byte, failure string) (*sdk.TxResponse, error)) {
+	if !pc.ProberEnabled() {
+		return
+	}
+	kp, err := k.GetSignerKey(ctx)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the private key from file for the liveness prober:\n%s", err.Error()))
+		return
+	}
+	// only staked and unjailed validators are able to report
+	self, found := k.GetNodeByServicerAddress(ctx, sdk.Address(kp.PublicKey().Address()))
+	if !found || !self.IsStaked() || self.IsJailed() {
+		return
+	}
+	selfAddr := self.GetAddress()
+	sessionBlockHeight := k.GetLatestSessionBlockHeight(ctx)
+	for _, servicer := range k.probeSample(ctx, selfAddr) {
+		probe, failure, err := pc.ProbeServicer(servicer.GetServiceURL(), servicer.GetServicerPublicKey().RawString(), servicer.GetChains(), sessionBlockHeight, ctx.BlockHeight(), kp)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("an error occured probing servicer %s:\n%s", servicer.GetAddress(), err.Error()))
+			continue
+		}
+		if failure == "" {
+			continue
+		}
+		ctx.Logger().Info(fmt.Sprintf("servicer %s failed the liveness probe: %s", servicer.GetAddress(), failure))
+		// report the failure on chain
+		msgType := nodesTypes.MsgReportServicerLivenessName
+		txBuilder, cliCtx, err := newTxBuilderAndCliCtxWithFee(ctx, msgType, k.posKeeper.MessageFee(ctx, msgType), n, keybase, k)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("an error occured creating the tx builder for the liveness report tx:\n%s", err.Error()))
+			return
+		}
+		if _, err := reportTx(kp, cliCtx, txBuilder, servicer.GetAddress(), probe, failure); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("an error occured sending the liveness report tx:\n%s", err.Error()))
+		}
+	}
+}
+
+// "HandleProbe" - Answers the liveness probe of a staked validator with a signed response, once per session, without
+// storing a proof as the probe is not a relay of an application
+func (k Keeper) HandleProbe(ctx sdk.Ctx, relay pc.Relay, selfNode nodesexported.ValidatorI, hostedBlockchains *pc.HostedBlockchains, sessionBlockHeight int64) (*pc.RelayResponse, sdk.Error) {
+	// only the probers of staked and unjailed validators are answered
+	proberAddr, er := relay.ProberAddress()
+	if er != nil {
+		return nil, pc.NewAppNotFoundError(pc.ModuleName)
+	}
+	prober, found := k.GetNodeByServicerAddress(ctx, proberAddr)
+	if !found || !prober.IsStaked() || prober.IsJailed() {
+		return nil, pc.NewAppNotFoundError(pc.ModuleName)
+	}
+	// ensure the validity of the probe
+	if err := relay.ValidateProbe(proberAddr, selfNode.GetServicerPublicKey().RawString(), selfNode.GetChains(), sessionBlockHeight); err != nil {
+		return nil, err
+	}
+	if !hostedBlockchains.Contains(relay.Proof.Blockchain) {
+		return nil, pc.NewUnsupportedBlockchainNodeError(pc.ModuleName)
+	}
+	if !pc.ServeProbe(proberAddr, sessionBlockHeight) {
+		return nil, pc.NewDuplicateProofError(pc.ModuleName)
+	}
+	// attempt to relay the probe to the chain
+	respPayload, err := relay.ExecuteProbe(hostedBlockchains)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pc.RelayResponse{
+		Response: respPayload,
+		Proof:    relay.Proof,
+	}
+	return k.signRelayResponse(ctx, resp, selfNode.GetAddress())
+}
+
+// "probeSample" - Returns a random sample of the staked and unjailed servicers, excluding self
+func (k Keeper) probeSample(ctx sdk.Ctx, selfAddr sdk.Address) (sample []nodesexported.ValidatorI) {
+	for _, v := range k.posKeeper.GetStakedValidators(ctx) {
+		if v.IsJailed() || v.GetAddress().Equals(selfAddr) {
+			continue
+		}
+		sample = append(sample, v)
+	}
+	rand.Shuffle(len(sample), func(i, j int) { sample[i], sample[j] = sample[j], sample[i] })
+	if len(sample) > pc.ProberSampleSize() {
+		sample = sample[:pc.ProberSampleSize()]
+	}
+	return
+}
@@ -17,27 +17,37 @@ import (
 
 // auto sends a proof transaction for the claim
 func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, keybase keys.Keybase, proofTx func(cliCtx util.CLIContext, txBuilder auth.TxBuilder, branches [2]pc.MerkleProof, leafNode, cousin pc.Proof) (*sdk.TxResponse, error)) {
-	kp, err := k.GetPKFromFile(ctx)
+	kp, err := k.GetSignerKey(ctx)
 	if err != nil {
 		ctx.Logger().Error(fmt.Sprintf("an error occured retrieving the pk from the file for the Proof Transaction:\n%v", err))
 		return
 	}
 	// get the self address
 	addr := sdk.Address(kp.PublicKey().Address())
-	// get all mature (waiting period has passed) claims for your address
-	claims, err := k.GetMatureClaims(ctx, addr)
-	if err != nil {
-		ctx.Logger().Error(fmt.Sprintf("an error occured getting the mature claims in the Proof Transaction:\n%v", err))
-		return
+	// get all mature (waiting period has passed) claims for your address and the servicer keys it rotated out of
+	var claims []pc.MsgClaim
+	for _, servicerAddr := range k.GetServicerAddresses(ctx, addr) {
+		c, err := k.GetMatureClaims(ctx, servicerAddr)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("an error occured getting the mature claims in the Proof Transaction:\n%v", err))
+			return
+		}
+		claims = append(claims, c...)
 	}
 	// for every claim of the mature set
 	for _, claim := range claims {
 		// if the claim is found to be verified in the world state, you can delete it from the cache and not send again
-		if _, found := k.GetReceipt(ctx, addr, claim.SessionHeader, claim.EvidenceType); found {
+		if _, found := k.GetReceipt(ctx, claim.FromAddress, claim.SessionHeader, claim.EvidenceType); found {
 			// remove from the local cache
 			pc.DeleteEvidence(claim.SessionHeader, claim.EvidenceType)
 			continue
 		}
+		// the misbehavior is only proven after the relays of the session
+		if claim.EvidenceType == pc.MisbehaviorEvidence {
+			if _, found := k.GetReceipt(ctx, claim.FromAddress, claim.SessionHeader, pc.RelayEvidence); !found {
+				continue
+			}
+		}
 		// check to see if evidence is stored in cache
 		evidence, found := pc.GetEvidence(claim.SessionHeader, claim.EvidenceType)
 		if !found || evidence.Proofs == nil || len(evidence.Proofs) == 0 {
@@ -55,8 +65,18 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, keybase keys.Keybase,
 		// get the leaf and cousin for the required pseudorandom index
 		leaf := pc.GetProof(claim.SessionHeader, claim.EvidenceType, index)
 		cousin := pc.GetProof(claim.SessionHeader, claim.EvidenceType, int64(cousinIndex))
+		// the proof is signed with the servicer key of the leaf, which may have been rotated out since the session
+		if leaf == nil || len(leaf.GetSigners()) < 1 {
+			continue
+		}
+		signers := leaf.GetSigners()
+		signerKey, err := k.GetServicerSignerKey(ctx, signers[0])
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("unable to sign the Proof Transaction for app: %s, at sessionHeight: %d:\n%v", claim.ApplicationPubKey, claim.SessionBlockHeight, err))
+			continue
+		}
 		// generate the auto txbuilder and clictx
-		txBuilder, cliCtx, err := newTxBuilderAndCliCtx(ctx, pc.MsgProofName, n, keybase, k)
+		txBuilder, cliCtx, err := newTxBuilderAndCliCtxWithKey(ctx, pc.MsgProofName, k.MessageFee(ctx, pc.MsgProofName), signerKey, n, keybase, k)
 		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("an error occured in the transaction process of the Proof Transaction:\n%v", err))
 			return
@@ -75,13 +95,14 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.
 	if len(addrs) < 1 {
 		return nil, pc.MsgClaim{}, pc.NewEmptyAddressError(pc.ModuleName)
 	}
-	addr := addrs[0]
-	// get the claim for the address
-	claim, found := k.GetClaim(ctx, addr, proof.Leaf.SessionHeader(), proof.Leaf.EvidenceType())
+	// get the claim for the address, or for the servicer key the node rotated out of since the claim
+	claim, found := k.getServicerClaim(ctx, addrs[0], proof.Leaf.SessionHeader(), proof.Leaf.EvidenceType())
 	// if the claim is not found for this claim
 	if !found {
 		return nil, pc.MsgClaim{}, pc.NewClaimNotFoundError(pc.ModuleName)
 	}
+	// the receipt is kept with the claim
+	addr := claim.FromAddress
 	// validate the proof
 	ctx.Logger().Info(fmt.Sprintf("Generate psuedorandom proof with %d proofs, at session height of %d, for app: %s", claim.TotalProofs, claim.SessionBlockHeight, claim.ApplicationPubKey))
 	reqProof, err := k.getPseudorandomIndex(ctx, claim.TotalProofs, claim.SessionHeader)
@@ -118,37 +139,92 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof pc.MsgProof) (servicerAddr sdk.
 		return nil, pc.MsgClaim{}, pc.NewAppNotFoundError(pc.ModuleName)
 	}
 	// validate the proof depending on the type of proof it is
-	er := proof.Leaf.Validate(application.GetChains(), int(k.SessionNodeCount(sessionCtx)), claim.SessionBlockHeight)
+	er := pc.ValidateProof(proof.Leaf, application.GetChains(), int(k.SessionNodeCount(sessionCtx)), claim.SessionBlockHeight, k.ChainRegistry(sessionCtx))
 	if er != nil {
 		return nil, pc.MsgClaim{}, er
 	}
+	// ensure the token of the relay proof was not revoked before the session
+	if rp, ok := proof.Leaf.(pc.RelayProof); ok && k.IsTokenRevoked(sessionCtx, rp.Token) {
+		return nil, pc.MsgClaim{}, pc.NewRevokedTokenError(pc.ModuleName)
+	}
+	// the over service is only proven once the relays of the full allowance of the reporter are proven
+	if _, ok := proof.Leaf.(pc.AppMisbehaviorProof); ok {
+		if err := k.ValidateMisbehaviorClaim(ctx, addr, claim.SessionHeader, application, int(k.SessionNodeCount(sessionCtx))); err != nil {
+			return nil, pc.MsgClaim{}, err
+		}
+	}
 	// return the needed info to the handler
 	return addr, claim, nil
 }
 
+// "getServicerClaim" - Returns the claim of the servicer key of the address, or of any other servicer key of its node
+func (k Keeper) getServicerClaim(ctx sdk.Ctx, servicerAddr sdk.Address, header pc.SessionHeader, evidenceType pc.EvidenceType) (claim pc.MsgClaim, found bool) {
+	if claim, found = k.GetClaim(ctx, servicerAddr, header, evidenceType); found {
+		return
+	}
+	for _, addr := range k.GetServicerAddresses(ctx, servicerAddr) {
+		if claim, found = k.GetClaim(ctx, addr, header, evidenceType); found {
+			return
+		}
+	}
+	return
+}
+
 func (k Keeper) ExecuteProof(ctx sdk.Ctx, proof pc.MsgProof, claim pc.MsgClaim) sdk.Error {
 	switch proof.Leaf.(type) {
 	case pc.RelayProof:
-		ctx.Logger().Info(fmt.Sprintf("reward coins to %s, for %d relays", claim.FromAddress.String(), claim.TotalProofs))
-		k.AwardCoinsForRelays(ctx, claim.TotalProofs, claim.FromAddress)
-		err := k.DeleteClaim(ctx, claim.FromAddress, claim.SessionHeader, pc.RelayEvidence)
+		appPubKey, err := crypto.NewPublicKey(claim.ApplicationPubKey)
+		if err != nil {
+			return sdk.ErrInvalidPubKey(err.Error())
+		}
+		appAddr := sdk.Address(appPubKey.Address())
+		// the claim is sent from the servicer key, the node is rewarded
+		nodeAddr := k.GetValidatorAddress(ctx, claim.FromAddress)
+		if k.appKeeper.IsPrepaid(ctx, appAddr) {
+			// prepaid applications pay the reward of the servicer from their escrow instead of minting it
+			ctx.Logger().Info(fmt.Sprintf("pay coins from the escrow of %s to %s, for %d relays", appAddr.String(), nodeAddr.String(), claim.TotalProofs))
+			k.PayCoinsFromEscrow(ctx, appAddr, claim.TotalProofs, nodeAddr)
+		} else {
+			ctx.Logger().Info(fmt.Sprintf("reward coins to %s, for %d relays", nodeAddr.String(), claim.TotalProofs))
+			k.AwardCoinsForRelays(ctx, claim.TotalProofs, nodeAddr)
+		}
+		// account the verified relays against the application's usage for the session
+		err = k.AddAppUsage(ctx, claim.SessionHeader, claim.TotalProofs)
+		if err != nil {
+			return sdk.ErrInternal(err.Error())
+		}
+		err = k.DeleteClaim(ctx, claim.FromAddress, claim.SessionHeader, pc.RelayEvidence)
 		if err != nil {
 			return sdk.ErrInternal(err.Error())
 		}
 	case pc.ChallengeProofInvalidData:
-		ctx.Logger().Info(fmt.Sprintf("burning coins from %s, for %d valid challenges", claim.FromAddress.String(), claim.TotalProofs))
 		pk := proof.Leaf.(pc.ChallengeProofInvalidData).MinorityResponse.Proof.ServicerPubKey
 		pubKey, err := crypto.NewPublicKey(pk)
 		if err != nil {
 			return sdk.ErrInvalidPubKey(err.Error())
 		}
-		k.BurnCoinsForChallenges(ctx, claim.TotalProofs, sdk.Address(pubKey.Address()))
+		// the minority response is signed with the servicer key of the challenged node
+		challengedAddr := k.GetValidatorAddress(ctx, sdk.Address(pubKey.Address()))
+		ctx.Logger().Info(fmt.Sprintf("burning coins from %s, for %d valid challenges", challengedAddr.String(), claim.TotalProofs))
+		k.BurnCoinsForChallenges(ctx, claim.TotalProofs, challengedAddr)
 		err = k.DeleteClaim(ctx, claim.FromAddress, claim.SessionHeader, pc.ChallengeEvidence)
 		if err != nil {
 			return sdk.ErrInternal(err.Error())
 		}
 		// small reward for the challenge proof invalid data
-		k.AwardCoinsForRelays(ctx, claim.TotalProofs/100, claim.FromAddress)
+		k.AwardCoinsForRelays(ctx, claim.TotalProofs/100, k.GetValidatorAddress(ctx, claim.FromAddress))
+	case pc.AppMisbehaviorProof:
+		ctx.Logger().Info(fmt.Sprintf("jailing application %s, for %d proven misbehaviors", claim.ApplicationPubKey, claim.TotalProofs))
+		pubKey, err := crypto.NewPublicKey(claim.ApplicationPubKey)
+		if err != nil {
+			return sdk.ErrInvalidPubKey(err.Error())
+		}
+		// the application is jailed once enough distinct servicers proved its misbehavior in the session
+		k.appKeeper.HandleApplicationMisbehavior(ctx, sdk.Address(pubKey.Address()), k.GetValidatorAddress(ctx, claim.FromAddress), claim.SessionBlockHeight, claim.TotalProofs)
+		err = k.DeleteClaim(ctx, claim.FromAddress, claim.SessionHeader, pc.MisbehaviorEvidence)
+		if err != nil {
+			return sdk.ErrInternal(err.Error())
+		}
 	}
 	return nil
 }
@@ -210,11 +286,21 @@ func (k Keeper) HandleReplayAttack(ctx sdk.Ctx, address sdk.Address, numberOfCha
 }
 
 func newTxBuilderAndCliCtx(ctx sdk.Ctx, msgType string, n client.Client, keybase keys.Keybase, k Keeper) (txBuilder auth.TxBuilder, cliCtx util.CLIContext, err error) {
+	return newTxBuilderAndCliCtxWithFee(ctx, msgType, k.MessageFee(ctx, msgType), n, keybase, k)
+}
+
+// create a tx builder and client context for an automatic message of another module, using the fee of that message
+func newTxBuilderAndCliCtxWithFee(ctx sdk.Ctx, msgType string, fee sdk.Int, n client.Client, keybase keys.Keybase, k Keeper) (txBuilder auth.TxBuilder, cliCtx util.CLIContext, err error) {
 	// get the pk, as it is the sender of the automatic message
-	kp, err := k.GetPKFromFile(ctx)
+	kp, err := k.GetSignerKey(ctx)
 	if err != nil {
 		return txBuilder, cliCtx, err
 	}
+	return newTxBuilderAndCliCtxWithKey(ctx, msgType, fee, kp, n, keybase, k)
+}
+
+// create a tx builder and client context for an automatic message sent from the servicer key
+func newTxBuilderAndCliCtxWithKey(ctx sdk.Ctx, msgType string, fee sdk.Int, kp crypto.PrivateKey, n client.Client, keybase keys.Keybase, k Keeper) (txBuilder auth.TxBuilder, cliCtx util.CLIContext, err error) {
 	// get the from address from the pkf
 	fromAddr := sdk.Address(kp.PublicKey().Address())
 	// get the genesis doc from the node for the chainID
@@ -224,11 +310,7 @@ func newTxBuilderAndCliCtx(ctx sdk.Ctx, msgType string, n client.Client, keybase
 	}
 	// create a client context for sending
 	cliCtx = util.NewCLIContext(n, fromAddr, "").WithCodec(k.cdc)
-	pk, err := k.GetPKFromFile(ctx)
-	if err != nil {
-		return txBuilder, cliCtx, err
-	}
-	cliCtx.PrivateKey = pk
+	cliCtx.PrivateKey = kp
 	// broadcast synchronously
 	cliCtx.BroadcastMode = util.BroadcastSync
 	// get the account to ensure balance
@@ -238,7 +320,6 @@ func newTxBuilderAndCliCtx(ctx sdk.Ctx, msgType string, n client.Client, keybase
 		return txBuilder, cliCtx, err
 	}
 	// check the fee amount
-	fee := sdk.NewInt(pc.PocketFeeMap[msgType])
 	if account.GetCoins().AmountOf(k.posKeeper.StakeDenom(ctx)).LTE(fee) {
 		ctx.Logger().Error(fmt.Sprintf("insufficient funds for the auto %s transaction: the fee needed is %v ", msgType, fee))
 	}
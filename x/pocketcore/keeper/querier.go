@@ -22,6 +22,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		// query pocket supported supported non-native blockchains
 		case types.QuerySupportedBlockchains:
 			return querySupportedBlockchains(ctx, req, k)
+		// query the governance managed chain registry
+		case types.QueryChainRegistry:
+			return queryChainRegistry(ctx, req, k)
 		// query the parameters of the pocketcore module
 		case types.QueryParameters:
 			return queryParameters(ctx, k)
@@ -34,6 +37,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 		// endpoint allowing a client to submit a challenge for an invalid relay-response
 		case types.QueryChallenge:
 			return queryChallenge(ctx, req, k)
+		// query the relays consumed by an application per session
+		case types.QueryAppUsage:
+			return queryAppUsage(ctx, req, k)
+		// simulate a param change against the current state
+		case types.QueryParamChange:
+			return queryParamChange(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown pocketcore query endpoint")
 		}
@@ -130,6 +139,17 @@ func querySupportedBlockchains(ctx sdk.Ctx, _ abci.RequestQuery, k Keeper) ([]by
 	return res, nil
 }
 
+// "queryChainRegistry" - Is a handler for the chain registry query
+// Returns the metadata of the non native chains supported on pocket network
+func queryChainRegistry(ctx sdk.Ctx, _ abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	// marshal the chain registry into amino-json
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.ChainRegistry(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
 // "queryReceipt" - Is a handler for the receipt query
 // Returns a receipt for relays or challenges
 func queryReceipt(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
@@ -146,8 +166,10 @@ func queryReceipt(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Err
 		et = types.RelayEvidence
 	case "challenge":
 		et = types.ChallengeEvidence
+	case "misbehavior":
+		et = types.MisbehaviorEvidence
 	default:
-		return nil, sdk.ErrInternal("type in the receipt query is not recognized: (relay or challenge)")
+		return nil, sdk.ErrInternal("type in the receipt query is not recognized: (relay, challenge or misbehavior)")
 	}
 	// retrieve the receipt
 	receipt, _ := k.GetReceipt(ctx, params.Address, params.Header, et)
@@ -180,3 +202,57 @@ func queryReceipts(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Er
 	}
 	return res, nil
 }
+
+// "queryAppUsage" - Is a handler for the app usage query
+// Returns the relays consumed by an application for a session or for all sessions
+func queryAppUsage(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	// unmarshal data into a QueryAppUsageParams object
+	var params types.QueryAppUsageParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	// get the usages of the application
+	usages, err := k.GetAppUsages(ctx, params.Address)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("an error occured retrieving the app usages: %s", err))
+	}
+	// filter by the session block height if specified
+	if params.SessionBlockHeight != 0 {
+		filtered := make([]types.AppUsage, 0)
+		for _, usage := range usages {
+			if usage.SessionBlockHeight == params.SessionBlockHeight {
+				filtered = append(filtered, usage)
+			}
+		}
+		usages = filtered
+	}
+	// marshal usages object into amino-json bytes
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, usages)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+// "queryParamChange" - Is a handler for the param change query
+// Simulates a param change against the current state, the change is not committed
+func queryParamChange(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	// unmarshal data into a QueryParamChangeParams object
+	var params types.QueryParamChangeParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	// simulate the change
+	report, er := k.SimulateParamChange(ctx, params.Key, []byte(params.Value))
+	if er != nil {
+		return nil, er
+	}
+	// marshal the report into amino-json bytes
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, report)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
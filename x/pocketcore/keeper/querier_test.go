@@ -12,7 +12,7 @@ import (
 func TestQuerySupportedBlockchains(t *testing.T) {
 	ctx, _, _, _, k, _ := createTestInput(t, false)
 	p := types.Params{
-		SupportedBlockchains: []string{"ethereum"},
+		ChainRegistry: types.NewLegacyChainRegistry([]string{"ethereum"}),
 	}
 	k.SetParams(ctx, p)
 	sbbz, err := querySupportedBlockchains(ctx, abci.RequestQuery{}, k)
@@ -26,7 +26,7 @@ func TestQuerySupportedBlockchains(t *testing.T) {
 func TestQueryParameters(t *testing.T) {
 	ctx, _, _, _, k, _ := createTestInput(t, false)
 	p := types.Params{
-		SupportedBlockchains: []string{"ethereum"},
+		ChainRegistry: types.NewLegacyChainRegistry([]string{"ethereum"}),
 	}
 	k.SetParams(ctx, p)
 	sbbz, err := queryParameters(ctx, k)
@@ -24,8 +24,16 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk
 	// get the application that staked on behalf of the client
 	app, found := k.GetAppFromPublicKey(ctx, relay.Proof.Token.ApplicationPublicKey)
 	if !found {
+		// the liveness probe of a staked validator uses a token self issued by its servicer key
+		if relay.IsProbe() {
+			return k.HandleProbe(ctx, relay, selfNode, hostedBlockchains, sessionBlockHeight)
+		}
 		return nil, pc.NewAppNotFoundError(pc.ModuleName)
 	}
+	// ensure the application has not revoked the token
+	if k.IsTokenRevoked(ctx, relay.Proof.Token) {
+		return nil, pc.NewRevokedTokenError(pc.ModuleName)
+	}
 	// get the session context
 	sessionCtx, er := ctx.PrevCtx(sessionBlockHeight)
 	if er != nil {
@@ -34,6 +42,8 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk
 	// ensure the validity of the relay
 	if err := relay.Validate(ctx, k.posKeeper, selfNode, hostedBlockchains, sessionBlockHeight, int(k.SessionNodeCount(sessionCtx)), app); err != nil {
 		ctx.Logger().Error(fmt.Errorf("could not validate relay for %v, %v, %v %v, %v, %v \n", selfNode, hostedBlockchains, sessionBlockHeight, int(k.SessionNodeCount(sessionCtx)), allNodes, app).Error())
+		// keep the proof of the misbehavior of the client to jail the application
+		k.StoreAppMisbehavior(ctx, relay, selfNode.GetAddress(), app, sessionBlockHeight, int(k.SessionNodeCount(sessionCtx)), err)
 		return nil, err
 	}
 	// store the proof before execution, because the proof corresponds to the previous relay
@@ -48,16 +58,21 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay pc.Relay) (*pc.RelayResponse, sdk
 		Response: respPayload,
 		Proof:    relay.Proof,
 	}
-	// get the private key from the private validator file
-	pk, er := k.GetPKFromFile(ctx)
+	return k.signRelayResponse(ctx, resp, selfNode.GetAddress())
+}
+
+// "signRelayResponse" - Signs the relay response with the servicer key of this node
+func (k Keeper) signRelayResponse(ctx sdk.Ctx, resp *pc.RelayResponse, selfAddr sdk.Address) (*pc.RelayResponse, sdk.Error) {
+	// get the private key object of the servicer (signs through the configured signer)
+	pk, er := k.GetSignerKey(ctx)
 	if er != nil {
-		ctx.Logger().Error(fmt.Errorf("could not get PK to Sign response for address: %v with hash: %v \n", selfNode.GetAddress().String(), resp.Hash()).Error())
+		ctx.Logger().Error(fmt.Errorf("could not get PK to Sign response for address: %v with hash: %v \n", selfAddr.String(), resp.Hash()).Error())
 		return nil, pc.NewKeybaseError(pc.ModuleName, er)
 	}
 	// sign the response
-	sig, er := pk.Sign(resp.Hash())
+	sig, er := pc.SignPayload(pk, pc.RelayResponseSignType, resp, resp.Hash())
 	if er != nil {
-		ctx.Logger().Error(fmt.Errorf("could not sign response for address: %v with hash: %v \n", selfNode.GetAddress().String(), resp.Hash()).Error())
+		ctx.Logger().Error(fmt.Errorf("could not sign response for address: %v with hash: %v \n", selfAddr.String(), resp.Hash()).Error())
 		return nil, pc.NewKeybaseError(pc.ModuleName, er)
 	}
 	// attach the signature in hex to the response
@@ -102,7 +117,7 @@ func (k Keeper) HandleChallenge(ctx sdk.Ctx, challenge pc.ChallengeProofInvalidD
 		pc.SetSession(session)
 	}
 	// validate the challenge
-	err = challenge.ValidateLocal(app.GetMaxRelays().Int64(), sessionBlkHeight, app.GetChains(), int(k.SessionNodeCount(sessionCtx)), session.SessionNodes, selfNode.GetAddress())
+	err = challenge.ValidateLocal(app.GetMaxRelays().Int64(), sessionBlkHeight, app.GetChains(), int(k.SessionNodeCount(sessionCtx)), session.SessionNodes, selfNode.GetAddress(), k.ChainRegistry(sessionCtx))
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	appsKeeper "github.com/pokt-network/pocket-core/x/apps/keeper"
 	appsTypes "github.com/pokt-network/pocket-core/x/apps/types"
+	nodesKeeper "github.com/pokt-network/pocket-core/x/nodes/keeper"
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
@@ -86,4 +88,62 @@ func TestKeeper_HandleRelay(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.NotEmpty(t, resp)
 	assert.Equal(t, resp.Response, "bar")
+	// relays of revoked tokens are rejected
+	assert.Nil(t, ak.RevokeAAT(ctx, app.Address, clientPubKey))
+	_, err = keeper.HandleRelay(mockCtx, validRelay)
+	assert.Equal(t, types.NewRevokedTokenError(types.ModuleName), err)
+}
+
+func TestKeeper_HandleProbe(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	ctx, _, _, _, keeper, keys := createTestInput(t, false)
+	mockCtx := new(Ctx)
+	nk := keeper.posKeeper.(nodesKeeper.Keeper)
+	proberPrivateKey := getRandomPrivateKey()
+	proberPubKey := proberPrivateKey.PublicKey()
+	kp, _ := keeper.Keybase.GetCoinbase()
+	probe := types.Relay{
+		Payload: types.Payload{Data: types.ProbePayload},
+		Meta:    types.RelayMeta{BlockHeight: 976},
+		Proof: types.RelayProof{
+			Entropy:            1,
+			SessionBlockHeight: 976,
+			ServicerPubKey:     kp.PublicKey.RawString(),
+			Blockchain:         ethereum,
+			Token: types.AAT{
+				Version:              "0.0.1",
+				ApplicationPublicKey: proberPubKey.RawString(),
+				ClientPublicKey:      proberPubKey.RawString(),
+			},
+		},
+	}
+	probe.Proof.RequestHash = probe.RequestHashString()
+	aatSig, _ := proberPrivateKey.Sign(probe.Proof.Token.Hash())
+	probe.Proof.Token.ApplicationSignature = hex.EncodeToString(aatSig)
+	sig, _ := proberPrivateKey.Sign(probe.Proof.Hash())
+	probe.Proof.Signature = hex.EncodeToString(sig)
+	defer gock.Off()
+	gock.New("https://www.google.com:443").
+		Post("/").
+		Reply(200).
+		BodyString("bar")
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("KVStore", keys["application"]).Return(ctx.KVStore(keys["application"]))
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("PrevCtx", keeper.GetLatestSessionBlockHeight(mockCtx)).Return(ctx, nil)
+	mockCtx.On("Logger").Return(ctx.Logger())
+	// the probe of an unstaked prober is handled as the relay of an unknown application
+	_, err := keeper.HandleRelay(mockCtx, probe)
+	assert.Equal(t, types.NewAppNotFoundError(types.ModuleName), err)
+	prober := nodesTypes.NewValidator(sdk.Address(proberPubKey.Address()), proberPubKey, []string{ethereum}, "https://www.google.com:443", sdk.NewInt(10000000))
+	nk.SetValidator(ctx, prober)
+	nk.SetStakedValidator(ctx, prober)
+	resp, err := keeper.HandleRelay(mockCtx, probe)
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Verify(probe))
+	// a prober is answered once per session
+	_, err = keeper.HandleRelay(mockCtx, probe)
+	assert.Equal(t, types.NewDuplicateProofError(types.ModuleName), err)
 }
@@ -53,7 +53,7 @@ func TestKeeper_IsPocketSupportedBlockchain(t *testing.T) {
 	sb := []string{"ethereum"}
 	notSB := "bitcoin"
 	p := types.Params{
-		SupportedBlockchains: sb,
+		ChainRegistry: types.NewLegacyChainRegistry(sb),
 	}
 	keeper.SetParams(ctx, p)
 	assert.True(t, keeper.IsPocketSupportedBlockchain(ctx, "ethereum"))
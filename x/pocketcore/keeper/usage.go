@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"fmt"
+
+	pc "github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+// "AddAppUsage" - Aggregates the relays of a verified receipt into the usage of the application for the session
+func (k Keeper) AddAppUsage(ctx sdk.Ctx, header pc.SessionHeader, totalRelays int64) error {
+	// get the usage of the application for the session
+	usage, found, err := k.GetAppUsage(ctx, header.ApplicationPubKey, header.SessionBlockHeight)
+	if err != nil {
+		return err
+	}
+	if !found {
+		usage = pc.AppUsage{
+			ApplicationPubKey:  header.ApplicationPubKey,
+			SessionBlockHeight: header.SessionBlockHeight,
+		}
+	}
+	// add the relays of the receipt
+	usage.TotalRelays += totalRelays
+	usage.Receipts++
+	return k.SetAppUsage(ctx, usage)
+}
+
+// "SetAppUsage" - Sets the usage object of an application for a session in the state storage
+func (k Keeper) SetAppUsage(ctx sdk.Ctx, usage pc.AppUsage) error {
+	// retrieve the store
+	store := ctx.KVStore(k.storeKey)
+	// get the address of the application
+	addr, err := appAddressFromPubKey(usage.ApplicationPubKey)
+	if err != nil {
+		return err
+	}
+	// generate the key for the app usage
+	key, err := pc.KeyForAppUsage(addr, usage.SessionBlockHeight)
+	if err != nil {
+		return err
+	}
+	// set the amino bz in the store
+	store.Set(key, k.cdc.MustMarshalBinaryBare(usage))
+	return nil
+}
+
+// "GetAppUsage" - Retrieves the usage object of an application for a session from the state storage
+func (k Keeper) GetAppUsage(ctx sdk.Ctx, appPubKey string, sessionBlockHeight int64) (usage pc.AppUsage, found bool, err error) {
+	// retrieve the store
+	store := ctx.KVStore(k.storeKey)
+	// get the address of the application
+	addr, err := appAddressFromPubKey(appPubKey)
+	if err != nil {
+		return pc.AppUsage{}, false, err
+	}
+	// generate the key for the app usage
+	key, err := pc.KeyForAppUsage(addr, sessionBlockHeight)
+	if err != nil {
+		return pc.AppUsage{}, false, err
+	}
+	// get the bytes from the store
+	res := store.Get(key)
+	if res == nil {
+		return pc.AppUsage{}, false, nil
+	}
+	// unmarshal the amino bz
+	k.cdc.MustUnmarshalBinaryBare(res, &usage)
+	return usage, true, nil
+}
+
+// "GetAppUsages" - Retrieves the usage objects of an application for all sessions
+func (k Keeper) GetAppUsages(ctx sdk.Ctx, appAddr sdk.Address) (usages []pc.AppUsage, err error) {
+	// retrieve the store
+	store := ctx.KVStore(k.storeKey)
+	// generate the key for the address
+	key, err := pc.KeyForAppUsages(appAddr)
+	if err != nil {
+		return nil, err
+	}
+	// iterate through the usages of the application
+	iterator := sdk.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var usage pc.AppUsage
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &usage)
+		usages = append(usages, usage)
+	}
+	return
+}
+
+// "SetAppUsages" - Sets many app usage objects in the store
+func (k Keeper) SetAppUsages(ctx sdk.Ctx, usages []pc.AppUsage) {
+	for _, usage := range usages {
+		if err := k.SetAppUsage(ctx, usage); err != nil {
+			panic(fmt.Sprintf("an error occured setting the app usages:\n%v", err))
+		}
+	}
+}
+
+// "GetAllAppUsages" - Retrieves all the app usage objects in the storage
+func (k Keeper) GetAllAppUsages(ctx sdk.Ctx) (usages []pc.AppUsage) {
+	// retrieve the store
+	store := ctx.KVStore(k.storeKey)
+	// iterate through the objects
+	iterator := sdk.KVStorePrefixIterator(store, pc.AppUsageKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var usage pc.AppUsage
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &usage)
+		usages = append(usages, usage)
+	}
+	return
+}
+
+// "DeleteExpiredAppUsages" - Deletes the app usages kept for more sessions than the app usage retention, counted from the
+// expiration of the claims of the session, so no proof can account relays to a deleted usage
+func (k Keeper) DeleteExpiredAppUsages(ctx sdk.Ctx) {
+	var usage pc.AppUsage
+	store := ctx.KVStore(k.storeKey)
+	retention := (k.ClaimExpiration(ctx) + k.AppUsageRetention(ctx)) * k.BlocksPerSession(ctx)
+	iterator := sdk.KVStorePrefixIterator(store, pc.AppUsageKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &usage)
+		if usage.SessionBlockHeight+retention <= ctx.BlockHeight() {
+			store.Delete(iterator.Key())
+		}
+	}
+}
+
+// "appAddressFromPubKey" - Returns the address of an application from the hex public key
+func appAddressFromPubKey(appPubKey string) (sdk.Address, error) {
+	pubKey, err := crypto.NewPublicKey(appPubKey)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.Address(pubKey.Address()), nil
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/pokt-network/pocket-core/x/pocketcore/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeeper_AddAppUsage(t *testing.T) {
+	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	appPubKey := getRandomPrivateKey().PublicKey().RawString()
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	header := types.SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              ethereum,
+		SessionBlockHeight: 1,
+	}
+	header2 := types.SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              bitcoin,
+		SessionBlockHeight: 1,
+	}
+	header3 := types.SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              ethereum,
+		SessionBlockHeight: 5,
+	}
+	// receipts of two servicers for the same session on different chains
+	assert.Nil(t, keeper.AddAppUsage(ctx, header, 100))
+	assert.Nil(t, keeper.AddAppUsage(ctx, header2, 50))
+	// receipt for the next session
+	assert.Nil(t, keeper.AddAppUsage(ctx, header3, 10))
+	usage, found, err := keeper.GetAppUsage(ctx, appPubKey, 1)
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Equal(t, types.AppUsage{ApplicationPubKey: appPubKey, SessionBlockHeight: 1, TotalRelays: 150, Receipts: 2}, usage)
+	_, found, err = keeper.GetAppUsage(ctx, appPubKey, 9)
+	assert.Nil(t, err)
+	assert.False(t, found)
+	pk, _ := crypto.NewPublicKey(appPubKey)
+	usages, err := keeper.GetAppUsages(ctx, sdk.Address(pk.Address()))
+	assert.Nil(t, err)
+	assert.Len(t, usages, 2)
+	assert.Equal(t, int64(10), usages[1].TotalRelays)
+	assert.Len(t, keeper.GetAllAppUsages(ctx), 2)
+}
+
+func TestKeeper_DeleteExpiredAppUsages(t *testing.T) {
+	ctx, _, _, _, keeper, _ := createTestInput(t, false)
+	appPubKey := getRandomPrivateKey().PublicKey().RawString()
+	ethereum := hex.EncodeToString([]byte{01})
+	blocksPerSession := keeper.BlocksPerSession(ctx)
+	retention := (keeper.ClaimExpiration(ctx) + keeper.AppUsageRetention(ctx)) * blocksPerSession
+	for _, sessionBlockHeight := range []int64{1, 1 + blocksPerSession} {
+		header := types.SessionHeader{ApplicationPubKey: appPubKey, Chain: ethereum, SessionBlockHeight: sessionBlockHeight}
+		assert.Nil(t, keeper.AddAppUsage(ctx, header, 10))
+	}
+	// the usages are kept during the retention
+	keeper.DeleteExpiredAppUsages(ctx.WithBlockHeight(retention))
+	assert.Len(t, keeper.GetAllAppUsages(ctx), 2)
+	// the usage of the first session is pruned
+	keeper.DeleteExpiredAppUsages(ctx.WithBlockHeight(1 + retention))
+	usages := keeper.GetAllAppUsages(ctx)
+	assert.Len(t, usages, 1)
+	assert.Equal(t, 1+blocksPerSession, usages[0].SessionBlockHeight)
+}
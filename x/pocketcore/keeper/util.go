@@ -1,22 +1,35 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 )
 
-// "GetPKFromFile" - Returns the private key object from a file
-func (k Keeper) GetPKFromFile(ctx sdk.Ctx) (crypto.PrivateKey, error) {
-	// get the Private validator key from the file
-	pvKey, err := types.GetPVKeyFile()
+// "GetSignerKey" - Returns the private key object of the servicer, which signs through the configured signer
+// (the private validator key file or a remote signer), so the key material is never required
+func (k Keeper) GetSignerKey(ctx sdk.Ctx) (crypto.PrivateKey, error) {
+	signer, err := types.GetSigner()
 	if err != nil {
 		return nil, err
 	}
-	// convert the privKey to a private key object (compatible interface)
-	pk, er := crypto.PrivKeyToPrivateKey(pvKey.PrivKey)
-	if er != nil {
-		return nil, er
+	return types.NewSignerKey(signer), nil
+}
+
+// "GetServicerSignerKey" - Returns the private key object of the current or a retired servicer key of the node,
+// the relays served with a servicer key are proven with it
+func (k Keeper) GetServicerSignerKey(ctx sdk.Ctx, servicerAddr sdk.Address) (crypto.PrivateKey, error) {
+	kp, err := k.GetSignerKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if sdk.Address(kp.PublicKey().Address()).Equals(servicerAddr) {
+		return kp, nil
+	}
+	if signer, found := types.GetRetiredSigner(servicerAddr); found {
+		return types.NewSignerKey(signer), nil
 	}
-	return pk, nil
+	return nil, types.NewSignerError(types.ModuleName, fmt.Errorf("the servicer key %s is not held by the node", servicerAddr.String()))
 }
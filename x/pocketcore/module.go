@@ -87,19 +87,25 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 // "BeginBlock" - Functionality that is called at the beginning of (every) block
 func (am AppModule) BeginBlock(ctx sdk.Ctx, req abci.RequestBeginBlock) {
 	if am.keeper.IsSessionBlock(ctx) && ctx.BlockHeight() != 1 {
-		go func() {
+		startWorker(func() {
 			// use this sleep timer to bypass the beginBlock lock over transactions
-			time.Sleep(time.Duration(rand.Intn(5000)) * time.Millisecond)
+			if !waitToWork(time.Duration(rand.Intn(5000)) * time.Millisecond) {
+				return
+			}
 			// auto send the proofs
 			am.keeper.SendClaimTx(ctx, am.keeper.TmNode, am.keeper.Keybase, ClaimTx)
 			// auto claim the proofs
 			am.keeper.SendProofTx(ctx, am.keeper.TmNode, am.keeper.Keybase, ProofTx)
+			// probe the liveness of a sample of servicers
+			am.keeper.ProbeServicers(ctx, am.keeper.TmNode, am.keeper.Keybase, ServicerLivenessReportTx)
 			// clear session cache and db
 			types.ClearSessionCache()
-		}()
+		})
 	}
 	// delete the expired claims
 	am.keeper.DeleteExpiredClaims(ctx)
+	// delete the expired app usages
+	am.keeper.DeleteExpiredAppUsages(ctx)
 }
 
 // "EndBlock" - Functionality that is called at the end of (every) block
@@ -21,7 +21,7 @@ func TestAppModule_InitExportGenesis(t *testing.T) {
 	p := types.Params{
 		SessionNodeCount:      10,
 		ClaimSubmissionWindow: 22,
-		SupportedBlockchains:  []string{"eth"},
+		ChainRegistry:         types.NewLegacyChainRegistry([]string{"eth"}),
 		ClaimExpiration:       55,
 	}
 	genesisState := types.GenesisState{
@@ -82,8 +82,9 @@ func TestAppModuleBasic_ValidateGenesis(t *testing.T) {
 	p := types.Params{
 		SessionNodeCount:      10,
 		ClaimSubmissionWindow: 22,
-		SupportedBlockchains:  []string{hex.EncodeToString([]byte{01})},
+		ChainRegistry:         types.NewLegacyChainRegistry([]string{hex.EncodeToString([]byte{01})}),
 		ClaimExpiration:       55,
+		MessageFees:           types.DefaultMessageFees,
 	}
 	genesisState := types.GenesisState{
 		Params:   p,
@@ -93,7 +94,7 @@ func TestAppModuleBasic_ValidateGenesis(t *testing.T) {
 	p2 := types.Params{
 		SessionNodeCount:      -1,
 		ClaimSubmissionWindow: 22,
-		SupportedBlockchains:  []string{"eth"},
+		ChainRegistry:         types.NewLegacyChainRegistry([]string{"eth"}),
 		ClaimExpiration:       55,
 	}
 	genesisState2 := types.GenesisState{
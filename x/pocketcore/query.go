@@ -101,6 +101,24 @@ func QueryPocketSupportedBlockchains(cdc *codec.Codec, tmNode client.Client, hei
 	return chains, nil
 }
 
+// "QueryChainRegistry" - Exported call to query the chain registry
+func QueryChainRegistry(cdc *codec.Codec, tmNode client.Client, height int64) (types.ChainRegistry, error) {
+	var registry types.ChainRegistry
+	// generate cli context
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	// execute abci query
+	res, _, err := cliCtx.Query(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryChainRegistry))
+	if err != nil {
+		return nil, err
+	}
+	// unmarshal result
+	err = cdc.UnmarshalJSON(res, &registry)
+	if err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
 // "QueryRelay" - Exported call to execute a relay request
 func QueryRelay(cdc *codec.Codec, tmNode client.Client, relay types.Relay) (*types.RelayResponse, error) {
 	// generate cli context
@@ -186,3 +204,52 @@ func QueryDispatch(cdc *codec.Codec, tmNode client.Client, header types.SessionH
 	}
 	return &response, nil
 }
+
+// "QueryAppUsage" - Exported query function for the relays consumed by an application per session
+// A session block height of zero retrieves the usage for all sessions
+func QueryAppUsage(cdc *codec.Codec, tmNode client.Client, appAddr sdk.Address, sessionBlockHeight, height int64) ([]types.AppUsage, error) {
+	// generate cli context
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	// setup params
+	params := types.QueryAppUsageParams{
+		Address:            appAddr,
+		SessionBlockHeight: sessionBlockHeight,
+	}
+	// marshal params
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return nil, err
+	}
+	// execute abci query
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryAppUsage), bz)
+	if err != nil {
+		return nil, err
+	}
+	// unmarshal result
+	var usages []types.AppUsage
+	err = cdc.UnmarshalJSON(res, &usages)
+	if err != nil {
+		return nil, err
+	}
+	return usages, nil
+}
+
+// "QueryParamChange" - Exported query function to simulate a param change against the current state
+func QueryParamChange(cdc *codec.Codec, tmNode client.Client, height int64, key string, value []byte) (types.ParamChangeReport, error) {
+	// generate cli context
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	// marshal params
+	bz, err := cdc.MarshalJSON(types.QueryParamChangeParams{Key: key, Value: string(value)})
+	if err != nil {
+		return types.ParamChangeReport{}, err
+	}
+	// execute abci query
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryParamChange), bz)
+	if err != nil {
+		return types.ParamChangeReport{}, err
+	}
+	// unmarshal result
+	var report types.ParamChangeReport
+	err = cdc.UnmarshalJSON(res, &report)
+	return report, err
+}
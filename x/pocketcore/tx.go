@@ -1,6 +1,7 @@
 package pocketcore
 
 import (
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
 	"github.com/pokt-network/pocket-core/x/pocketcore/keeper"
 	"github.com/pokt-network/pocket-core/x/pocketcore/types"
 	"github.com/pokt-network/posmint/crypto"
@@ -41,7 +42,28 @@ func ProofTx(cliCtx util.CLIContext, txBuilder auth.TxBuilder, branches [2]types
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
 }
 
+// "ServicerLivenessReportTx" - A transaction reporting the service url of a servicer as unreachable (sent by the liveness prober)
+// The probe is the relay the prober sent to the servicer and the failure is the failure of the probe
+func ServicerLivenessReportTx(kp crypto.PrivateKey, cliCtx util.CLIContext, txBuilder auth.TxBuilder, servicer sdk.Address, probe []byte, failure string) (*sdk.TxResponse, error) {
+	msg := nodesTypes.MsgReportServicerLiveness{
+		Reporter: sdk.Address(kp.PublicKey().Address()),
+		Servicer: servicer,
+		Probe:    probe,
+		Failure:  failure,
+	}
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, []sdk.Msg{msg})
+}
+
 // "GenerateAAT" - Exported call to generate an application authentication token
 func GenerateAAT(keybase keys.Keybase, appPubKey, cliPubKey, passphrase string) (types.AAT, error) {
 	return keeper.AATGeneration(appPubKey, cliPubKey, passphrase, keybase)
 }
+
+// "GenerateScopedAAT" - Exported call to generate a scoped application authentication token
+func GenerateScopedAAT(keybase keys.Keybase, appPubKey, cliPubKey string, expirationHeight int64, chains []string, maxRelays int64, passphrase string) (types.AAT, error) {
+	return keeper.ScopedAATGeneration(appPubKey, cliPubKey, expirationHeight, chains, maxRelays, passphrase, keybase)
+}
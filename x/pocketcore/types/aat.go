@@ -9,15 +9,23 @@ import (
 var (
 	// A list of supported token versions
 	// Requires major (semantic) upgrade to update this list
-	SupportedTokenVersions = []string{"0.0.1"}
+	SupportedTokenVersions = []string{AATVersion, ScopedAATVersion}
+)
+
+const (
+	AATVersion       = "0.0.1" // the original token version, never expires and is valid for every app chain
+	ScopedAATVersion = "0.0.2" // the scoped token version, with an optional expiration, chain allowlist and relay cap
 )
 
 // "AAT" - Application authentication token, used to authenticate clients for applications
 type AAT struct {
-	Version              string `json:"version"`        // what version of the token is used?
-	ApplicationPublicKey string `json:"app_pub_key"`    // the app pub key in hex
-	ClientPublicKey      string `json:"client_pub_key"` // the client pub key in hex
-	ApplicationSignature string `json:"signature"`      // the app signature in hex
+	Version              string   `json:"version"`                     // what version of the token is used?
+	ApplicationPublicKey string   `json:"app_pub_key"`                 // the app pub key in hex
+	ClientPublicKey      string   `json:"client_pub_key"`              // the client pub key in hex
+	ApplicationSignature string   `json:"signature"`                   // the app signature in hex
+	ExpirationHeight     int64    `json:"expiration_height,omitempty"` // (0.0.2) the first session height the token is no longer valid, 0 = never
+	Chains               []string `json:"chains,omitempty"`            // (0.0.2) the non-native chains the token is valid for, empty = all app chains
+	MaxRelays            int64    `json:"max_relays,omitempty"`        // (0.0.2) the max relays per servicer per session, 0 = no token limit
 }
 
 // "VersionIsIncluded" - Returns if the version is included
@@ -64,6 +72,9 @@ func (a AAT) Bytes() []byte {
 		ApplicationPublicKey: a.ApplicationPublicKey,
 		ClientPublicKey:      a.ClientPublicKey,
 		Version:              a.Version,
+		ExpirationHeight:     a.ExpirationHeight,
+		Chains:               a.Chains,
+		MaxRelays:            a.MaxRelays,
 	})
 	if err != nil {
 		panic(fmt.Sprintf("an error occured hashing the aat:\n%v", err))
@@ -111,6 +122,58 @@ func (a AAT) ValidateMessage() error {
 	if err := PubKeyVerification(a.ClientPublicKey); err != nil {
 		return err
 	}
+	// check the scope of the aat
+	if a.Version == AATVersion {
+		if a.IsScoped() {
+			return UnsupportedTokenScopeError
+		}
+		return nil
+	}
+	if a.ExpirationHeight < 0 {
+		return InvalidTokenExpirationError
+	}
+	if a.MaxRelays < 0 {
+		return InvalidTokenMaxRelaysError
+	}
+	for _, chain := range a.Chains {
+		if err := NetworkIdentifierVerification(chain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "IsScoped" - Returns if the AAT contains any (0.0.2) scope field
+func (a AAT) IsScoped() bool {
+	return a.ExpirationHeight != 0 || len(a.Chains) != 0 || a.MaxRelays != 0
+}
+
+// "IsExpired" - Returns if the AAT is no longer valid for the session block height
+func (a AAT) IsExpired(sessionBlockHeight int64) bool {
+	return a.ExpirationHeight > 0 && sessionBlockHeight >= a.ExpirationHeight
+}
+
+// "AllowsChain" - Returns if the AAT is valid for the non-native chain
+func (a AAT) AllowsChain(chain string) bool {
+	if len(a.Chains) == 0 {
+		return true
+	}
+	for _, c := range a.Chains {
+		if c == chain {
+			return true
+		}
+	}
+	return false
+}
+
+// "ValidateScope" - Confirms the AAT is valid for the chain and session block height
+func (a AAT) ValidateScope(chain string, sessionBlockHeight int64) error {
+	if a.IsExpired(sessionBlockHeight) {
+		return ExpiredTokenError
+	}
+	if !a.AllowsChain(chain) {
+		return UnauthorizedTokenChainError
+	}
 	return nil
 }
 
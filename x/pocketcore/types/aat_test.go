@@ -271,3 +271,104 @@ func TestAAT_Validate(t *testing.T) {
 	AAT.ApplicationSignature = hex.EncodeToString(applicationSignature)
 	assert.Nil(t, AAT.Validate())
 }
+
+func TestAAT_ValidateScopedMessage(t *testing.T) {
+	appPrivKey := GetRandomPrivateKey()
+	clientPubKey := getRandomPubKey()
+	chain := hex.EncodeToString([]byte{01})
+	newAAT := func(version string, expirationHeight int64, chains []string, maxRelays int64) AAT {
+		return AAT{
+			Version:              version,
+			ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+			ClientPublicKey:      clientPubKey.RawString(),
+			ExpirationHeight:     expirationHeight,
+			Chains:               chains,
+			MaxRelays:            maxRelays,
+		}
+	}
+	tests := []struct {
+		name string
+		aat  AAT
+		err  error
+	}{
+		{
+			name: "0.0.1 AAT can't have an expiration height",
+			aat:  newAAT(AATVersion, 10, nil, 0),
+			err:  UnsupportedTokenScopeError,
+		},
+		{
+			name: "0.0.1 AAT can't have chains",
+			aat:  newAAT(AATVersion, 0, []string{chain}, 0),
+			err:  UnsupportedTokenScopeError,
+		},
+		{
+			name: "0.0.2 AAT has a negative expiration height",
+			aat:  newAAT(ScopedAATVersion, -1, nil, 0),
+			err:  InvalidTokenExpirationError,
+		},
+		{
+			name: "0.0.2 AAT has negative max relays",
+			aat:  newAAT(ScopedAATVersion, 0, nil, -1),
+			err:  InvalidTokenMaxRelaysError,
+		},
+		{
+			name: "0.0.2 AAT has an invalid chain",
+			aat:  newAAT(ScopedAATVersion, 0, []string{""}, 0),
+			err:  NewEmptyHashError(ModuleName),
+		},
+		{
+			name: "0.0.2 AAT has a valid scope",
+			aat:  newAAT(ScopedAATVersion, 10, []string{chain}, 5),
+		},
+		{
+			name: "0.0.2 AAT without a scope is valid",
+			aat:  newAAT(ScopedAATVersion, 0, nil, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.aat.ValidateMessage()
+			if tt.err == nil {
+				assert.Nil(t, err)
+				return
+			}
+			assert.NotNil(t, err)
+			assert.Equal(t, tt.err.Error(), err.Error())
+		})
+	}
+}
+
+func TestAAT_ValidateScope(t *testing.T) {
+	chain := hex.EncodeToString([]byte{01})
+	otherChain := hex.EncodeToString([]byte{02})
+	scoped := AAT{Version: ScopedAATVersion, ExpirationHeight: 5, Chains: []string{chain}}
+	unscoped := AAT{Version: ScopedAATVersion}
+	assert.Nil(t, scoped.ValidateScope(chain, 4))
+	assert.Equal(t, ExpiredTokenError, scoped.ValidateScope(chain, 5))
+	assert.Equal(t, UnauthorizedTokenChainError, scoped.ValidateScope(otherChain, 4))
+	assert.Nil(t, unscoped.ValidateScope(otherChain, 1000))
+}
+
+func TestAAT_ScopedSignature(t *testing.T) {
+	appPrivKey := GetRandomPrivateKey()
+	clientPubKey := getRandomPubKey()
+	aat := AAT{
+		Version:              ScopedAATVersion,
+		ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+		ClientPublicKey:      clientPubKey.RawString(),
+		ExpirationHeight:     10,
+		Chains:               []string{hex.EncodeToString([]byte{01})},
+		MaxRelays:            5,
+	}
+	sig, err := appPrivKey.Sign(aat.Hash())
+	assert.Nil(t, err)
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	assert.Nil(t, aat.Validate())
+	// the scope is covered by the app signature
+	tampered := aat
+	tampered.MaxRelays = 50
+	assert.Equal(t, InvalidTokenSignatureErorr, tampered.Validate())
+	tampered = aat
+	tampered.ExpirationHeight = 0
+	assert.Equal(t, InvalidTokenSignatureErorr, tampered.Validate())
+}
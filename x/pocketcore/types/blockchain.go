@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+	sdk "github.com/pokt-network/posmint/types"
+	"strings"
+)
+
+const (
+	JSONRPCBlockchainType = "JSON-RPC" // json rpc style api (ethereum, bitcoin, etc.)
+	RESTBlockchainType    = "REST"     // rest style api with json responses
+	GraphQLBlockchainType = "GraphQL"  // graphql style api with json responses
+	RawBlockchainType     = "RAW"      // any other api, responses are compared byte for byte
+)
+
+var (
+	// A list of supported blockchain api types for the chain registry
+	SupportedBlockchainTypes = []string{JSONRPCBlockchainType, RESTBlockchainType, GraphQLBlockchainType, RawBlockchainType}
+)
+
+// "Blockchain" - An entry of the governance managed chain registry, describes a pocket supported non-native blockchain
+type Blockchain struct {
+	ID          string     `json:"id"`                    // network identifier of the blockchain in hex
+	Name        string     `json:"name"`                  // human readable name of the blockchain
+	Type        string     `json:"type"`                  // api type of the blockchain (JSON-RPC, REST, etc.)
+	HealthCheck string     `json:"health_check"`          // default health check payload for the blockchain
+	Deprecated  bool       `json:"deprecated"`            // if deprecated, the blockchain may no longer be staked for
+	FieldMasks  FieldMasks `json:"field_masks,omitempty"` // the response fields ignored by the json rpc comparator, per method
+}
+
+// "Validate" - Validates the chain registry entry
+func (b Blockchain) Validate() sdk.Error {
+	// validate the network identifier
+	if err := NetworkIdentifierVerification(b.ID); err != nil {
+		return err
+	}
+	// validate the name
+	if strings.TrimSpace(b.Name) == "" {
+		return NewInvalidRegistryBlockchainError(ModuleName, fmt.Errorf("the name of %s is empty", b.ID))
+	}
+	// validate the type
+	if !b.TypeIsSupported() {
+		return NewInvalidRegistryBlockchainError(ModuleName, fmt.Errorf("the type %s of %s is not supported", b.Type, b.ID))
+	}
+	// validate the field masks, only used by the json rpc comparator
+	if len(b.FieldMasks) != 0 && b.Type != JSONRPCBlockchainType {
+		return NewInvalidRegistryBlockchainError(ModuleName, fmt.Errorf("the field masks of %s are only supported for the %s type", b.ID, JSONRPCBlockchainType))
+	}
+	if err := b.FieldMasks.Validate(); err != nil {
+		return NewInvalidRegistryBlockchainError(ModuleName, fmt.Errorf("the field masks of %s are invalid: %s", b.ID, err.Error()))
+	}
+	return nil
+}
+
+// "TypeIsSupported" - Returns if the api type of the blockchain is a supported blockchain type
+func (b Blockchain) TypeIsSupported() bool {
+	for _, t := range SupportedBlockchainTypes {
+		if b.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// "String" - Returns a human readable representation of the blockchain
+func (b Blockchain) String() string {
+	return fmt.Sprintf("%s (%s) type: %s deprecated: %v", b.ID, b.Name, b.Type, b.Deprecated)
+}
+
+// "ChainRegistry" - The list of blockchains registered by governance
+type ChainRegistry []Blockchain
+
+// "NewLegacyChainRegistry" - Returns a chain registry of the network identifiers of the supported blockchains param,
+// which the chain registry replaced (the entries are named after their identifier and compared byte for byte)
+func NewLegacyChainRegistry(supportedBlockchains []string) ChainRegistry {
+	cr := make(ChainRegistry, 0, len(supportedBlockchains))
+	for _, chain := range supportedBlockchains {
+		cr = append(cr, Blockchain{ID: chain, Name: chain, Type: RawBlockchainType})
+	}
+	return cr
+}
+
+// "Validate" - Validates each entry of the chain registry and ensures the entries are unique
+func (cr ChainRegistry) Validate() error {
+	m := make(map[string]struct{}, len(cr))
+	for _, blockchain := range cr {
+		// validate the entry
+		if err := blockchain.Validate(); err != nil {
+			return err
+		}
+		// ensure no duplicates
+		if _, found := m[blockchain.ID]; found {
+			return NewInvalidRegistryBlockchainError(ModuleName, fmt.Errorf("%s is registered more than once", blockchain.ID))
+		}
+		m[blockchain.ID] = struct{}{}
+	}
+	return nil
+}
+
+// "SupportedBlockchains" - Returns the network identifiers of the registered blockchains, which are the pocket
+// supported blockchains (deprecated blockchains are still supported, they may no longer be staked for)
+func (cr ChainRegistry) SupportedBlockchains() []string {
+	chains := make([]string, 0, len(cr))
+	for _, blockchain := range cr {
+		chains = append(chains, blockchain.ID)
+	}
+	return chains
+}
+
+// "Get" - Returns the registry entry for the network identifier
+func (cr ChainRegistry) Get(id string) (blockchain Blockchain, found bool) {
+	for _, blockchain := range cr {
+		if blockchain.ID == id {
+			return blockchain, true
+		}
+	}
+	return Blockchain{ID: id}, false
+}
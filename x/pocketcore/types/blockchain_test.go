@@ -0,0 +1,99 @@
+package types
+
+import (
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBlockchain_Validate(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	tests := []struct {
+		name       string
+		blockchain Blockchain
+		hasError   bool
+	}{
+		{
+			name:       "Invalid Blockchain, network identifier",
+			blockchain: Blockchain{ID: "invalid", Name: "ethereum", Type: JSONRPCBlockchainType},
+			hasError:   true,
+		},
+		{
+			name:       "Invalid Blockchain, empty name",
+			blockchain: Blockchain{ID: ethereum, Name: " ", Type: JSONRPCBlockchainType},
+			hasError:   true,
+		},
+		{
+			name:       "Invalid Blockchain, unsupported type",
+			blockchain: Blockchain{ID: ethereum, Name: "ethereum", Type: "SOAP"},
+			hasError:   true,
+		},
+		{
+			name:       "Valid Blockchain",
+			blockchain: Blockchain{ID: ethereum, Name: "ethereum", Type: JSONRPCBlockchainType},
+			hasError:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.blockchain.Validate() != nil, tt.hasError)
+		})
+	}
+}
+
+func TestChainRegistry_Validate(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	eth := Blockchain{ID: ethereum, Name: "ethereum", Type: JSONRPCBlockchainType}
+	btc := Blockchain{ID: bitcoin, Name: "bitcoin", Type: JSONRPCBlockchainType}
+	tests := []struct {
+		name     string
+		registry ChainRegistry
+		hasError bool
+	}{
+		{
+			name:     "Invalid Registry, invalid entry",
+			registry: ChainRegistry{eth, {ID: bitcoin}},
+			hasError: true,
+		},
+		{
+			name:     "Invalid Registry, duplicate entry",
+			registry: ChainRegistry{eth, eth},
+			hasError: true,
+		},
+		{
+			name:     "Valid Registry",
+			registry: ChainRegistry{eth, btc},
+			hasError: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.registry.Validate() != nil, tt.hasError)
+		})
+	}
+}
+
+func TestChainRegistry_Get(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	eth := Blockchain{ID: ethereum, Name: "ethereum", Type: JSONRPCBlockchainType}
+	registry := ChainRegistry{eth}
+	b, found := registry.Get(ethereum)
+	assert.True(t, found)
+	assert.Equal(t, eth, b)
+	b, found = registry.Get(bitcoin)
+	assert.False(t, found)
+	assert.Equal(t, bitcoin, b.ID)
+}
+
+func TestChainRegistry_SupportedBlockchains(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	registry := ChainRegistry{{ID: ethereum, Name: "ethereum", Type: JSONRPCBlockchainType}, {ID: bitcoin, Name: "bitcoin", Type: JSONRPCBlockchainType, Deprecated: true}}
+	assert.Equal(t, []string{ethereum, bitcoin}, registry.SupportedBlockchains())
+	// the supported blockchains param is migrated to raw entries
+	legacy := NewLegacyChainRegistry([]string{ethereum})
+	assert.Nil(t, legacy.Validate())
+	assert.Equal(t, ChainRegistry{{ID: ethereum, Name: ethereum, Type: RawBlockchainType}}, legacy)
+}
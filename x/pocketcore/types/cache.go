@@ -34,6 +34,16 @@ func InitCache(evidenceDir, sessionDir string, sessionDBType, evidenceDBType db.
 	})
 }
 
+// "CloseCache" - Closes the session and evidence dbs, the cache can't be used afterwards
+func CloseCache() {
+	if globalSessionCache != nil {
+		globalSessionCache.Close()
+	}
+	if globalEvidenceCache != nil {
+		globalEvidenceCache.Close()
+	}
+}
+
 // "Init" - Initializes a cache storage object
 func (cs *CacheStorage) Init(dir, name string, dbType db.DBBackendType, maxEntries int) {
 	// init the lru cache with a max entries
@@ -103,6 +113,14 @@ func (cs *CacheStorage) Clear() {
 	}
 }
 
+// "Close" - Closes the db, flushing it to disk
+func (cs *CacheStorage) Close() {
+	cs.l.Lock()
+	defer cs.l.Unlock()
+	cs.Cache.Purge()
+	cs.DB.Close()
+}
+
 // "Iterator" - Returns an iterator for all of the items in the stores
 func (cs *CacheStorage) Iterator() db.Iterator {
 	return cs.DB.Iterator(nil, nil)
@@ -307,3 +325,14 @@ func GetTotalProofs(h SessionHeader, et EvidenceType) int64 {
 	// return number of proofs
 	return evidence.NumOfProofs
 }
+
+// "GetTotalTokenProofs" - Returns the total number of relay proofs for a piece of evidence that were authorized by the token
+func GetTotalTokenProofs(h SessionHeader, token AAT) int64 {
+	// retrieve the evidence
+	evidence, found := GetEvidence(h, RelayEvidence)
+	if !found {
+		return 0
+	}
+	// return the relay count of the token
+	return evidence.TotalTokenRelays(token)
+}
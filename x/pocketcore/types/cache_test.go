@@ -126,6 +126,42 @@ func TestAllEvidence_GetTotalProofs(t *testing.T) {
 	assert.Equal(t, GetTotalProofs(header, RelayEvidence), int64(2))
 }
 
+func TestAllEvidence_GetTotalTokenProofs(t *testing.T) {
+	InitCacheTest()
+	appPubKey := getRandomPubKey().RawString()
+	servicerPubKey := getRandomPubKey().RawString()
+	ethereum := hex.EncodeToString([]byte{0001})
+	header := SessionHeader{
+		ApplicationPubKey:  appPubKey,
+		Chain:              ethereum,
+		SessionBlockHeight: 1,
+	}
+	token := AAT{
+		Version:              ScopedAATVersion,
+		ApplicationPublicKey: appPubKey,
+		ClientPublicKey:      getRandomPubKey().RawString(),
+		MaxRelays:            2,
+	}
+	token2 := AAT{
+		Version:              AATVersion,
+		ApplicationPublicKey: appPubKey,
+		ClientPublicKey:      getRandomPubKey().RawString(),
+	}
+	for i, tk := range []AAT{token, token, token2} {
+		SetProof(header, RelayEvidence, RelayProof{
+			Entropy:            int64(i),
+			SessionBlockHeight: 1,
+			ServicerPubKey:     servicerPubKey,
+			RequestHash:        header.HashString(), // fake
+			Blockchain:         ethereum,
+			Token:              tk,
+		})
+	}
+	assert.Equal(t, int64(2), GetTotalTokenProofs(header, token))
+	assert.Equal(t, int64(1), GetTotalTokenProofs(header, token2))
+	assert.Equal(t, int64(3), GetTotalProofs(header, RelayEvidence))
+}
+
 func TestSetGetSession(t *testing.T) {
 	InitCacheTest()
 	session := NewTestSession(t, hex.EncodeToString(Hash([]byte("foo"))))
@@ -23,6 +23,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*Proof)(nil), nil)
 	cdc.RegisterConcrete(RelayProof{}, "pocketcore/relay_proof", nil)
 	cdc.RegisterConcrete(ChallengeProofInvalidData{}, "pocketcore/challenge_proof_invalid_data", nil)
+	cdc.RegisterConcrete(AppMisbehaviorProof{}, "pocketcore/app_misbehavior_proof", nil)
 	cdc.RegisterInterface((*exported.ValidatorI)(nil), nil)
 	cdc.RegisterConcrete(nodesTypes.Validator{}, "pos/Validator", nil) // todo does this really need to depend on nodes/types
 }
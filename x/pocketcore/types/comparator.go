@@ -0,0 +1,232 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	JSONRPCIDField       = "id" // the json rpc request id, echoed back by the non-native chain
+	JSONRPCAllMethodsKey = "*"  // a field mask key that applies to every json rpc method
+)
+
+// "ResponseComparator" - Normalizes and compares relay responses of a non-native blockchain
+// NOTE: comparators are used in challenge validation, so every node on the network must use the same comparators
+type ResponseComparator interface {
+	Normalize(request Payload, response string) string       // returns the comparable form of the response
+	Equal(request Payload, response1, response2 string) bool // returns true if the responses are semantically equal
+}
+
+// "GetResponseComparator" - Returns the comparator of the chain registry entry of the blockchain, selected by its type
+// NOTE: the chain registry is a governance param, so every node on the network uses the same comparators
+func GetResponseComparator(blockchain Blockchain) ResponseComparator {
+	switch blockchain.Type {
+	case JSONRPCBlockchainType:
+		return JSONRPCComparator{FieldMasks: blockchain.FieldMasks}
+	case RawBlockchainType:
+		return RawComparator{}
+	default:
+		// unregistered blockchains and other json based apis are compared using sorted json
+		return JSONComparator{}
+	}
+}
+
+// "RawComparator" - Compares responses byte for byte
+type RawComparator struct{}
+
+var _ ResponseComparator = RawComparator{}
+
+// "Normalize" - Returns the response as is
+func (rc RawComparator) Normalize(_ Payload, response string) string {
+	return response
+}
+
+// "Equal" - Returns true if the responses are identical
+func (rc RawComparator) Equal(request Payload, response1, response2 string) bool {
+	return rc.Normalize(request, response1) == rc.Normalize(request, response2)
+}
+
+// "JSONComparator" - Compares responses using sorted json
+type JSONComparator struct{}
+
+var _ ResponseComparator = JSONComparator{}
+
+// "Normalize" - Returns the sorted json of the response
+func (jc JSONComparator) Normalize(_ Payload, response string) string {
+	return sortJSONResponse(response)
+}
+
+// "Equal" - Returns true if the sorted json of the responses are identical
+func (jc JSONComparator) Equal(request Payload, response1, response2 string) bool {
+	return jc.Normalize(request, response1) == jc.Normalize(request, response2)
+}
+
+// "FieldMask" - The response fields ignored by the comparison of the responses of a json rpc method
+type FieldMask struct {
+	Method string   `json:"method"` // the json rpc method (e.g. "eth_getBlockByNumber"), "*" masks every method
+	Fields []string `json:"fields"` // the masked fields (e.g. "result.timestamp"), nested fields are separated by a '.'
+}
+
+// "FieldMasks" - The masked fields of the json rpc methods of a blockchain
+type FieldMasks []FieldMask
+
+// "Validate" - Ensures the methods and fields of the masks are not empty and the methods are unique
+func (fm FieldMasks) Validate() error {
+	methods := make(map[string]struct{}, len(fm))
+	for _, mask := range fm {
+		if strings.TrimSpace(mask.Method) == "" {
+			return fmt.Errorf("a field mask has no method")
+		}
+		if _, found := methods[mask.Method]; found {
+			return fmt.Errorf("the field mask of %s is set more than once", mask.Method)
+		}
+		methods[mask.Method] = struct{}{}
+		for _, field := range mask.Fields {
+			if strings.TrimSpace(field) == "" || strings.Contains(field, "..") || strings.HasPrefix(field, ".") || strings.HasSuffix(field, ".") {
+				return fmt.Errorf("the field %q of the %s mask is invalid", field, mask.Method)
+			}
+		}
+	}
+	return nil
+}
+
+// "Fields" - Returns the masked fields of the method, including the fields masked for every method
+func (fm FieldMasks) Fields(method string) (fields []string) {
+	for _, mask := range fm {
+		if mask.Method == JSONRPCAllMethodsKey || mask.Method == method {
+			fields = append(fields, mask.Fields...)
+		}
+	}
+	return
+}
+
+// "JSONRPCComparator" - Compares json rpc responses, ignoring the request id and the masked fields
+type JSONRPCComparator struct {
+	// the fields ignored for each json rpc method, arrays are masked element by element
+	FieldMasks FieldMasks `json:"field_masks"`
+}
+
+var _ ResponseComparator = JSONRPCComparator{}
+
+// "Normalize" - Returns the sorted json of the response without the id and the masked fields of the requested method
+func (jc JSONRPCComparator) Normalize(request Payload, response string) string {
+	var res interface{}
+	if err := unmarshalJSONNumbers(response, &res); err != nil {
+		return response
+	}
+	methods := jsonRPCMethods(request.Data)
+	switch r := res.(type) {
+	case map[string]interface{}:
+		jc.mask(r, methods)
+	case []interface{}:
+		// batch response
+		for _, elem := range r {
+			if m, ok := elem.(map[string]interface{}); ok {
+				jc.mask(m, methods)
+			}
+		}
+	default:
+		return response
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return response
+	}
+	return string(bz)
+}
+
+// "Equal" - Returns true if the normalized responses are identical
+func (jc JSONRPCComparator) Equal(request Payload, response1, response2 string) bool {
+	return jc.Normalize(request, response1) == jc.Normalize(request, response2)
+}
+
+// "mask" - Removes the id and the masked fields from a single json rpc response
+func (jc JSONRPCComparator) mask(response map[string]interface{}, methods map[string]string) {
+	// find the method of the response using its id
+	method := methods[jsonRPCIDKey(response[JSONRPCIDField])]
+	delete(response, JSONRPCIDField)
+	for _, field := range jc.FieldMasks.Fields(method) {
+		deleteJSONField(response, strings.Split(field, "."))
+	}
+}
+
+// "jsonRPCMethods" - Returns the methods of a json rpc request (single or batch) keyed by the request id
+func jsonRPCMethods(data string) map[string]string {
+	type jsonRPCRequest struct {
+		ID     interface{} `json:"id"`
+		Method string      `json:"method"`
+	}
+	methods := make(map[string]string)
+	var batch []jsonRPCRequest
+	if err := unmarshalJSONNumbers(data, &batch); err != nil {
+		var single jsonRPCRequest
+		if err := unmarshalJSONNumbers(data, &single); err != nil {
+			return methods
+		}
+		batch = []jsonRPCRequest{single}
+	}
+	for _, req := range batch {
+		methods[jsonRPCIDKey(req.ID)] = req.Method
+	}
+	return methods
+}
+
+// "jsonRPCIDKey" - Returns a comparable key for a json rpc id (ids may be strings, numbers or null)
+func jsonRPCIDKey(id interface{}) string {
+	bz, err := json.Marshal(id)
+	if err != nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// "deleteJSONField" - Deletes the field at the path from decoded json
+func deleteJSONField(v interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			delete(t, path[0])
+			return
+		}
+		if child, found := t[path[0]]; found {
+			deleteJSONField(child, path[1:])
+		}
+	case []interface{}:
+		for _, elem := range t {
+			deleteJSONField(elem, path)
+		}
+	}
+}
+
+// "unmarshalJSONNumbers" - Unmarshals json while preserving the exact representation of numbers
+func unmarshalJSONNumbers(data string, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewBufferString(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// "RequestPayload" - Returns the payload of the relay request used to produce the request hash
+func RequestPayload(requestHash string) (Payload, error) {
+	bz, err := hex.DecodeString(requestHash)
+	if err != nil {
+		return Payload{}, err
+	}
+	var relay struct {
+		Payload payload `json:"payload"`
+	}
+	if err := json.Unmarshal(bz, &relay); err != nil {
+		return Payload{}, err
+	}
+	return Payload{
+		Data:    relay.Payload.Data,
+		Method:  relay.Payload.Method,
+		Path:    relay.Payload.Path,
+		Headers: relay.Payload.Headers,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/hex"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetResponseComparator(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	aion := hex.EncodeToString([]byte{03})
+	masks := FieldMasks{{Method: "eth_blockNumber", Fields: []string{"result"}}}
+	registry := ChainRegistry{
+		{ID: ethereum, Name: "ethereum", Type: JSONRPCBlockchainType, FieldMasks: masks},
+		{ID: bitcoin, Name: "bitcoin", Type: RawBlockchainType},
+	}
+	get := func(chain string) ResponseComparator {
+		blockchain, _ := registry.Get(chain)
+		return GetResponseComparator(blockchain)
+	}
+	// the json rpc comparator uses the field masks of the registry entry
+	assert.Equal(t, JSONRPCComparator{FieldMasks: masks}, get(ethereum))
+	assert.Equal(t, RawComparator{}, get(bitcoin))
+	assert.Equal(t, JSONComparator{}, get(aion))
+}
+
+func TestFieldMasks_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		masks    FieldMasks
+		hasError bool
+	}{
+		{"no method", FieldMasks{{Fields: []string{"result"}}}, true},
+		{"duplicate method", FieldMasks{{Method: "getinfo"}, {Method: "getinfo"}}, true},
+		{"empty field", FieldMasks{{Method: "getinfo", Fields: []string{" "}}}, true},
+		{"empty nested field", FieldMasks{{Method: "getinfo", Fields: []string{"result..connections"}}}, true},
+		{"valid", FieldMasks{{Method: JSONRPCAllMethodsKey, Fields: []string{"result.peers"}}, {Method: "getinfo", Fields: []string{"result.connections"}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.hasError, tt.masks.Validate() != nil)
+		})
+	}
+	// the masks are only used by the json rpc comparator
+	blockchain := Blockchain{ID: hex.EncodeToString([]byte{01}), Name: "bitcoin", Type: RawBlockchainType, FieldMasks: FieldMasks{{Method: "getinfo"}}}
+	assert.NotNil(t, blockchain.Validate())
+	blockchain.Type = JSONRPCBlockchainType
+	assert.Nil(t, blockchain.Validate())
+}
+
+func TestJSONRPCComparator_Equal(t *testing.T) {
+	comparator := JSONRPCComparator{FieldMasks: FieldMasks{
+		{Method: JSONRPCAllMethodsKey, Fields: []string{"result.peers"}},
+		{Method: "eth_getBlockByNumber", Fields: []string{"result.timestamp", "result.transactions.blockHash"}},
+	}}
+	blockRequest := Payload{Data: `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["latest",true],"id":1}`}
+	balanceRequest := Payload{Data: `{"jsonrpc":"2.0","method":"eth_getBalance","params":["0x0","latest"],"id":"a"}`}
+	batchRequest := Payload{Data: `[{"jsonrpc":"2.0","method":"eth_getBlockByNumber","id":1},{"jsonrpc":"2.0","method":"eth_getBalance","id":2}]`}
+	tests := []struct {
+		name      string
+		request   Payload
+		response1 string
+		response2 string
+		equal     bool
+	}{
+		{
+			name:      "ignores the id",
+			request:   balanceRequest,
+			response1: `{"jsonrpc":"2.0","id":"a","result":"0x1"}`,
+			response2: `{"result":"0x1","id":"b","jsonrpc":"2.0"}`,
+			equal:     true,
+		},
+		{
+			name:      "detects different results",
+			request:   balanceRequest,
+			response1: `{"jsonrpc":"2.0","id":"a","result":"0x1"}`,
+			response2: `{"jsonrpc":"2.0","id":"a","result":"0x2"}`,
+			equal:     false,
+		},
+		{
+			name:      "ignores the method masks",
+			request:   blockRequest,
+			response1: `{"jsonrpc":"2.0","id":1,"result":{"number":"0x1","timestamp":"0x5","transactions":[{"hash":"0xa","blockHash":"0xb"}]}}`,
+			response2: `{"jsonrpc":"2.0","id":1,"result":{"number":"0x1","timestamp":"0x6","transactions":[{"hash":"0xa","blockHash":"0xc"}]}}`,
+			equal:     true,
+		},
+		{
+			name:      "does not apply the masks of other methods",
+			request:   balanceRequest,
+			response1: `{"jsonrpc":"2.0","id":"a","result":{"timestamp":"0x5"}}`,
+			response2: `{"jsonrpc":"2.0","id":"a","result":{"timestamp":"0x6"}}`,
+			equal:     false,
+		},
+		{
+			name:      "applies the masks of all methods",
+			request:   balanceRequest,
+			response1: `{"jsonrpc":"2.0","id":"a","result":{"peers":5}}`,
+			response2: `{"jsonrpc":"2.0","id":"a","result":{"peers":6}}`,
+			equal:     true,
+		},
+		{
+			name:      "masks batch responses by id",
+			request:   batchRequest,
+			response1: `[{"jsonrpc":"2.0","id":1,"result":{"timestamp":"0x5"}},{"jsonrpc":"2.0","id":2,"result":"0x1"}]`,
+			response2: `[{"jsonrpc":"2.0","id":1,"result":{"timestamp":"0x6"}},{"jsonrpc":"2.0","id":2,"result":"0x1"}]`,
+			equal:     true,
+		},
+		{
+			name:      "preserves large numbers",
+			request:   balanceRequest,
+			response1: `{"jsonrpc":"2.0","id":"a","result":12345678901234567890}`,
+			response2: `{"jsonrpc":"2.0","id":"a","result":12345678901234567891}`,
+			equal:     false,
+		},
+		{
+			name:      "compares non json responses as is",
+			request:   Payload{},
+			response1: `not json`,
+			response2: `not json`,
+			equal:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.equal, comparator.Equal(tt.request, tt.response1, tt.response2))
+		})
+	}
+}
+
+func TestRawComparator_Equal(t *testing.T) {
+	comparator := RawComparator{}
+	assert.True(t, comparator.Equal(Payload{}, `{"a":1,"b":2}`, `{"a":1,"b":2}`))
+	assert.False(t, comparator.Equal(Payload{}, `{"a":1,"b":2}`, `{"b":2,"a":1}`))
+}
+
+func TestJSONComparator_Equal(t *testing.T) {
+	comparator := JSONComparator{}
+	assert.True(t, comparator.Equal(Payload{}, `{"a":1,"b":2}`, `{"b":2,"a":1}`))
+	assert.False(t, comparator.Equal(Payload{}, `{"a":1,"b":2}`, `{"a":1,"b":3}`))
+}
+
+func TestRequestPayload(t *testing.T) {
+	relay := Relay{
+		Payload: Payload{
+			Data:    `{"jsonrpc":"2.0","method":"eth_blockNumber","id":1}`,
+			Method:  "POST",
+			Headers: map[string]string{"Content-Type": "application/json"},
+		},
+		Meta: RelayMeta{BlockHeight: 1},
+	}
+	p, err := RequestPayload(relay.RequestHashString())
+	assert.Nil(t, err)
+	assert.Equal(t, relay.Payload, p)
+	_, err = RequestPayload("invalid")
+	assert.NotNil(t, err)
+}
@@ -90,6 +90,16 @@ const (
 	CodeReplayAttackError                = 86
 	CodeInvalidNetworkIDError            = 87
 	CodeInvalidExpirationHeightErr       = 88
+	CodeDeprecatedBlockchainError        = 89
+	CodeInvalidRegistryBlockchainError   = 90
+	CodeMismatchedResponseProofError     = 91
+	CodeTokenOverServiceError            = 92
+	CodeRevokedTokenError                = 93
+	CodeInvalidMisbehaviorProofError     = 94
+	CodeInvalidParamChangeError          = 95
+	CodeSignerError                      = 96
+	CodeInvalidLivenessProbeError        = 97
+	CodeDuplicateClaimError              = 98
 )
 
 var (
@@ -98,6 +108,18 @@ var (
 	MissingApplicationPublicKeyError = errors.New("the applicaiton public key included in the AAT is not valid")
 	MissingClientPublicKeyError      = errors.New("the client public key included in the AAT is not valid")
 	InvalidTokenSignatureErorr       = errors.New("the application signature on the AAT is not valid")
+	UnsupportedTokenScopeError       = errors.New("the scope fields of the AAT are not supported by the token version")
+	InvalidTokenExpirationError      = errors.New("the expiration height of the AAT is negative")
+	InvalidTokenMaxRelaysError       = errors.New("the max relays of the AAT is negative")
+	ExpiredTokenError                = errors.New("the AAT is expired for the session")
+	UnauthorizedTokenChainError      = errors.New("the blockchain is not authorized by the AAT")
+	TokenOverServiceError            = errors.New("the max relays of the AAT has been reached for this session")
+	RevokedTokenError                = errors.New("the AAT has been revoked by the application")
+	InvalidMisbehaviorProofError     = errors.New("the misbehavior proof does not prove the misbehavior of the application")
+	InvalidParamChangeError          = errors.New("the param change is invalid")
+	SignerError                      = errors.New("the signer of the servicer key failed")
+	InvalidLivenessProbeError        = errors.New("the liveness probe is invalid")
+	DuplicateClaimError              = errors.New("the session was already claimed with another servicer key of the node")
 	NegativeICCounterError           = errors.New("the IC counter is less than 0")
 	MaximumEntropyError              = errors.New("the entropy exceeds the maximum allowed relays")
 	NodeNotInSessionError            = errors.New("the node is not within the session")
@@ -180,8 +202,23 @@ var (
 	InvalidEvidenceErr               = errors.New("the evidence type passed is not valid")
 	ReplayAttackError                = errors.New("the merkle proof is flagged as a replay attack")
 	InvalidExpirationHeightErr       = errors.New("the expiration height included in the claim message is invalid (should not be set)")
+	DeprecatedBlockchainError        = errors.New("the blockchain is deprecated in the chain registry")
+	InvalidRegistryBlockchainError   = errors.New("the chain registry entry is invalid: ")
+	MismatchedResponseProofError     = errors.New("the proof of the relay response does not match the proof of the relay")
 )
 
+func NewMismatchedResponseProofError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMismatchedResponseProofError, MismatchedResponseProofError.Error())
+}
+
+func NewDeprecatedBlockchainError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeDeprecatedBlockchainError, DeprecatedBlockchainError.Error())
+}
+
+func NewInvalidRegistryBlockchainError(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRegistryBlockchainError, InvalidRegistryBlockchainError.Error()+err.Error())
+}
+
 func NewUnsupportedBlockchainError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeUnsupportedBlockchainError, UnsupportedBlockchainError.Error())
 }
@@ -387,6 +424,10 @@ func NewInvalidEntropyError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidEntropyError, InvalidEntropyError.Error())
 }
 
+func NewMaximumEntropyError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMaximumEntropyError, MaximumEntropyError.Error())
+}
+
 func NewHTTPExecutionError(codespace sdk.CodespaceType, err error) sdk.Error {
 	return sdk.NewError(codespace, CodeHTTPExecutionError, HTTPExecutionError.Error()+err.Error())
 }
@@ -461,3 +502,31 @@ func NewInvalidTokenError(codespace sdk.CodespaceType, err error) sdk.Error {
 func NewInvalidPKError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidPkFileErr, InvalidPkFileErr.Error())
 }
+
+func NewTokenOverServiceError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenOverServiceError, TokenOverServiceError.Error())
+}
+
+func NewRevokedTokenError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRevokedTokenError, RevokedTokenError.Error())
+}
+
+func NewInvalidMisbehaviorProofError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMisbehaviorProofError, InvalidMisbehaviorProofError.Error())
+}
+
+func NewInvalidParamChangeError(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamChangeError, InvalidParamChangeError.Error()+": "+err.Error())
+}
+
+func NewSignerError(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeSignerError, SignerError.Error()+": "+err.Error())
+}
+
+func NewInvalidLivenessProbeError(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidLivenessProbeError, InvalidLivenessProbeError.Error()+": "+err.Error())
+}
+
+func NewDuplicateClaimError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateClaimError, DuplicateClaimError.Error())
+}
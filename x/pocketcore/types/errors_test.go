@@ -45,3 +45,15 @@ func TestInvalidHashLengthError(t *testing.T) {
 func TestInvalidAppPubKeyError(t *testing.T) {
 	assert.Equal(t, NewInvalidAppPubKeyError(ModuleName), sdk.NewError(ModuleName, CodeInvalidAppPubKeyError, InvalidAppPubKeyError.Error()))
 }
+
+func TestMismatchedResponseProofError(t *testing.T) {
+	assert.Equal(t, NewMismatchedResponseProofError(ModuleName), sdk.NewError(ModuleName, CodeMismatchedResponseProofError, MismatchedResponseProofError.Error()))
+}
+
+func TestTokenOverServiceError(t *testing.T) {
+	assert.Equal(t, NewTokenOverServiceError(ModuleName), sdk.NewError(ModuleName, CodeTokenOverServiceError, TokenOverServiceError.Error()))
+}
+
+func TestRevokedTokenError(t *testing.T) {
+	assert.Equal(t, NewRevokedTokenError(ModuleName), sdk.NewError(ModuleName, CodeRevokedTokenError, RevokedTokenError.Error()))
+}
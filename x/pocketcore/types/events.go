@@ -4,4 +4,5 @@ const (
 	EventTypeClaim        = MsgClaimName // an event for emitting a claim message
 	EventTypeProof        = MsgProofName // an event for emitting a proof message
 	AttributeKeyValidator = "validator"  // a validator attribute
+	AttributeKeyChain     = "chain"      // a session chain attribute
 )
@@ -5,6 +5,13 @@ type Evidence struct {
 	SessionHeader `json:"evidence_header"` // the session h serves as an identifier for the evidence
 	NumOfProofs   int64                    `json:"num_of_proofs"` // the total number of proofs in the evidence
 	Proofs        []Proof                  `json:"proofs"`        // a slice of Proof objects (Proof per relay or challenge)
+	TokenRelays   []TokenRelays            `json:"token_relays"`  // the number of relay proofs per token
+}
+
+// "TokenRelays" - The number of relay proofs in the evidence that were authorized by a token
+type TokenRelays struct {
+	TokenHash string `json:"token_hash"` // the hash of the aat
+	Count     int64  `json:"count"`      // the number of relay proofs
 }
 
 // "GenerateMerkleRoot" - Generates the merkle root for an evidence object
@@ -24,6 +31,28 @@ func (e *Evidence) AddProof(p Proof) {
 	e.Proofs = append(e.Proofs, p)
 	// increment total proof count
 	e.NumOfProofs = e.NumOfProofs + 1
+	// increment the relay count of the token
+	if rp, ok := p.(RelayProof); ok {
+		tokenHash := rp.Token.HashString()
+		for i := range e.TokenRelays {
+			if e.TokenRelays[i].TokenHash == tokenHash {
+				e.TokenRelays[i].Count++
+				return
+			}
+		}
+		e.TokenRelays = append(e.TokenRelays, TokenRelays{TokenHash: tokenHash, Count: 1})
+	}
+}
+
+// "TotalTokenRelays" - Returns the number of relay proofs in the evidence that were authorized by the token
+func (e Evidence) TotalTokenRelays(token AAT) int64 {
+	tokenHash := token.HashString()
+	for _, tr := range e.TokenRelays {
+		if tr.TokenHash == tokenHash {
+			return tr.Count
+		}
+	}
+	return 0
 }
 
 // "GenerateMerkleProof" - Generates the merkle Proof for an evidence
@@ -35,12 +64,13 @@ func (e *Evidence) GenerateMerkleProof(index int) (proofs MerkleProofs, cousinIn
 	return
 }
 
-// "EvidenceType" type to distinguish the types of evidence (relay/challenge)
+// "EvidenceType" type to distinguish the types of evidence (relay/challenge/misbehavior)
 type EvidenceType int
 
 const (
 	RelayEvidence EvidenceType = iota + 1 // essentially an enum for evidence types
 	ChallengeEvidence
+	MisbehaviorEvidence
 )
 
 // "Convert evidence type to bytes
@@ -50,6 +80,8 @@ func (et EvidenceType) Byte() byte {
 		return 0
 	case ChallengeEvidence:
 		return 1
+	case MisbehaviorEvidence:
+		return 2
 	default:
 		panic("unrecognized evidence type")
 	}
@@ -62,3 +94,8 @@ type Receipt struct {
 	Total           int64           `json:"total"`         // the number of proofs
 	EvidenceType    EvidenceType    `json:"evidence_type"` // the type (relay/challenge)
 }
+
+// "IsValid" - Returns true if the evidence type is known
+func (et EvidenceType) IsValid() bool {
+	return et == RelayEvidence || et == ChallengeEvidence || et == MisbehaviorEvidence
+}
@@ -8,8 +8,12 @@ import (
 
 type PosKeeper interface {
 	RewardForRelays(ctx sdk.Ctx, relays sdk.Int, address sdk.Address)
+	RewardForPrepaidRelays(ctx sdk.Ctx, tokens sdk.Int, address sdk.Address)
 	GetStakedTokens(ctx sdk.Ctx) sdk.Int
 	Validator(ctx sdk.Ctx, addr sdk.Address) nodesexported.ValidatorI
+	ValidatorByServicerAddress(ctx sdk.Ctx, servicerAddr sdk.Address) nodesexported.ValidatorI
+	ValidatorByRetiredServicerAddress(ctx sdk.Ctx, servicerAddr sdk.Address) nodesexported.ValidatorI
+	GetRetiredServicerAddresses(ctx sdk.Ctx, addr sdk.Address) (servicerAddrs []sdk.Address)
 	TotalTokens(ctx sdk.Ctx) sdk.Int
 	BurnForChallenge(ctx sdk.Ctx, challenges sdk.Int, address sdk.Address)
 	JailValidator(ctx sdk.Ctx, addr sdk.Address)
@@ -17,6 +21,7 @@ type PosKeeper interface {
 	GetStakedValidators(ctx sdk.Ctx) (validators []nodesexported.ValidatorI)
 	BlocksPerSession(ctx sdk.Ctx) (res int64)
 	StakeDenom(ctx sdk.Ctx) (res string)
+	MessageFee(ctx sdk.Ctx, msgType string) sdk.Int
 }
 
 type AppsKeeper interface {
@@ -25,4 +30,10 @@ type AppsKeeper interface {
 	AllApplications(ctx sdk.Ctx) (applications []appexported.ApplicationI)
 	TotalTokens(ctx sdk.Ctx) sdk.Int
 	JailApplication(ctx sdk.Ctx, addr sdk.Address)
+	HandleApplicationMisbehavior(ctx sdk.Ctx, addr, reporter sdk.Address, sessionBlockHeight, misbehaviors int64)
+	IsAATRevoked(ctx sdk.Ctx, appAddr sdk.Address, clientPubKey string) bool
+	IsPrepaid(ctx sdk.Ctx, addr sdk.Address) bool
+	PayRelaysFromEscrow(ctx sdk.Ctx, appAddr sdk.Address, servicer sdk.Address, relays int64) sdk.Int
+	ReserveEscrowRelays(ctx sdk.Ctx, appAddr sdk.Address, relays int64)
+	ReleaseEscrowRelays(ctx sdk.Ctx, appAddr sdk.Address, relays int64)
 }
@@ -1,14 +1,25 @@
 package types
 
+import (
+	coreTypes "github.com/pokt-network/pocket-core/types"
+)
+
+// default fees of the messages (in uPOKT), the fees in state are the MessageFees param
 const (
 	ClaimFee = 100000 // fee for claim message (in uPOKT)
 	ProofFee = 100000 // fee for proof message (in uPOKT)
 )
 
 var (
-	// map of message name to fee value
-	PocketFeeMap = map[string]int64{
-		MsgClaimName: ClaimFee,
-		MsgProofName: ProofFee,
+	// default fees of the messages of the module
+	DefaultMessageFees = MessageFees{
+		{MsgType: MsgClaimName, Fee: ClaimFee},
+		{MsgType: MsgProofName, Fee: ProofFee},
 	}
 )
+
+// "MessageFee" - The fee of a message type (in uPOKT)
+type MessageFee = coreTypes.MessageFee
+
+// "MessageFees" - The fees of the messages of the module
+type MessageFees = coreTypes.MessageFees
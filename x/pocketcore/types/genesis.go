@@ -6,9 +6,10 @@ import (
 
 // "GenesisState" - The state of the module from the beginning
 type GenesisState struct {
-	Params   Params     `json:"params" yaml:"params"` // governance params
-	Receipts []Receipt  `json:"receipts"`             // verified proofs
-	Claims   []MsgClaim `json:"claims"`               // outstanding claims
+	Params    Params     `json:"params" yaml:"params"` // governance params
+	Receipts  []Receipt  `json:"receipts"`             // verified proofs
+	Claims    []MsgClaim `json:"claims"`               // outstanding claims
+	AppUsages []AppUsage `json:"app_usages"`           // relays consumed by applications
 }
 
 // "ValidateGenesis" - Returns an error on an invalid genesis object
@@ -33,7 +34,7 @@ func ValidateGenesis(gs GenesisState) error {
 			return errors.New("total relays for receipt is not positive")
 		}
 		// test byte conversion of evidence
-		if reciept.EvidenceType != RelayEvidence && reciept.EvidenceType != ChallengeEvidence {
+		if !reciept.EvidenceType.IsValid() {
 			return NewInvalidEvidenceErr(ModuleName)
 		}
 	}
@@ -43,6 +44,12 @@ func ValidateGenesis(gs GenesisState) error {
 			return err
 		}
 	}
+	// validate each app usage
+	for _, usage := range gs.AppUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
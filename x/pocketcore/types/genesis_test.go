@@ -25,7 +25,7 @@ func TestValidateGenesis(t *testing.T) {
 		Params: Params{
 			SessionNodeCount:      0,
 			ClaimSubmissionWindow: 0,
-			SupportedBlockchains:  nil,
+			ChainRegistry:         nil,
 			ClaimExpiration:       0,
 		},
 		Receipts: []Receipt{{
@@ -54,8 +54,9 @@ func TestValidateGenesis(t *testing.T) {
 		Params: Params{
 			SessionNodeCount:      1,
 			ClaimSubmissionWindow: 5,
-			SupportedBlockchains:  []string{nn},
+			ChainRegistry:         NewLegacyChainRegistry([]string{nn}),
 			ClaimExpiration:       50,
+			MessageFees:           DefaultMessageFees,
 		},
 		Receipts: []Receipt{{
 			SessionHeader: SessionHeader{
@@ -83,8 +84,9 @@ func TestValidateGenesis(t *testing.T) {
 		Params: Params{
 			SessionNodeCount:      1,
 			ClaimSubmissionWindow: 5,
-			SupportedBlockchains:  []string{nn},
+			ChainRegistry:         NewLegacyChainRegistry([]string{nn}),
 			ClaimExpiration:       50,
+			MessageFees:           DefaultMessageFees,
 		},
 		Receipts: []Receipt{{
 			SessionHeader: SessionHeader{
@@ -112,8 +114,9 @@ func TestValidateGenesis(t *testing.T) {
 		Params: Params{
 			SessionNodeCount:      1,
 			ClaimSubmissionWindow: 5,
-			SupportedBlockchains:  []string{nn},
+			ChainRegistry:         NewLegacyChainRegistry([]string{nn}),
 			ClaimExpiration:       50,
+			MessageFees:           DefaultMessageFees,
 		},
 		Receipts: []Receipt{{
 			SessionHeader: SessionHeader{
@@ -137,6 +140,13 @@ func TestValidateGenesis(t *testing.T) {
 			EvidenceType: RelayEvidence,
 		}},
 	}
+	invalidAppUsages := validGenesisState
+	invalidAppUsages.AppUsages = []AppUsage{{
+		ApplicationPubKey:  appPubKeyProof,
+		SessionBlockHeight: 0,
+		TotalRelays:        100,
+		Receipts:           1,
+	}}
 	tests := []struct {
 		name         string
 		genesisState GenesisState
@@ -157,6 +167,11 @@ func TestValidateGenesis(t *testing.T) {
 			genesisState: invalidClaims,
 			hasError:     true,
 		},
+		{
+			name:         "Bad app usages",
+			genesisState: invalidAppUsages,
+			hasError:     true,
+		},
 		{
 			name:         "Valid genesis state",
 			genesisState: validGenesisState,
@@ -186,8 +201,9 @@ func TestDefaultGenesisState(t *testing.T) {
 		Params: Params{
 			SessionNodeCount:      1,
 			ClaimSubmissionWindow: 5,
-			SupportedBlockchains:  []string{nn},
+			ChainRegistry:         NewLegacyChainRegistry([]string{nn}),
 			ClaimExpiration:       50,
+			MessageFees:           DefaultMessageFees,
 		},
 		Receipts: []Receipt{{
 			SessionHeader: SessionHeader{
@@ -212,9 +228,10 @@ func TestDefaultGenesisState(t *testing.T) {
 	DefaultGenState := GenesisState{Params: Params{
 		SessionNodeCount:           DefaultSessionNodeCount,
 		ClaimSubmissionWindow:      DefaultClaimSubmissionWindow,
-		SupportedBlockchains:       DefaultSupportedBlockchains,
 		ClaimExpiration:            DefaultClaimExpiration,
 		ReplayAttackBurnMultiplier: DefaultReplayAttackBurnMultiplier,
+		MessageFees:                DefaultMessageFees,
+		AppUsageRetention:          DefaultAppUsageRetention,
 	}}
 	tests := []struct {
 		name         string
@@ -11,8 +11,9 @@ const (
 )
 
 var (
-	ReceiptKey = []byte{0x01} // key for the verified and stored evidence
-	ClaimKey   = []byte{0x02} // key for pending claims
+	ReceiptKey  = []byte{0x01} // key for the verified and stored evidence
+	ClaimKey    = []byte{0x02} // key for pending claims
+	AppUsageKey = []byte{0x03} // key for the relays consumed by applications
 )
 
 // "KeyForReceipt" - Generates a key for the receipt object for the state store
@@ -26,7 +27,7 @@ func KeyForReceipt(ctx sdk.Ctx, addr sdk.Address, header SessionHeader, evidence
 		return nil, err
 	}
 	// validate the evidence type
-	if evidenceType != RelayEvidence && evidenceType != ChallengeEvidence {
+	if !evidenceType.IsValid() {
 		return nil, NewInvalidEvidenceErr(ModuleName)
 	}
 	// return the key bz
@@ -54,7 +55,7 @@ func KeyForClaim(ctx sdk.Ctx, addr sdk.Address, header SessionHeader, evidenceTy
 		return nil, err
 	}
 	// validate the evidence type
-	if evidenceType != RelayEvidence && evidenceType != ChallengeEvidence {
+	if !evidenceType.IsValid() {
 		return nil, NewInvalidEvidenceErr(ModuleName)
 	}
 	// return the key bz
@@ -74,8 +75,33 @@ func KeyForClaims(addr sdk.Address) ([]byte, error) {
 // "KeyForEvidence" - Generates the key for evidence
 func KeyForEvidence(header SessionHeader, evidenceType EvidenceType) ([]byte, error) {
 	// validate the evidence type
-	if evidenceType != RelayEvidence && evidenceType != ChallengeEvidence {
+	if !evidenceType.IsValid() {
 		return nil, NewInvalidEvidenceErr(ModuleName)
 	}
 	return append(header.Hash(), evidenceType.Byte()), nil
 }
+
+// "KeyForAppUsage" - Generates the key for the app usage object of a session
+func KeyForAppUsage(appAddr sdk.Address, sessionBlockHeight int64) ([]byte, error) {
+	// validate the session block height
+	if sessionBlockHeight < 1 {
+		return nil, NewInvalidBlockHeightError(ModuleName)
+	}
+	// get the key for the app usages of the address
+	key, err := KeyForAppUsages(appAddr)
+	if err != nil {
+		return nil, err
+	}
+	// return the key bz
+	return append(key, sdk.Uint64ToBigEndian(uint64(sessionBlockHeight))...), nil
+}
+
+// "KeyForAppUsages" - Generates the key for the app usage objects of an application
+func KeyForAppUsages(appAddr sdk.Address) ([]byte, error) {
+	// verify the address
+	if err := AddressVerification(appAddr.String()); err != nil {
+		return nil, err
+	}
+	// return the key bz
+	return append(AppUsageKey, appAddr.Bytes()...), nil
+}
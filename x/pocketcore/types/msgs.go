@@ -26,7 +26,7 @@ type MsgClaim struct {
 
 // "GetFee" - Returns the fee (sdk.Int) of the messgae type
 func (msg MsgClaim) GetFee() sdk.Int {
-	return sdk.NewInt(PocketFeeMap[msg.Type()])
+	return DefaultMessageFees.Amount(msg.Type())
 }
 
 // "Route" - Returns module router key
@@ -69,7 +69,7 @@ func (msg MsgClaim) ValidateBasic() sdk.Error {
 	if msg.EvidenceType == 0 {
 		return NewNoEvidenceTypeErr(ModuleName)
 	}
-	if msg.EvidenceType != RelayEvidence && msg.EvidenceType != ChallengeEvidence {
+	if !msg.EvidenceType.IsValid() {
 		return NewInvalidEvidenceErr(ModuleName)
 	}
 	if msg.ExpirationHeight != 0 {
@@ -99,7 +99,7 @@ type MsgProof struct {
 
 // "GetFee" - Returns the fee (sdk.Int) of the messgae type
 func (msg MsgProof) GetFee() sdk.Int {
-	return sdk.NewInt(PocketFeeMap[msg.Type()])
+	return DefaultMessageFees.Amount(msg.Type())
 }
 
 // "Route" - Returns module router key
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	coreTypes "github.com/pokt-network/pocket-core/types"
+)
+
+// "ChangeParam" - Returns a copy of the params with the param of the key changed to the amino json value, along with
+// the typed value; the value is decoded into the type of the param and the resulting params must be valid
+func (p Params) ChangeParam(key string, value []byte) (Params, interface{}, error) {
+	v, err := coreTypes.ChangeParam(ModuleCdc, &p, key, value)
+	return p, v, err
+}
+
+// "ParamChangeEffect" - The effect of a param change on a single node or application
+type ParamChangeEffect = coreTypes.ParamChangeEffect
+
+// "ParamChangeReport" - The simulated outcome of a param change against the current state
+type ParamChangeReport struct {
+	ParamKey      string              `json:"param_key"`
+	Params        Params              `json:"params"`         // the params after the change
+	AffectedNodes []ParamChangeEffect `json:"affected_nodes"` // the nodes affected by the change
+	AffectedApps  []ParamChangeEffect `json:"affected_apps"`  // the applications affected by the change
+}
+
+// "String" - Returns a human readable param change report
+func (r ParamChangeReport) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Param Change %s:\n%s\n", r.ParamKey, r.Params.String()))
+	b.WriteString(fmt.Sprintf("Affected Nodes: %d\n", len(r.AffectedNodes)))
+	for _, e := range r.AffectedNodes {
+		b.WriteString(fmt.Sprintf("  %s: %s\n", e.Address, e.Effect))
+	}
+	b.WriteString(fmt.Sprintf("Affected Applications: %d\n", len(r.AffectedApps)))
+	for _, e := range r.AffectedApps {
+		b.WriteString(fmt.Sprintf("  %s: %s\n", e.Address, e.Effect))
+	}
+	return strings.TrimSpace(b.String())
+}
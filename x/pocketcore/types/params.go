@@ -15,26 +15,32 @@ const (
 	DefaultClaimSubmissionWindow      = int64(3)   // default sessions to submit a claim
 	DefaultClaimExpiration            = int64(100) // default sessions to exprie claims
 	DefaultReplayAttackBurnMultiplier = int64(3)   // default replay attack burn multiplier
+	DefaultAppUsageRetention          = int64(100) // default sessions to keep the app usages after the claims expired
 )
 
 var (
-	DefaultSupportedBlockchains   []string
+	DefaultChainRegistry          ChainRegistry
 	KeySessionNodeCount           = []byte("SessionNodeCount")
 	KeyClaimSubmissionWindow      = []byte("ClaimSubmissionWindow")
-	KeySupportedBlockchains       = []byte("SupportedBlockchains")
+	KeySupportedBlockchains       = []byte("SupportedBlockchains") // replaced by the chain registry, only read to migrate a state without a registry
 	KeyClaimExpiration            = []byte("ClaimExpiration")
 	KeyReplayAttackBurnMultiplier = []byte("ReplayAttackBurnMultiplier")
+	KeyChainRegistry              = []byte("ChainRegistry")
+	KeyMessageFees                = []byte("MessageFees")
+	KeyAppUsageRetention          = []byte("AppUsageRetention")
 )
 
 var _ types.ParamSet = (*Params)(nil)
 
 // "Params" - defines the governance set, high level settings for pocketcore module
 type Params struct {
-	SessionNodeCount           int64    `json:"session_node_count"`
-	ClaimSubmissionWindow      int64    `json:"proof_waiting_period"`
-	SupportedBlockchains       []string `json:"supported_blockchains"`
-	ClaimExpiration            int64    `json:"claim_expiration"` // per session
-	ReplayAttackBurnMultiplier int64    `json:"replay_attack_burn_multiplier"`
+	SessionNodeCount           int64         `json:"session_node_count"`
+	ClaimSubmissionWindow      int64         `json:"proof_waiting_period"`
+	ClaimExpiration            int64         `json:"claim_expiration"` // per session
+	ReplayAttackBurnMultiplier int64         `json:"replay_attack_burn_multiplier"`
+	ChainRegistry              ChainRegistry `json:"chain_registry"`      // the supported blockchains and their metadata
+	MessageFees                MessageFees   `json:"message_fees"`        // fees of the messages of the module (in uPOKT)
+	AppUsageRetention          int64         `json:"app_usage_retention"` // per session, after the claims of the session expired
 }
 
 // "ParamSetPairs" - returns an kv params object
@@ -43,9 +49,11 @@ func (p *Params) ParamSetPairs() types.ParamSetPairs {
 	return types.ParamSetPairs{
 		{Key: KeySessionNodeCount, Value: &p.SessionNodeCount},
 		{Key: KeyClaimSubmissionWindow, Value: &p.ClaimSubmissionWindow},
-		{Key: KeySupportedBlockchains, Value: &p.SupportedBlockchains},
 		{Key: KeyClaimExpiration, Value: &p.ClaimExpiration},
-		{Key: KeyReplayAttackBurnMultiplier, Value: p.ReplayAttackBurnMultiplier},
+		{Key: KeyReplayAttackBurnMultiplier, Value: &p.ReplayAttackBurnMultiplier},
+		{Key: KeyChainRegistry, Value: &p.ChainRegistry},
+		{Key: KeyMessageFees, Value: &p.MessageFees},
+		{Key: KeyAppUsageRetention, Value: &p.AppUsageRetention},
 	}
 }
 
@@ -54,9 +62,11 @@ func DefaultParams() Params {
 	return Params{
 		SessionNodeCount:           DefaultSessionNodeCount,
 		ClaimSubmissionWindow:      DefaultClaimSubmissionWindow,
-		SupportedBlockchains:       DefaultSupportedBlockchains,
 		ClaimExpiration:            DefaultClaimExpiration,
 		ReplayAttackBurnMultiplier: DefaultReplayAttackBurnMultiplier,
+		ChainRegistry:              DefaultChainRegistry,
+		MessageFees:                append(MessageFees(nil), DefaultMessageFees...),
+		AppUsageRetention:          DefaultAppUsageRetention,
 	}
 }
 
@@ -70,11 +80,9 @@ func (p Params) Validate() error {
 	if p.ClaimSubmissionWindow < 2 {
 		return errors.New("waiting period must be at least 2 sessions")
 	}
-	// verify each supported blockchain
-	for _, chain := range p.SupportedBlockchains {
-		if err := NetworkIdentifierVerification(chain); err != nil {
-			return err
-		}
+	// verify each chain registry entry
+	if err := p.ChainRegistry.Validate(); err != nil {
+		return err
 	}
 	// ensure replay attack burn multiplier is above 0
 	if p.ReplayAttackBurnMultiplier < 0 {
@@ -87,6 +95,14 @@ func (p Params) Validate() error {
 	if p.ClaimExpiration < p.ClaimSubmissionWindow {
 		return errors.New("unverified Proof expiration is far too short, must be greater than Proof waiting period")
 	}
+	// ensure app usage retention
+	if p.AppUsageRetention < 0 {
+		return errors.New("invalid app usage retention")
+	}
+	// verify the fee of each message
+	if err := p.MessageFees.Validate(DefaultMessageFees); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -102,13 +118,17 @@ func (p Params) String() string {
 	return fmt.Sprintf(`Params:
   SessionNodeCount:          %d
   ClaimSubmissionWindow:        %d
-  Supported Blockchains      %v
   ClaimExpiration            %d
   ReplayAttackBurnMultiplier %d
+  ChainRegistry              %v
+  MessageFees                %s
+  AppUsageRetention          %d
 `,
 		p.SessionNodeCount,
 		p.ClaimSubmissionWindow,
-		p.SupportedBlockchains,
 		p.ClaimExpiration,
-		p.ReplayAttackBurnMultiplier)
+		p.ReplayAttackBurnMultiplier,
+		p.ChainRegistry,
+		p.MessageFees,
+		p.AppUsageRetention)
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"reflect"
 	"testing"
 )
 
@@ -19,7 +20,7 @@ func TestParams_Equal(t *testing.T) {
 func TestParams_Validate(t *testing.T) {
 	ethereum := hex.EncodeToString([]byte{01})
 	validParams := DefaultParams()
-	validParams.SupportedBlockchains = []string{ethereum}
+	validParams.ChainRegistry = NewLegacyChainRegistry([]string{ethereum})
 	// invalid session node count
 	invalidParamsSessionNodes := validParams
 	invalidParamsSessionNodes.SessionNodeCount = -1
@@ -28,10 +29,16 @@ func TestParams_Validate(t *testing.T) {
 	invalidParamsWaitingPeriod.ClaimSubmissionWindow = -1
 	// invalid supported chains
 	invalidParamsSupported := validParams
-	invalidParamsSupported.SupportedBlockchains = []string{"invalid"}
+	invalidParamsSupported.ChainRegistry = NewLegacyChainRegistry([]string{"invalid"})
 	// invalid claim expiration
 	invalidParamsClaims := validParams
 	invalidParamsClaims.ClaimExpiration = -1
+	// invalid chain registry
+	invalidParamsRegistry := validParams
+	invalidParamsRegistry.ChainRegistry = NewLegacyChainRegistry([]string{ethereum, ethereum})
+	// invalid message fees
+	invalidParamsFees := validParams
+	invalidParamsFees.MessageFees = MessageFees{{MsgType: MsgClaimName, Fee: -1}, {MsgType: MsgProofName, Fee: ProofFee}}
 	tests := []struct {
 		name     string
 		params   Params
@@ -57,6 +64,16 @@ func TestParams_Validate(t *testing.T) {
 			params:   invalidParamsClaims,
 			hasError: true,
 		},
+		{
+			name:     "Invalid Params, chain registry",
+			params:   invalidParamsRegistry,
+			hasError: true,
+		},
+		{
+			name:     "Invalid Params, message fees",
+			params:   invalidParamsFees,
+			hasError: true,
+		},
 		{
 			name:     "Valid Params",
 			params:   validParams,
@@ -74,15 +91,43 @@ func TestDefaultParams(t *testing.T) {
 	assert.True(t, Params{
 		SessionNodeCount:           DefaultSessionNodeCount,
 		ClaimSubmissionWindow:      DefaultClaimSubmissionWindow,
-		SupportedBlockchains:       DefaultSupportedBlockchains,
 		ClaimExpiration:            DefaultClaimExpiration,
 		ReplayAttackBurnMultiplier: DefaultReplayAttackBurnMultiplier,
+		MessageFees:                DefaultMessageFees,
+		AppUsageRetention:          DefaultAppUsageRetention,
 	}.Equal(DefaultParams()))
 }
 
 func TestParams_ParamSetPairs(t *testing.T) {
 	df := DefaultParams()
 	assert.NotPanics(t, func() { df.ParamSetPairs() })
+	// the values must be pointers for the paramstore to decode into
+	for _, pair := range df.ParamSetPairs() {
+		assert.Equal(t, reflect.Ptr, reflect.TypeOf(pair.Value).Kind(), string(pair.Key))
+	}
+}
+
+func TestParams_ChangeParam(t *testing.T) {
+	df := DefaultParams()
+	// typed change
+	params, value, err := df.ChangeParam(string(KeyReplayAttackBurnMultiplier), []byte(`"5"`))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), value)
+	assert.Equal(t, int64(5), params.ReplayAttackBurnMultiplier)
+	assert.Equal(t, DefaultReplayAttackBurnMultiplier, df.ReplayAttackBurnMultiplier)
+	params, value, err = df.ChangeParam(string(KeyChainRegistry), []byte(`[{"id":"0001","name":"ethereum","type":"JSON-RPC"}]`))
+	assert.Nil(t, err)
+	assert.Equal(t, ChainRegistry{{ID: "0001", Name: "ethereum", Type: JSONRPCBlockchainType}}, value)
+	assert.Equal(t, []string{"0001"}, params.ChainRegistry.SupportedBlockchains())
+	// the resulting params are invalid
+	_, _, err = df.ChangeParam(string(KeySessionNodeCount), []byte(`"30"`))
+	assert.NotNil(t, err)
+	// the value is not of the param type
+	_, _, err = df.ChangeParam(string(KeySessionNodeCount), []byte(`"five"`))
+	assert.NotNil(t, err)
+	// unknown param
+	_, _, err = df.ChangeParam("UnknownParam", []byte(`"5"`))
+	assert.NotNil(t, err)
 }
 
 func TestParams_String(t *testing.T) {
@@ -0,0 +1,202 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+)
+
+const (
+	ProbeRelayPath = "/v1/client/relay" // the path of the relay endpoint of a servicer
+	ProbePayload   = "{}"               // the payload of the probe relay, any answer of the chain proves the relay is served
+)
+
+var (
+	globalProberEnabled    bool          // is the off-chain liveness prober enabled
+	globalProberSampleSize int           // the number of servicers probed each session
+	globalProberTimeout    time.Duration // the http timeout of a single probe
+	globalProbesServed     = probesServed{probers: make(map[string]int64)}
+)
+
+// "probesServed" - The probers served by this servicer in the latest session, to answer one probe per prober per session
+type probesServed struct {
+	l       sync.Mutex
+	probers map[string]int64 // prober address -> session block height
+}
+
+// "InitProber" - Initializes the off-chain servicer liveness prober configuration
+func InitProber(enabled bool, sampleSize int, timeoutMillis int64) {
+	globalProberEnabled = enabled
+	globalProberSampleSize = sampleSize
+	globalProberTimeout = time.Duration(timeoutMillis) * time.Millisecond
+}
+
+// "ProberEnabled" - Returns true if the off-chain servicer liveness prober is enabled
+func ProberEnabled() bool {
+	return globalProberEnabled && globalProberSampleSize > 0
+}
+
+// "ProberSampleSize" - Returns the number of servicers probed each session
+func ProberSampleSize() int {
+	return globalProberSampleSize
+}
+
+// "ProbeServicer" - Probes the service url of a servicer with a signed relay to a random chain the servicer is staked for
+// Returns the json encoded probe and the failure of the probe (empty if the servicer answered with a response signed by
+// its servicer key)
+func ProbeServicer(serviceURL, servicerPubKey string, chains []string, sessionBlockHeight, blockHeight int64, kp crypto.PrivateKey) (probe []byte, failure string, err error) {
+	if len(chains) == 0 {
+		return nil, "", fmt.Errorf("the servicer is not staked for any chain")
+	}
+	relay, err := newProbeRelay(servicerPubKey, chains[rand.Intn(len(chains))], sessionBlockHeight, blockHeight, kp)
+	if err != nil {
+		return nil, "", err
+	}
+	probe, err = json.Marshal(relay)
+	if err != nil {
+		return nil, "", err
+	}
+	c := http.Client{Timeout: globalProberTimeout}
+	resp, err := c.Post(strings.TrimRight(serviceURL, "/")+ProbeRelayPath, "application/json", bytes.NewBuffer(probe))
+	if err != nil {
+		if e, ok := err.(net.Error); ok && e.Timeout() {
+			return probe, nodesTypes.ProbeFailureTimeout, nil
+		}
+		return probe, nodesTypes.ProbeFailureUnreachable, nil
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return probe, nodesTypes.ProbeFailureTimeout, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return probe, nodesTypes.ProbeFailureRejected, nil
+	}
+	// the servicer must answer with a response signed by its servicer key
+	var rr RelayResponse
+	if err := json.Unmarshal(body, &rr); err != nil || rr.Verify(relay) != nil {
+		return probe, nodesTypes.ProbeFailureResponse, nil
+	}
+	return probe, "", nil
+}
+
+// "newProbeRelay" - Creates a probe relay signed by the prober, with an application authentication token self issued
+// by the servicer key of the prober
+func newProbeRelay(servicerPubKey, chain string, sessionBlockHeight, blockHeight int64, kp crypto.PrivateKey) (Relay, error) {
+	aat := AAT{
+		Version:              SupportedTokenVersions[0],
+		ApplicationPublicKey: kp.PublicKey().RawString(),
+		ClientPublicKey:      kp.PublicKey().RawString(),
+	}
+	sig, err := SignPayload(kp, ProbeTokenSignType, aat, aat.Hash())
+	if err != nil {
+		return Relay{}, err
+	}
+	aat.ApplicationSignature = hex.EncodeToString(sig)
+	relay := Relay{
+		Payload: Payload{Data: ProbePayload},
+		Meta:    RelayMeta{BlockHeight: blockHeight},
+		Proof: RelayProof{
+			Entropy:            rand.Int63(),
+			SessionBlockHeight: sessionBlockHeight,
+			ServicerPubKey:     servicerPubKey,
+			Blockchain:         chain,
+			Token:              aat,
+		},
+	}
+	relay.Proof.RequestHash = relay.RequestHashString()
+	sig, err = SignPayload(kp, ProbeProofSignType, relay.Proof, relay.Proof.Hash())
+	if err != nil {
+		return Relay{}, err
+	}
+	relay.Proof.Signature = hex.EncodeToString(sig)
+	return relay, nil
+}
+
+// "IsProbe" - Returns true if the relay uses a token self issued by the client, as the probe of a liveness prober does
+func (r Relay) IsProbe() bool {
+	return r.Proof.Token.ApplicationPublicKey == r.Proof.Token.ClientPublicKey
+}
+
+// "ProberAddress" - Returns the address of the servicer key of the prober that signed the probe
+func (r Relay) ProberAddress() (sdk.Address, error) {
+	pk, err := crypto.NewPublicKey(r.Proof.Token.ClientPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.Address(pk.Address()), nil
+}
+
+// "ValidateProbe" - Validates a liveness probe: a relay signed by the prober, with a token self issued by the prober,
+// to a chain of the servicer in the session
+func (r Relay) ValidateProbe(prober sdk.Address, servicerPubKey string, servicerChains []string, sessionBlockHeight int64) sdk.Error {
+	if !r.IsProbe() {
+		return NewInvalidLivenessProbeError(ModuleName, fmt.Errorf("the token is not self issued by the prober"))
+	}
+	// the probe must carry the probe payload, so it is never a relay of an application
+	if r.Payload.Data != ProbePayload || r.Proof.RequestHash != r.RequestHashString() {
+		return NewInvalidLivenessProbeError(ModuleName, NewRequestHashError(ModuleName))
+	}
+	addr, err := r.ProberAddress()
+	if err != nil || !addr.Equals(prober) {
+		return NewInvalidLivenessProbeError(ModuleName, fmt.Errorf("the probe is not signed by the prober"))
+	}
+	if r.Proof.ServicerPubKey != servicerPubKey {
+		return NewInvalidLivenessProbeError(ModuleName, NewInvalidNodePubKeyError(ModuleName))
+	}
+	// validates the session, the chain of the servicer, the token and the signature of the prober
+	if err := r.Proof.Validate(servicerChains, 0, sessionBlockHeight); err != nil {
+		return NewInvalidLivenessProbeError(ModuleName, err)
+	}
+	return nil
+}
+
+// "ValidateLivenessProbe" - Validates the json encoded probe of a liveness report
+func ValidateLivenessProbe(probe []byte, reporter sdk.Address, servicerPubKey string, servicerChains []string, sessionBlockHeight int64) (relay Relay, err sdk.Error) {
+	if er := json.Unmarshal(probe, &relay); er != nil {
+		return relay, NewInvalidLivenessProbeError(ModuleName, er)
+	}
+	return relay, relay.ValidateProbe(reporter, servicerPubKey, servicerChains, sessionBlockHeight)
+}
+
+// "ExecuteProbe" - Relays the probe to the hosted chain, where any http answer of the chain proves the relay is served
+func (r Relay) ExecuteProbe(hostedBlockchains *HostedBlockchains) (string, sdk.Error) {
+	url, err := hostedBlockchains.GetChainURL(r.Proof.Blockchain)
+	if err != nil {
+		return "", err
+	}
+	resp, er := (&http.Client{Timeout: globalProberTimeout}).Post(strings.Trim(url, `/`), "application/json", bytes.NewBuffer([]byte(r.Payload.Data)))
+	if er != nil {
+		return "", NewHTTPExecutionError(ModuleName, er)
+	}
+	_ = resp.Body.Close()
+	return fmt.Sprintf(`{"status":%d}`, resp.StatusCode), nil
+}
+
+// "ServeProbe" - Records the probe of the prober in the session, returns false if the prober was already served
+func ServeProbe(prober sdk.Address, sessionBlockHeight int64) bool {
+	globalProbesServed.l.Lock()
+	defer globalProbesServed.l.Unlock()
+	for p, height := range globalProbesServed.probers {
+		if height < sessionBlockHeight {
+			delete(globalProbesServed.probers, p)
+		}
+	}
+	if _, served := globalProbesServed.probers[prober.String()]; served {
+		return false
+	}
+	globalProbesServed.probers[prober.String()] = sessionBlockHeight
+	return true
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	sdk "github.com/pokt-network/posmint/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeServicer(t *testing.T) {
+	InitProber(true, 1, 1000)
+	kp := GetRandomPrivateKey()
+	servicerKp := GetRandomPrivateKey()
+	servicerPubKey := servicerKp.PublicKey().RawString()
+	chains := []string{"0001"}
+	live := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var relay Relay
+		_ = json.NewDecoder(r.Body).Decode(&relay)
+		resp := RelayResponse{Response: `{"status":200}`, Proof: relay.Proof}
+		sig, _ := servicerKp.Sign(resp.Hash())
+		resp.Signature = hex.EncodeToString(sig)
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer live.Close()
+	unsigned := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"response":"{}"}`))
+	}))
+	defer unsigned.Close()
+	rejecting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":400,"message":"invalid relay"}`))
+	}))
+	defer rejecting.Close()
+	probe, failure, err := ProbeServicer(live.URL, servicerPubKey, chains, 1, 1, kp)
+	assert.Nil(t, err)
+	assert.Empty(t, failure)
+	assert.NotEmpty(t, probe)
+	_, failure, err = ProbeServicer(unsigned.URL, servicerPubKey, chains, 1, 1, kp)
+	assert.Nil(t, err)
+	assert.Equal(t, nodesTypes.ProbeFailureResponse, failure)
+	_, failure, err = ProbeServicer(rejecting.URL, servicerPubKey, chains, 1, 1, kp)
+	assert.Nil(t, err)
+	assert.Equal(t, nodesTypes.ProbeFailureRejected, failure)
+	probe, failure, err = ProbeServicer("http://127.0.0.1:1", servicerPubKey, chains, 1, 1, kp)
+	assert.Nil(t, err)
+	assert.Equal(t, nodesTypes.ProbeFailureUnreachable, failure)
+	// the probe of a failure is valid evidence for the report
+	_, err = ValidateLivenessProbe(probe, sdk.Address(kp.PublicKey().Address()), servicerPubKey, chains, 1)
+	assert.Nil(t, err)
+	_, _, err = ProbeServicer(live.URL, servicerPubKey, nil, 1, 1, kp)
+	assert.NotNil(t, err)
+}
+
+func TestNewProbeRelay(t *testing.T) {
+	kp := GetRandomPrivateKey()
+	relay, err := newProbeRelay(getRandomPubKey().RawString(), "0001", 1, 1, kp)
+	assert.Nil(t, err)
+	assert.Nil(t, relay.Proof.Token.ValidateSignature())
+	assert.Equal(t, relay.RequestHashString(), relay.Proof.RequestHash)
+	assert.Equal(t, "0001", relay.Proof.Blockchain)
+	assert.True(t, relay.IsProbe())
+	sig, _ := hex.DecodeString(relay.Proof.Signature)
+	assert.True(t, kp.PublicKey().VerifyBytes(relay.Proof.Hash(), sig))
+}
+
+func TestValidateLivenessProbe(t *testing.T) {
+	kp := GetRandomPrivateKey()
+	reporter := sdk.Address(kp.PublicKey().Address())
+	servicerPubKey := getRandomPubKey().RawString()
+	chains := []string{"0001"}
+	relay, err := newProbeRelay(servicerPubKey, "0001", 1, 1, kp)
+	assert.Nil(t, err)
+	probe, _ := json.Marshal(relay)
+	foreignChain, _ := newProbeRelay(servicerPubKey, "0002", 1, 1, kp)
+	foreignChainProbe, _ := json.Marshal(foreignChain)
+	tampered := relay
+	tampered.Payload.Data = `{"method":"eth_blockNumber"}`
+	tamperedProbe, _ := json.Marshal(tampered)
+	tests := []struct {
+		name           string
+		probe          []byte
+		reporter       sdk.Address
+		servicerPubKey string
+		session        int64
+		hasError       bool
+	}{
+		{"valid probe", probe, reporter, servicerPubKey, 1, false},
+		{"not a probe", []byte("probe"), reporter, servicerPubKey, 1, true},
+		{"not signed by the reporter", probe, sdk.Address(getRandomPubKey().Address()), servicerPubKey, 1, true},
+		{"another servicer", probe, reporter, getRandomPubKey().RawString(), 1, true},
+		{"another session", probe, reporter, servicerPubKey, 5, true},
+		{"chain not hosted by the servicer", foreignChainProbe, reporter, servicerPubKey, 1, true},
+		{"tampered payload", tamperedProbe, reporter, servicerPubKey, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValidateLivenessProbe(tt.probe, tt.reporter, tt.servicerPubKey, chains, tt.session)
+			assert.Equal(t, tt.hasError, err != nil)
+		})
+	}
+}
+
+func TestServeProbe(t *testing.T) {
+	prober := sdk.Address(getRandomPubKey().Address())
+	assert.True(t, ServeProbe(prober, 1))
+	assert.False(t, ServeProbe(prober, 1))
+	// a new session serves the prober again
+	assert.True(t, ServeProbe(prober, 5))
+}
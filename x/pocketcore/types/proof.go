@@ -21,6 +21,21 @@ type Proof interface {
 	EvidenceType() EvidenceType                                                                          // return the type of evidence from the proof object
 }
 
+// "BlockchainProof" - A proof validated against the chain registry entry of its blockchain
+type BlockchainProof interface {
+	Proof
+	ValidateBlockchain(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64, blockchain Blockchain) sdk.Error
+}
+
+// "ValidateProof" - Validates the proof, against the chain registry entry of its blockchain if it is a blockchain proof
+func ValidateProof(p Proof, appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64, chainRegistry ChainRegistry) sdk.Error {
+	if bp, ok := p.(BlockchainProof); ok {
+		blockchain, _ := chainRegistry.Get(p.SessionHeader().Chain)
+		return bp.ValidateBlockchain(appSupportedBlockchains, sessionNodeCount, sessionBlockHeight, blockchain)
+	}
+	return p.Validate(appSupportedBlockchains, sessionNodeCount, sessionBlockHeight)
+}
+
 var _ Proof = RelayProof{} // ensure implements interface at compile time
 
 // "RelayProof" - A proof object that represetns one relay finished
@@ -79,6 +94,14 @@ func (rp RelayProof) Validate(appSupportedBlockchains []string, sessionNodeCount
 	if err := rp.Token.Validate(); err != nil {
 		return NewInvalidTokenError(ModuleName, err)
 	}
+	// validate the scope of the service token
+	if err := rp.Token.ValidateScope(rp.Blockchain, rp.SessionBlockHeight); err != nil {
+		return NewInvalidTokenError(ModuleName, err)
+	}
+	// validate the relay is within the relay cap of the service token
+	if err := rp.ValidateTokenRelays(); err != nil {
+		return err
+	}
 	return SignatureVerification(rp.Token.ClientPublicKey, rp.HashString(), rp.Signature)
 }
 
@@ -108,6 +131,14 @@ func (rp RelayProof) ValidateBasic() sdk.Error {
 	if err := rp.Token.Validate(); err != nil {
 		return NewInvalidTokenError(ModuleName, err)
 	}
+	// verify the token scope
+	if err := rp.Token.ValidateScope(rp.Blockchain, rp.SessionBlockHeight); err != nil {
+		return NewInvalidTokenError(ModuleName, err)
+	}
+	// verify the relay is within the relay cap of the token
+	if err := rp.ValidateTokenRelays(); err != nil {
+		return err
+	}
 	// verify the client signature on the Proof
 	if err := SignatureVerification(rp.Token.ClientPublicKey, rp.HashString(), rp.Signature); err != nil {
 		return err
@@ -115,6 +146,16 @@ func (rp RelayProof) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// "ValidateTokenRelays" - Validates the relay is within the relay cap of the token, where a capped token
+// uses the entropy as the index of the relay to the servicer in the session, so no more than MaxRelays
+// relay proofs of the token can be claimed by a servicer in a session
+func (rp RelayProof) ValidateTokenRelays() sdk.Error {
+	if rp.Token.MaxRelays > 0 && rp.Entropy >= rp.Token.MaxRelays {
+		return NewMaximumEntropyError(ModuleName)
+	}
+	return nil
+}
+
 // "SessionHeader" - Returns the session header corresponding with the proof
 func (rp RelayProof) SessionHeader() SessionHeader {
 	return SessionHeader{
@@ -219,10 +260,10 @@ type ChallengeProofInvalidData struct {
 	ReporterAddress   sdk.Address      `json:"address"`            // the address of the reporter
 }
 
-var _ Proof = ChallengeProofInvalidData{} // compile time interface implementation
+var _ BlockchainProof = ChallengeProofInvalidData{} // compile time interface implementation
 
 // "ValidateLocal" - Validate local is used to validate a challenge request directly from a client
-func (c ChallengeProofInvalidData) ValidateLocal(maxRelays, sessionblockHeight int64, supportedBlockchains []string, sessionNodeCount int, sessionNodes SessionNodes, selfAddr sdk.Address) sdk.Error {
+func (c ChallengeProofInvalidData) ValidateLocal(maxRelays, sessionblockHeight int64, supportedBlockchains []string, sessionNodeCount int, sessionNodes SessionNodes, selfAddr sdk.Address, chainRegistry ChainRegistry) sdk.Error {
 	// get the header to retrieve the evidence object
 	h := SessionHeader{
 		ApplicationPubKey:  c.MinorityResponse.Proof.Token.ApplicationPublicKey,
@@ -238,15 +279,21 @@ func (c ChallengeProofInvalidData) ValidateLocal(maxRelays, sessionblockHeight i
 	if !sessionNodes.ContainsAddress(selfAddr) {
 		return NewNodeNotInSessionError(ModuleName)
 	}
-	err := c.Validate(supportedBlockchains, sessionNodeCount, sessionblockHeight)
+	err := ValidateProof(c, supportedBlockchains, sessionNodeCount, sessionblockHeight, chainRegistry)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-// "Validate" - validate is used to validate a challenge request
+// "Validate" - validate is used to validate a challenge request of an unregistered blockchain
+// NOTE: the responses are compared by the comparator of the registry entry of the blockchain, see ValidateProof
 func (c ChallengeProofInvalidData) Validate(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error {
+	return c.ValidateBlockchain(appSupportedBlockchains, sessionNodeCount, sessionBlockHeight, Blockchain{ID: c.MinorityResponse.Proof.Blockchain})
+}
+
+// "ValidateBlockchain" - validate is used to validate a challenge request against the chain registry entry of its blockchain
+func (c ChallengeProofInvalidData) ValidateBlockchain(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64, blockchain Blockchain) sdk.Error {
 	majResponse := c.MajorityResponses[0]
 	majResponse2 := c.MajorityResponses[1]
 	// check for duplicates
@@ -279,9 +326,11 @@ func (c ChallengeProofInvalidData) Validate(appSupportedBlockchains []string, se
 		majResponse.Proof.Blockchain != c.MinorityResponse.Proof.Blockchain {
 		return NewMismatchedBlockchainsError(ModuleName)
 	}
-	// check for a true majority minority response
-	majResp, majResp2, minResp := sortJSONResponse(majResponse.Response), sortJSONResponse(majResponse2.Response), sortJSONResponse(c.MinorityResponse.Response)
-	if majResp != majResp2 || minResp == majResp {
+	// check for a true majority minority response (compared by the comparator of the blockchain)
+	comparator := GetResponseComparator(blockchain)
+	// the request payload is optional for comparison, so an undecodable request hash results in an empty payload
+	request, _ := RequestPayload(majResponse.Proof.RequestHash)
+	if !comparator.Equal(request, majResponse.Response, majResponse2.Response) || comparator.Equal(request, majResponse.Response, c.MinorityResponse.Response) {
 		return NewNoMajorityResponseError(ModuleName)
 	}
 	// check for supported blockchain
@@ -449,3 +498,122 @@ func (c ChallengeProofInvalidData) Store() {
 func (c ChallengeProofInvalidData) EvidenceType() EvidenceType {
 	return ChallengeEvidence
 }
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// "MisbehaviorType" - The type of misbehavior of the client of an application
+type MisbehaviorType int
+
+const (
+	OverServiceMisbehavior MisbehaviorType = iota + 1 // the client kept sending authorized relays over the max relays of the application
+)
+
+// "IsValid" - Returns true if the misbehavior type is known
+func (mt MisbehaviorType) IsValid() bool {
+	return mt == OverServiceMisbehavior
+}
+
+// "AppMisbehaviorProof" - A proof of the misbehavior of the client of an application (used to jail the application)
+// Only misbehaviors provable on chain are proofs: the relay must be signed by a client the application authorized, and
+// the over service is only proven along with the relay receipt of the reporter for the full allowance of the session
+type AppMisbehaviorProof struct {
+	RelayProof      RelayProof      `json:"relay_proof"` // the offending relay proof, signed by the client
+	Misbehavior     MisbehaviorType `json:"misbehavior"` // the type of the misbehavior
+	ReporterAddress sdk.Address     `json:"address"`     // the address of the reporter
+}
+
+var _ Proof = AppMisbehaviorProof{} // compile time interface implementation
+
+// "Validate" - Validates the misbehavior proof object
+func (m AppMisbehaviorProof) Validate(appSupportedBlockchains []string, sessionNodeCount int, sessionBlockHeight int64) sdk.Error {
+	if !m.Misbehavior.IsValid() {
+		return NewInvalidMisbehaviorProofError(ModuleName)
+	}
+	// the relay must be authorized by the application (aat signed by the application and relay signed by the client)
+	return m.RelayProof.Validate(appSupportedBlockchains, sessionNodeCount, sessionBlockHeight)
+}
+
+// "ValidateBasic" - Provides a lightweight, storeless validity check
+func (m AppMisbehaviorProof) ValidateBasic() sdk.Error {
+	// ensure address is not empty
+	if m.ReporterAddress == nil {
+		return NewEmptyAddressError(ModuleName)
+	}
+	if !m.Misbehavior.IsValid() {
+		return NewInvalidMisbehaviorProofError(ModuleName)
+	}
+	rp := m.RelayProof
+	// verify the session block height is positive
+	if rp.SessionBlockHeight < 1 {
+		return NewInvalidBlockHeightError(ModuleName)
+	}
+	// verify the public key format for the servicer
+	if err := PubKeyVerification(rp.ServicerPubKey); err != nil {
+		return err
+	}
+	// verify the blockchain addr format
+	if err := NetworkIdentifierVerification(rp.Blockchain); err != nil {
+		return err
+	}
+	// verify the request hash format
+	if err := HashVerification(rp.RequestHash); err != nil {
+		return err
+	}
+	// verify the token is signed by the application
+	if err := rp.Token.Validate(); err != nil {
+		return NewInvalidTokenError(ModuleName, err)
+	}
+	// verify the client signature on the Proof
+	return SignatureVerification(rp.Token.ClientPublicKey, rp.HashString(), rp.Signature)
+}
+
+// "SessionHeader" - Returns the session header for the misbehavior proof
+func (m AppMisbehaviorProof) SessionHeader() SessionHeader {
+	return m.RelayProof.SessionHeader()
+}
+
+// "appMisbehaviorProof" - is used to marshal / unmarshal json
+type appMisbehaviorProof struct {
+	RelayProof      string          `json:"relay_proof"`
+	Misbehavior     MisbehaviorType `json:"misbehavior"`
+	ReporterAddress string          `json:"address"`
+}
+
+// "Bytes" - Bytes representation of the misbehavior proof object
+func (m AppMisbehaviorProof) Bytes() []byte {
+	bz, err := json.Marshal(appMisbehaviorProof{
+		RelayProof:      m.RelayProof.HashStringWithSignature(),
+		Misbehavior:     m.Misbehavior,
+		ReporterAddress: m.ReporterAddress.String(),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("an error occured converting the misbehavior proof to bytes\n%v", err))
+	}
+	return bz
+}
+
+// "Hash" - The cryptographic hash representation of the misbehavior proof bytes
+func (m AppMisbehaviorProof) Hash() []byte {
+	return Hash(m.Bytes())
+}
+
+// "HashString" - The hex encoded string representation of the misbehavior proof hash
+func (m AppMisbehaviorProof) HashString() string {
+	return hex.EncodeToString(m.Hash())
+}
+
+// "GetSigners" - Returns the signer(s) for the message
+func (m AppMisbehaviorProof) GetSigners() []sdk.Address {
+	return []sdk.Address{m.ReporterAddress}
+}
+
+// "Store" - Stores the misbehavior proof (stores in cache)
+func (m AppMisbehaviorProof) Store() {
+	// add the Proof to the global (in memory) collection of proofs
+	SetProof(m.SessionHeader(), MisbehaviorEvidence, m)
+}
+
+// "EvidenceType" - Returns the type of the evidence
+func (m AppMisbehaviorProof) EvidenceType() EvidenceType {
+	return MisbehaviorEvidence
+}
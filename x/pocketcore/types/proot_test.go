@@ -321,6 +321,24 @@ func TestRelayProof_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestRelayProof_ValidateTokenRelays(t *testing.T) {
+	proof := RelayProof{
+		Entropy: 1,
+		Token: AAT{
+			Version:   ScopedAATVersion,
+			MaxRelays: 2,
+		},
+	}
+	assert.Nil(t, proof.ValidateTokenRelays())
+	// the entropy is the index of the relay, so it must be under the cap
+	proof.Entropy = 2
+	assert.Equal(t, sdk.CodeType(CodeMaximumEntropyError), proof.ValidateTokenRelays().Code())
+	// no cap
+	proof.Token.MaxRelays = 0
+	proof.Entropy = 34939492
+	assert.Nil(t, proof.ValidateTokenRelays())
+}
+
 func TestRelayProof_SessionHeader(t *testing.T) {
 	appPrivateKey := GetRandomPrivateKey()
 	clientPrivateKey := GetRandomPrivateKey()
@@ -520,7 +538,7 @@ func TestChallengeProofInvalidData_ValidateLocal(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.proof.ValidateLocal(tt.maxRelays, 1, tt.supportedBlockchains, 5, tt.sessionNodes, tt.reporterAddress); (err != nil) != tt.hasError {
+			if err := tt.proof.ValidateLocal(tt.maxRelays, 1, tt.supportedBlockchains, 5, tt.sessionNodes, tt.reporterAddress, nil); (err != nil) != tt.hasError {
 				t.Fatalf(err.Error())
 			}
 		})
@@ -671,3 +689,100 @@ func TestChallengeProofInvalidData_SessionHeader(t *testing.T) {
 		SessionBlockHeight: c.MinorityResponse.Proof.SessionBlockHeight,
 	})
 }
+
+func newMisbehaviorRelayProof(t *testing.T, appSigner crypto.PrivateKey) (rp RelayProof) {
+	appPrivateKey := GetRandomPrivateKey()
+	clientPrivateKey := GetRandomPrivateKey()
+	servicerPubKey := getRandomPubKey().RawString()
+	rp = RelayProof{
+		Entropy:            0,
+		SessionBlockHeight: 1,
+		ServicerPubKey:     servicerPubKey,
+		RequestHash:        servicerPubKey, // fake
+		Blockchain:         hex.EncodeToString([]byte{01}),
+		Token: AAT{
+			Version:              "0.0.1",
+			ApplicationPublicKey: appPrivateKey.PublicKey().RawString(),
+			ClientPublicKey:      clientPrivateKey.PublicKey().RawString(),
+		},
+	}
+	if appSigner == nil {
+		appSigner = appPrivateKey
+	}
+	appSignature, er := appSigner.Sign(rp.Token.Hash())
+	if er != nil {
+		t.Fatalf(er.Error())
+	}
+	rp.Token.ApplicationSignature = hex.EncodeToString(appSignature)
+	clientSignature, er := clientPrivateKey.Sign(rp.Hash())
+	if er != nil {
+		t.Fatalf(er.Error())
+	}
+	rp.Signature = hex.EncodeToString(clientSignature)
+	return
+}
+
+func TestAppMisbehaviorProof_ValidateBasic(t *testing.T) {
+	validProof := AppMisbehaviorProof{
+		RelayProof:      newMisbehaviorRelayProof(t, nil),
+		Misbehavior:     OverServiceMisbehavior,
+		ReporterAddress: getRandomValidatorAddress(),
+	}
+	noReporter := validProof
+	noReporter.ReporterAddress = nil
+	invalidMisbehavior := validProof
+	invalidMisbehavior.Misbehavior = 0
+	invalidSig := validProof
+	invalidSig.RelayProof.Signature = "abc"
+	forgedAAT := validProof
+	forgedAAT.RelayProof = newMisbehaviorRelayProof(t, GetRandomPrivateKey())
+	tests := []struct {
+		name     string
+		proof    AppMisbehaviorProof
+		hasError bool
+	}{
+		{"valid proof", validProof, false},
+		{"invalid proof, no reporter", noReporter, true},
+		{"invalid proof, unknown misbehavior", invalidMisbehavior, true},
+		{"invalid proof, invalid client signature", invalidSig, true},
+		{"invalid proof, aat not signed by the application", forgedAAT, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proof.ValidateBasic()
+			assert.Equal(t, tt.hasError, err != nil)
+		})
+	}
+}
+
+func TestAppMisbehaviorProof_Validate(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	reporter := getRandomValidatorAddress()
+	signedRelay := newMisbehaviorRelayProof(t, nil)
+	forgedRelay := newMisbehaviorRelayProof(t, GetRandomPrivateKey())
+	tests := []struct {
+		name     string
+		proof    AppMisbehaviorProof
+		hasError bool
+	}{
+		{"over service with an authorized relay", AppMisbehaviorProof{signedRelay, OverServiceMisbehavior, reporter}, false},
+		{"over service with a forged aat", AppMisbehaviorProof{forgedRelay, OverServiceMisbehavior, reporter}, true},
+		{"unknown misbehavior", AppMisbehaviorProof{signedRelay, 0, reporter}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proof.Validate([]string{ethereum}, 5, 1)
+			assert.Equal(t, tt.hasError, err != nil)
+		})
+	}
+}
+
+func TestValidateProof_FieldMasks(t *testing.T) {
+	c, _, _, _, _, _, _ := NewValidChallengeProof(t)
+	chain := c.MinorityResponse.Proof.Blockchain
+	registry := ChainRegistry{{ID: chain, Name: "ethereum", Type: JSONRPCBlockchainType}}
+	assert.Nil(t, ValidateProof(c, []string{chain}, 5, 1, registry))
+	// the field masks of the registry entry make the minority response agree with the majority
+	registry[0].FieldMasks = FieldMasks{{Method: JSONRPCAllMethodsKey, Fields: []string{"result"}}}
+	assert.NotNil(t, ValidateProof(c, []string{chain}, 5, 1, registry))
+}
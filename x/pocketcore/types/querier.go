@@ -13,6 +13,9 @@ const (
 	QueryDispatch             = "dispatch"
 	QueryChallenge            = "challenge"
 	QueryParameters           = "parameters"
+	QueryChainRegistry        = "chainRegistry"
+	QueryAppUsage             = "appUsage"
+	QueryParamChange          = "paramChange"
 )
 
 // "QueryRelayParams" - The parameters needed to submit a relay request
@@ -41,3 +44,17 @@ type QueryReceiptParams struct {
 type QueryReceiptsParams struct {
 	Address sdk.Address `json:"address"`
 }
+
+// "QueryAppUsageParams" - The parameters needed to retrieve the relay usage of an application
+// A session block height of zero retrieves the usage for all sessions
+type QueryAppUsageParams struct {
+	Address            sdk.Address `json:"address"`
+	SessionBlockHeight int64       `json:"session_block_height"`
+}
+
+// "QueryParamChangeParams" - The parameters needed to simulate a param change
+// The value is the amino json of the new param value
+type QueryParamChangeParams struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
@@ -64,8 +64,12 @@ func (r *Relay) Validate(ctx sdk.Ctx, keeper PosKeeper, node nodeexported.Valida
 	if totalRelays >= int64(math.Ceil(float64(app.GetMaxRelays().Int64())/float64(len(app.GetChains())))/(float64(sessionNodeCount))) {
 		return NewOverServiceError(ModuleName)
 	}
+	// validate not over the relay cap of the token
+	if r.Proof.Token.MaxRelays > 0 && GetTotalTokenProofs(evidenceHeader, r.Proof.Token) >= r.Proof.Token.MaxRelays {
+		return NewTokenOverServiceError(ModuleName)
+	}
 	// validate the Proof
-	if err := r.Proof.ValidateLocal(app.GetChains(), sessionNodeCount, sessionBlockHeight, node.GetPublicKey().RawString()); err != nil {
+	if err := r.Proof.ValidateLocal(app.GetChains(), sessionNodeCount, sessionBlockHeight, node.GetServicerPublicKey().RawString()); err != nil {
 		return err
 	}
 	// get the sessionContext
@@ -226,12 +230,40 @@ func (rr RelayResponse) Validate() sdk.Error {
 		return NewEmptyResponseError(ModuleName)
 	}
 	// cannot contain empty signature (nodes must be accountable)
-	if rr.Signature == "" || len(rr.Signature) == crypto.Ed25519SignatureSize {
+	if rr.Signature == "" {
+		return NewResponseSignatureError(ModuleName)
+	}
+	// the signature must be a hex encoded ed25519 signature
+	sig, err := hex.DecodeString(rr.Signature)
+	if err != nil || len(sig) != crypto.Ed25519SignatureSize {
 		return NewResponseSignatureError(ModuleName)
 	}
 	return nil
 }
 
+// "Verify" - Verifies the response is the servicer's signed response for the relay (used by the client)
+func (rr RelayResponse) Verify(relay Relay) sdk.Error {
+	// validate the format of the response
+	if err := rr.Validate(); err != nil {
+		return err
+	}
+	// ensure the request hash of the relay is the hash of the relay request
+	if relay.Proof.RequestHash != relay.RequestHashString() {
+		return NewRequestHashError(ModuleName)
+	}
+	// ensure the response is bound to the proof of the relay
+	if rr.Proof.HashStringWithSignature() != relay.Proof.HashStringWithSignature() {
+		return NewMismatchedResponseProofError(ModuleName)
+	}
+	// verify the servicer signature
+	return rr.VerifySignature()
+}
+
+// "VerifySignature" - Verifies the signature of the response against the servicer public key of the proof
+func (rr RelayResponse) VerifySignature() sdk.Error {
+	return SignatureVerification(rr.Proof.ServicerPubKey, rr.HashString(), rr.Signature)
+}
+
 // "Hash" - The cryptographic hash representation of the relay response
 func (rr RelayResponse) Hash() []byte {
 	seed, err := json.Marshal(relayResponse{
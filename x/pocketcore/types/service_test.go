@@ -5,6 +5,7 @@ import (
 	appsType "github.com/pokt-network/pocket-core/x/apps/types"
 	"github.com/pokt-network/pocket-core/x/nodes/exported"
 	nodesTypes "github.com/pokt-network/pocket-core/x/nodes/types"
+	"github.com/pokt-network/posmint/crypto"
 	sdk "github.com/pokt-network/posmint/types"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/h2non/gock.v1"
@@ -310,6 +311,87 @@ func TestRelayResponse_BytesAndHash(t *testing.T) {
 	assert.Equal(t, storedHashString, relayResp.HashString())
 }
 
+func newTestRelayAndResponse(t *testing.T) (Relay, RelayResponse, crypto.PrivateKey) {
+	nodePrivKey := GetRandomPrivateKey()
+	appPrivKey := GetRandomPrivateKey()
+	cliPrivKey := GetRandomPrivateKey()
+	relay := Relay{
+		Payload: Payload{Data: `{"jsonrpc":"2.0","method":"web3_clientVersion","params":[],"id":67}`, Method: "POST"},
+		Meta:    RelayMeta{BlockHeight: 1},
+		Proof: RelayProof{
+			Entropy:            230942034,
+			SessionBlockHeight: 1,
+			ServicerPubKey:     nodePrivKey.PublicKey().RawString(),
+			Blockchain:         hex.EncodeToString([]byte{01}),
+			Token: AAT{
+				Version:              "0.0.1",
+				ApplicationPublicKey: appPrivKey.PublicKey().RawString(),
+				ClientPublicKey:      cliPrivKey.PublicKey().RawString(),
+			},
+		},
+	}
+	appSig, err := appPrivKey.Sign(relay.Proof.Token.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	relay.Proof.Token.ApplicationSignature = hex.EncodeToString(appSig)
+	relay.Proof.RequestHash = relay.RequestHashString()
+	cliSig, err := cliPrivKey.Sign(relay.Proof.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	relay.Proof.Signature = hex.EncodeToString(cliSig)
+	resp := RelayResponse{Response: "foo", Proof: relay.Proof}
+	nodeSig, err := nodePrivKey.Sign(resp.Hash())
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	resp.Signature = hex.EncodeToString(nodeSig)
+	return relay, resp, nodePrivKey
+}
+
+func TestRelayResponse_Validate(t *testing.T) {
+	_, resp, _ := newTestRelayAndResponse(t)
+	assert.Nil(t, resp.Validate())
+	emptyResponse := resp
+	emptyResponse.Response = ""
+	assert.NotNil(t, emptyResponse.Validate())
+	emptySignature := resp
+	emptySignature.Signature = ""
+	assert.NotNil(t, emptySignature.Validate())
+	invalidSignatureEncoding := resp
+	invalidSignatureEncoding.Signature = "not hex"
+	assert.NotNil(t, invalidSignatureEncoding.Validate())
+	invalidSignatureSize := resp
+	invalidSignatureSize.Signature = resp.Signature[:len(resp.Signature)-2]
+	assert.NotNil(t, invalidSignatureSize.Validate())
+}
+
+func TestRelayResponse_Verify(t *testing.T) {
+	relay, resp, _ := newTestRelayAndResponse(t)
+	assert.Nil(t, resp.VerifySignature())
+	assert.Nil(t, resp.Verify(relay))
+	// signed by another key than the servicer
+	wrongSigner := resp
+	sig, err := GetRandomPrivateKey().Sign(resp.Hash())
+	assert.Nil(t, err)
+	wrongSigner.Signature = hex.EncodeToString(sig)
+	assert.NotNil(t, wrongSigner.VerifySignature())
+	assert.NotNil(t, wrongSigner.Verify(relay))
+	// tampered response
+	tamperedResponse := resp
+	tamperedResponse.Response = "bar"
+	assert.NotNil(t, tamperedResponse.Verify(relay))
+	// response bound to another proof (re-signed by the servicer)
+	otherRelay, otherResp, _ := newTestRelayAndResponse(t)
+	assert.Equal(t, NewMismatchedResponseProofError(ModuleName), otherResp.Verify(relay))
+	assert.Nil(t, otherResp.Verify(otherRelay))
+	// request hash not matching the relay request
+	tamperedRelay := relay
+	tamperedRelay.Payload.Data = `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":67}`
+	assert.Equal(t, NewRequestHashError(ModuleName), resp.Verify(tamperedRelay))
+}
+
 func TestSortJSON(t *testing.T) {
 	// out of order json arrays
 	j1 := `{"foo":0,"bar":1}`
@@ -329,6 +411,10 @@ func (m MockPosKeeper) RewardForRelays(ctx sdk.Ctx, relays sdk.Int, address sdk.
 	panic("implement me")
 }
 
+func (m MockPosKeeper) RewardForPrepaidRelays(ctx sdk.Ctx, tokens sdk.Int, address sdk.Address) {
+	panic("implement me")
+}
+
 func (m MockPosKeeper) GetStakedTokens(ctx sdk.Ctx) sdk.Int {
 	panic("implement me")
 }
@@ -342,6 +428,23 @@ func (m MockPosKeeper) Validator(ctx sdk.Ctx, addr sdk.Address) exported.Validat
 	return nil
 }
 
+func (m MockPosKeeper) ValidatorByRetiredServicerAddress(ctx sdk.Ctx, servicerAddr sdk.Address) exported.ValidatorI {
+	return nil
+}
+
+func (m MockPosKeeper) GetRetiredServicerAddresses(ctx sdk.Ctx, addr sdk.Address) []sdk.Address {
+	return nil
+}
+
+func (m MockPosKeeper) ValidatorByServicerAddress(ctx sdk.Ctx, servicerAddr sdk.Address) exported.ValidatorI {
+	for _, v := range m.Validators {
+		if servicerAddr.Equals(v.GetServicerAddress()) {
+			return v
+		}
+	}
+	return nil
+}
+
 func (m MockPosKeeper) TotalTokens(ctx sdk.Ctx) sdk.Int {
 	panic("implement me")
 }
@@ -369,3 +472,7 @@ func (m MockPosKeeper) BlocksPerSession(ctx sdk.Ctx) (res int64) {
 func (m MockPosKeeper) StakeDenom(ctx sdk.Ctx) (res string) {
 	panic("implement me")
 }
+
+func (m MockPosKeeper) MessageFee(ctx sdk.Ctx, msgType string) sdk.Int {
+	panic("implement me")
+}
@@ -0,0 +1,305 @@
+package types
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sync"
+
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	tmCrypto "github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	FileSignerType    = "file"    // signs with the private validator key file (default)
+	PrivValSignerType = "privval" // signs through a remote signer over tendermint's privval socket protocol
+	HTTPSignerType    = "http"    // signs through a remote signer over http
+)
+
+const (
+	RelayResponseSignType = "relay_response" // a relay response served by the servicer
+	ProbeTokenSignType    = "probe_token"    // the token self issued by the servicer for a liveness probe
+	ProbeProofSignType    = "probe_proof"    // the proof of a liveness probe sent by the servicer
+	TxSignType            = "tx"             // the sign bytes of a claim, proof or liveness report transaction of the servicer
+)
+
+var (
+	// the messages of the transactions the servicer key signs
+	signerTxMsgTypes = map[string]bool{
+		"pocketcore/claim":              true,
+		"pocketcore/Proof":              true,
+		"pos/MsgReportServicerLiveness": true,
+	}
+	globalSigner    Signer            // the signer of the servicer key, if nil the private validator key file is used
+	retiredSigners  map[string]Signer // the signers of the servicer keys rotated out, by address, to prove their claims
+	globalSignerMux sync.RWMutex
+)
+
+// "Signer" - Signs relay responses, claims and proofs on behalf of the servicer key
+// so the private key doesn't need to be held in memory by the node
+type Signer interface {
+	PublicKey() crypto.PublicKey                          // the public key of the servicer
+	Sign(signType string, payload []byte) ([]byte, error) // sign the typed payload with the servicer key (see SignBytes)
+}
+
+// "InitSigner" - Initializes the global signer of the servicer key
+func InitSigner(signer Signer) {
+	globalSignerMux.Lock()
+	defer globalSignerMux.Unlock()
+	globalSigner = signer
+}
+
+// "InitRetiredSigners" - Initializes the signers of the servicer keys the node rotated out of
+// The relays served with a servicer key are proven with it, so it is kept until the claims of its sessions are settled
+func InitRetiredSigners(signers ...Signer) {
+	globalSignerMux.Lock()
+	defer globalSignerMux.Unlock()
+	retiredSigners = make(map[string]Signer, len(signers))
+	for _, signer := range signers {
+		retiredSigners[sdk.Address(signer.PublicKey().Address()).String()] = signer
+	}
+}
+
+// "GetRetiredSigner" - Returns the signer of the retired servicer key of the address
+func GetRetiredSigner(addr sdk.Address) (signer Signer, found bool) {
+	globalSignerMux.RLock()
+	defer globalSignerMux.RUnlock()
+	signer, found = retiredSigners[addr.String()]
+	return
+}
+
+// "GetSigner" - Returns the global signer of the servicer key, falling back to the private validator key file
+func GetSigner() (Signer, sdk.Error) {
+	globalSignerMux.RLock()
+	signer := globalSigner
+	globalSignerMux.RUnlock()
+	if signer != nil {
+		return signer, nil
+	}
+	pvKey, err := GetPVKeyFile()
+	if err != nil {
+		return nil, err
+	}
+	pk, er := crypto.PrivKeyToPrivateKey(pvKey.PrivKey)
+	if er != nil {
+		return nil, NewSignerError(ModuleName, er)
+	}
+	return NewFileSigner(pk), nil
+}
+
+// "NewSigner" - Creates a remote signer from the type and address of the config, the http signer requires mutual tls
+func NewSigner(signerType, address string, timeoutMillis int64, tlsConfig *tls.Config) (Signer, error) {
+	switch signerType {
+	case PrivValSignerType:
+		return NewPrivValSigner(address, timeoutMillis)
+	case HTTPSignerType:
+		return NewHTTPSigner(address, timeoutMillis, tlsConfig)
+	default:
+		return nil, fmt.Errorf("unsupported remote signer type %q, supported types are %q and %q", signerType, PrivValSignerType, HTTPSignerType)
+	}
+}
+
+// "FileSigner" - Signs with a private key loaded from the private validator key file
+type FileSigner struct {
+	pk crypto.PrivateKey
+}
+
+var _ Signer = FileSigner{}
+
+// "NewFileSigner" - Creates a signer from a private key
+func NewFileSigner(pk crypto.PrivateKey) FileSigner {
+	return FileSigner{pk: pk}
+}
+
+// "PublicKey" - Returns the public key of the file signer
+func (fs FileSigner) PublicKey() crypto.PublicKey {
+	return fs.pk.PublicKey()
+}
+
+// "Sign" - Signs the typed payload with the private key of the file signer
+func (fs FileSigner) Sign(signType string, payload []byte) ([]byte, error) {
+	msg, err := SignBytes(signType, payload, fs.pk.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	return fs.pk.Sign(msg)
+}
+
+// "SignBytes" - Returns the bytes the servicer key signs for the typed payload; the servicer key only signs relay
+// responses and liveness probes of the servicer, and claim, proof and liveness report transactions, never arbitrary bytes
+func SignBytes(signType string, payload []byte, pubKey crypto.PublicKey) ([]byte, error) {
+	switch signType {
+	case RelayResponseSignType:
+		var resp RelayResponse
+		if err := json.Unmarshal(payload, &resp); err != nil {
+			return nil, fmt.Errorf("the payload is not a relay response: %s", err.Error())
+		}
+		if resp.Proof.ServicerPubKey != pubKey.RawString() {
+			return nil, fmt.Errorf("the relay response is not served by %s", pubKey.RawString())
+		}
+		return resp.Hash(), nil
+	case ProbeTokenSignType:
+		var aat AAT
+		if err := json.Unmarshal(payload, &aat); err != nil {
+			return nil, fmt.Errorf("the payload is not a token: %s", err.Error())
+		}
+		if aat.ApplicationPublicKey != pubKey.RawString() || aat.ClientPublicKey != pubKey.RawString() {
+			return nil, fmt.Errorf("the token is not self issued by %s", pubKey.RawString())
+		}
+		return aat.Hash(), nil
+	case ProbeProofSignType:
+		var proof RelayProof
+		if err := json.Unmarshal(payload, &proof); err != nil {
+			return nil, fmt.Errorf("the payload is not a relay proof: %s", err.Error())
+		}
+		if proof.Token.ApplicationPublicKey != pubKey.RawString() || proof.Token.ClientPublicKey != pubKey.RawString() {
+			return nil, fmt.Errorf("the relay proof is not a liveness probe of %s", pubKey.RawString())
+		}
+		return proof.Hash(), nil
+	case TxSignType:
+		var doc struct {
+			Msgs []json.RawMessage `json:"msgs"`
+		}
+		if err := json.Unmarshal(payload, &doc); err != nil || len(doc.Msgs) == 0 {
+			return nil, fmt.Errorf("the payload is not the sign bytes of a transaction")
+		}
+		for _, bz := range doc.Msgs {
+			var msg struct {
+				Type string `json:"type"`
+			}
+			if err := json.Unmarshal(bz, &msg); err != nil || !signerTxMsgTypes[msg.Type] {
+				return nil, fmt.Errorf("the servicer key doesn't sign %q messages", msg.Type)
+			}
+		}
+		return payload, nil
+	default:
+		return nil, fmt.Errorf("unsupported sign type %q", signType)
+	}
+}
+
+// "SignPayload" - Signs the typed payload with the private key; a signer key signs through its signer,
+// which derives the bytes to sign from the payload, any other key signs the bytes directly
+func SignPayload(kp crypto.PrivateKey, signType string, payload interface{}, msg []byte) ([]byte, error) {
+	sk, ok := kp.(SignerKey)
+	if !ok {
+		return kp.Sign(msg)
+	}
+	bz, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return sk.Signer.Sign(signType, bz)
+}
+
+// "NewSignerTLSConfig" - Creates the mutual tls config of an http remote signer and of the node connecting to it:
+// both present their certificate and only trust the certificates signed by the certificate authority
+func NewSignerTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificate authority found in %s", caFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// "verifySignerSignature" - Ensures the signature returned by a remote signer is valid for the public key
+func verifySignerSignature(pubKey crypto.PublicKey, msg, sig []byte) error {
+	if len(sig) != crypto.Ed25519SignatureSize {
+		return fmt.Errorf("the remote signer returned a signature of size %d", len(sig))
+	}
+	if !pubKey.VerifyBytes(msg, sig) {
+		return fmt.Errorf("the remote signer returned an invalid signature for %s", pubKey.RawString())
+	}
+	return nil
+}
+
+// "SignerKey" - Adapts a signer to the private key interface, so the signer can be used where a private key is expected
+// (signing txs); only the public key and signing are available, the key material never is
+type SignerKey struct {
+	Signer
+}
+
+var _ crypto.PrivateKey = SignerKey{}
+
+// "NewSignerKey" - Creates a private key object backed by the signer
+func NewSignerKey(signer Signer) SignerKey {
+	return SignerKey{Signer: signer}
+}
+
+// "Sign" - Signs the sign bytes of a transaction through the signer, relays and probes are signed with SignPayload
+func (sk SignerKey) Sign(msg []byte) ([]byte, error) {
+	return sk.Signer.Sign(TxSignType, msg)
+}
+
+// "Bytes" - Unavailable, the key material is held by the signer
+func (sk SignerKey) Bytes() []byte {
+	return nil
+}
+
+// "RawBytes" - Unavailable, the key material is held by the signer
+func (sk SignerKey) RawBytes() []byte {
+	return nil
+}
+
+// "String" - Unavailable, the key material is held by the signer
+func (sk SignerKey) String() string {
+	return ""
+}
+
+// "RawString" - Unavailable, the key material is held by the signer
+func (sk SignerKey) RawString() string {
+	return ""
+}
+
+// "PrivKey" - Unavailable, the key material is held by the signer
+func (sk SignerKey) PrivKey() tmCrypto.PrivKey {
+	return nil
+}
+
+// "PubKey" - Returns the tendermint public key of the signer
+func (sk SignerKey) PubKey() tmCrypto.PubKey {
+	return sk.PublicKey().PubKey()
+}
+
+// "Equals" - Returns true if the private key belongs to the public key of the signer
+func (sk SignerKey) Equals(other tmCrypto.PrivKey) bool {
+	if other == nil {
+		return false
+	}
+	return other.PubKey().Equals(sk.PubKey())
+}
+
+// "PrivKeyToPrivateKey" - Converts the tendermint private key, the signer is not involved
+func (sk SignerKey) PrivKeyToPrivateKey(pk tmCrypto.PrivKey) crypto.PrivateKey {
+	res, err := crypto.PrivKeyToPrivateKey(pk)
+	if err != nil {
+		return nil
+	}
+	return res
+}
+
+// "GenPrivateKey" - Generates a new private key, the signer is not involved
+func (sk SignerKey) GenPrivateKey() crypto.PrivateKey {
+	return crypto.GenerateEd25519PrivKey()
+}
+
+// "Size" - Returns the size of an ed25519 private key
+func (sk SignerKey) Size() int {
+	return crypto.Ed25519PrivKeySize
+}
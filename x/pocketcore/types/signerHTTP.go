@@ -0,0 +1,176 @@
+package types
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pokt-network/posmint/crypto"
+)
+
+const (
+	HTTPSignerPubKeyPath = "/v1/pubkey" // the path of the public key endpoint of an http remote signer
+	HTTPSignerSignPath   = "/v1/sign"   // the path of the sign endpoint of an http remote signer
+)
+
+// "HTTPSignerPubKeyResponse" - The response of the public key endpoint of an http remote signer
+type HTTPSignerPubKeyResponse struct {
+	PublicKey string `json:"public_key"` // hex encoded public key
+}
+
+// "HTTPSignerSignRequest" - The request to the sign endpoint of an http remote signer
+type HTTPSignerSignRequest struct {
+	PublicKey string `json:"public_key"` // hex encoded public key of the key to sign with
+	Type      string `json:"type"`       // the type of the payload (see SignBytes)
+	Payload   string `json:"payload"`    // hex encoded payload to sign
+}
+
+// "HTTPSignerSignResponse" - The response of the sign endpoint of an http remote signer
+type HTTPSignerSignResponse struct {
+	Signature string `json:"signature"`       // hex encoded signature
+	Error     string `json:"error,omitempty"` // the error of the remote signer, if any
+}
+
+// "HTTPSigner" - Signs through a remote signer over https with mutual tls
+type HTTPSigner struct {
+	url    string
+	client http.Client
+	pubKey crypto.PublicKey
+}
+
+var _ Signer = &HTTPSigner{}
+
+// "NewHTTPSigner" - Creates a signer for the https remote signer at the url, the public key is fetched from the signer;
+// the node authenticates with the client certificate of the tls config and only trusts a signer certified by its authority
+func NewHTTPSigner(url string, timeoutMillis int64, tlsConfig *tls.Config) (*HTTPSigner, error) {
+	if tlsConfig == nil || len(tlsConfig.Certificates) == 0 {
+		return nil, fmt.Errorf("the http remote signer requires a client certificate for mutual tls")
+	}
+	if !strings.HasPrefix(url, "https://") {
+		return nil, fmt.Errorf("the http remote signer must be served over https, got %s", url)
+	}
+	hs := &HTTPSigner{
+		url: strings.TrimRight(url, "/"),
+		client: http.Client{
+			Timeout:   time.Duration(timeoutMillis) * time.Millisecond,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}
+	resp, err := hs.client.Get(hs.url + HTTPSignerPubKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the public key endpoint of the remote signer returned status %d", resp.StatusCode)
+	}
+	var res HTTPSignerPubKeyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+	hs.pubKey, err = crypto.NewPublicKey(res.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return hs, nil
+}
+
+// "PublicKey" - Returns the public key of the http remote signer
+func (hs *HTTPSigner) PublicKey() crypto.PublicKey {
+	return hs.pubKey
+}
+
+// "Sign" - Signs the typed payload through the http remote signer
+func (hs *HTTPSigner) Sign(signType string, payload []byte) ([]byte, error) {
+	msg, err := SignBytes(signType, payload, hs.pubKey)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(HTTPSignerSignRequest{
+		PublicKey: hs.pubKey.RawString(),
+		Type:      signType,
+		Payload:   hex.EncodeToString(payload),
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := hs.client.Post(hs.url+HTTPSignerSignPath, "application/json", bytes.NewBuffer(bz))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var res HTTPSignerSignResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, fmt.Errorf("the sign endpoint of the remote signer returned status %d: %s", resp.StatusCode, string(body))
+	}
+	if resp.StatusCode != http.StatusOK || res.Error != "" {
+		return nil, fmt.Errorf("the sign endpoint of the remote signer returned status %d: %s", resp.StatusCode, res.Error)
+	}
+	sig, err := hex.DecodeString(res.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifySignerSignature(hs.pubKey, msg, sig); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// "NewHTTPSignerHandler" - Returns the http handler of a stand-in remote signer holding the key, to be served over https
+// with mutual tls (see NewSignerTLSConfig): requests without a verified client certificate are refused
+func NewHTTPSignerHandler(pk crypto.PrivateKey) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(HTTPSignerPubKeyPath, func(w http.ResponseWriter, r *http.Request) {
+		writeHTTPSignerResponse(w, http.StatusOK, HTTPSignerPubKeyResponse{PublicKey: pk.PublicKey().RawString()})
+	})
+	mux.HandleFunc(HTTPSignerSignPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeHTTPSignerResponse(w, http.StatusMethodNotAllowed, HTTPSignerSignResponse{Error: "the sign endpoint only accepts POST"})
+			return
+		}
+		var req HTTPSignerSignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeHTTPSignerResponse(w, http.StatusBadRequest, HTTPSignerSignResponse{Error: err.Error()})
+			return
+		}
+		if req.PublicKey != pk.PublicKey().RawString() {
+			writeHTTPSignerResponse(w, http.StatusBadRequest, HTTPSignerSignResponse{Error: "unknown public key " + req.PublicKey})
+			return
+		}
+		payload, err := hex.DecodeString(req.Payload)
+		if err != nil {
+			writeHTTPSignerResponse(w, http.StatusBadRequest, HTTPSignerSignResponse{Error: err.Error()})
+			return
+		}
+		sig, err := NewFileSigner(pk).Sign(req.Type, payload)
+		if err != nil {
+			writeHTTPSignerResponse(w, http.StatusBadRequest, HTTPSignerSignResponse{Error: err.Error()})
+			return
+		}
+		writeHTTPSignerResponse(w, http.StatusOK, HTTPSignerSignResponse{Signature: hex.EncodeToString(sig)})
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+			writeHTTPSignerResponse(w, http.StatusUnauthorized, HTTPSignerSignResponse{Error: "a verified client certificate is required"})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// "writeHTTPSignerResponse" - Writes the json response of a stand-in remote signer
+func writeHTTPSignerResponse(w http.ResponseWriter, status int, res interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
@@ -0,0 +1,208 @@
+package types
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pokt-network/posmint/crypto"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/privval"
+)
+
+const maxPrivValMsgSize = 1024 * 10 // the max size of a message of the privval socket protocol, besides the payload to sign
+
+// the codec of the privval socket connection, with the messages of the servicer key in place of votes and proposals
+var privValCdc = amino.NewCodec()
+
+func init() {
+	cryptoAmino.RegisterAmino(privValCdc)
+	privval.RegisterRemoteSignerMsg(privValCdc)
+	privValCdc.RegisterConcrete(&SignPayloadRequest{}, "pocket/remotesigner/SignPayloadRequest", nil)
+	privValCdc.RegisterConcrete(&SignedPayloadResponse{}, "pocket/remotesigner/SignedPayloadResponse", nil)
+}
+
+// "SignPayloadRequest" - The request to sign a typed payload of the servicer key (see SignBytes)
+type SignPayloadRequest struct {
+	Type    string
+	Payload []byte
+}
+
+// "SignedPayloadResponse" - The signature of the typed payload or an error
+type SignedPayloadResponse struct {
+	Signature []byte
+	Error     *privval.RemoteSignerError
+}
+
+// "PrivValSigner" - Signs through a remote signer over the socket connection of tendermint's privval protocol:
+// the node listens on the address and the remote signer dials in, like a tendermint remote signer, but the signer only
+// signs the typed payloads of the servicer key, it's not a tendermint validator signer and never signs votes or proposals
+type PrivValSigner struct {
+	mtx      sync.Mutex
+	listener net.Listener
+	conn     net.Conn
+	pubKey   crypto.PublicKey
+}
+
+var _ Signer = &PrivValSigner{}
+
+// "NewPrivValSigner" - Listens on the address (tcp://host:port or unix://path) for the remote signer
+// and waits for the remote signer to connect, the public key is fetched from the signer
+func NewPrivValSigner(listenAddr string, timeoutMillis int64) (*PrivValSigner, error) {
+	timeout := time.Duration(timeoutMillis) * time.Millisecond
+	protocol, address := cmn.ProtocolAndAddress(listenAddr)
+	ln, err := net.Listen(protocol, address)
+	if err != nil {
+		return nil, err
+	}
+	var listener net.Listener
+	switch protocol {
+	case "unix":
+		unixListener := privval.NewUnixListener(ln)
+		privval.UnixListenerTimeoutAccept(timeout)(unixListener)
+		privval.UnixListenerTimeoutReadWrite(timeout)(unixListener)
+		listener = unixListener
+	case "tcp":
+		tcpListener := privval.NewTCPListener(ln, ed25519.GenPrivKey())
+		privval.TCPListenerTimeoutAccept(timeout)(tcpListener)
+		privval.TCPListenerTimeoutReadWrite(timeout)(tcpListener)
+		listener = tcpListener
+	default:
+		_ = ln.Close()
+		return nil, fmt.Errorf("wrong listen address: expected either 'tcp' or 'unix' protocols, got %s", protocol)
+	}
+	ps := &PrivValSigner{listener: listener}
+	res, err := ps.sendRequest(&privval.PubKeyRequest{})
+	if err != nil {
+		_ = ps.Close()
+		return nil, err
+	}
+	pkResp, ok := res.(*privval.PubKeyResponse)
+	if !ok {
+		_ = ps.Close()
+		return nil, fmt.Errorf("unexpected response from the remote signer %T", res)
+	}
+	if pkResp.Error != nil {
+		_ = ps.Close()
+		return nil, pkResp.Error
+	}
+	ps.pubKey, err = crypto.PubKeyToPublicKey(pkResp.PubKey)
+	if err != nil {
+		_ = ps.Close()
+		return nil, err
+	}
+	return ps, nil
+}
+
+// "PublicKey" - Returns the public key of the privval remote signer
+func (ps *PrivValSigner) PublicKey() crypto.PublicKey {
+	return ps.pubKey
+}
+
+// "Sign" - Signs the typed payload through the privval remote signer
+func (ps *PrivValSigner) Sign(signType string, payload []byte) ([]byte, error) {
+	msg, err := SignBytes(signType, payload, ps.pubKey)
+	if err != nil {
+		return nil, err
+	}
+	res, err := ps.sendRequest(&SignPayloadRequest{Type: signType, Payload: payload})
+	if err != nil {
+		return nil, err
+	}
+	sigResp, ok := res.(*SignedPayloadResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response from the remote signer %T", res)
+	}
+	if sigResp.Error != nil {
+		return nil, sigResp.Error
+	}
+	if err := verifySignerSignature(ps.pubKey, msg, sigResp.Signature); err != nil {
+		return nil, err
+	}
+	return sigResp.Signature, nil
+}
+
+// "Close" - Closes the connection with the remote signer and stops listening
+func (ps *PrivValSigner) Close() error {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	ps.dropConnection()
+	return ps.listener.Close()
+}
+
+// "sendRequest" - Sends the request to the remote signer and reads the response,
+// a broken connection is dropped and the remote signer is expected to dial in again
+func (ps *PrivValSigner) sendRequest(req privval.SignerMessage) (privval.SignerMessage, error) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	if ps.conn == nil {
+		conn, err := ps.listener.Accept()
+		if err != nil {
+			return nil, fmt.Errorf("the remote signer is not connected: %s", err.Error())
+		}
+		ps.conn = conn
+	}
+	if _, err := privValCdc.MarshalBinaryLengthPrefixedWriter(ps.conn, req); err != nil {
+		ps.dropConnection()
+		return nil, err
+	}
+	var res privval.SignerMessage
+	if _, err := privValCdc.UnmarshalBinaryLengthPrefixedReader(ps.conn, &res, maxPrivValMsgSize); err != nil {
+		ps.dropConnection()
+		return nil, err
+	}
+	return res, nil
+}
+
+// "dropConnection" - Closes the connection with the remote signer
+func (ps *PrivValSigner) dropConnection() {
+	if ps.conn != nil {
+		_ = ps.conn.Close()
+		ps.conn = nil
+	}
+}
+
+// "ServePrivValSigner" - Serves the requests of a node over the privval socket connection with the servicer key,
+// so a stand-in remote signer is a dial to the node (see privval.DialTCPFn and privval.DialUnixFn) and a call to this;
+// only the typed payloads of the servicer key are signed, votes and proposals are refused. A relay response is signed
+// with its payload, so the requests are read up to the max payload size (the max rpc body size of the node)
+func ServePrivValSigner(conn net.Conn, pk crypto.PrivateKey, maxPayloadSize int64) error {
+	maxMsgSize := maxPayloadSize + maxPrivValMsgSize
+	for {
+		// the node may be idle for a long time between requests
+		if err := conn.SetDeadline(time.Time{}); err != nil {
+			return err
+		}
+		var req privval.SignerMessage
+		if _, err := privValCdc.UnmarshalBinaryLengthPrefixedReader(conn, &req, maxMsgSize); err != nil {
+			return err
+		}
+		var res privval.SignerMessage
+		switch r := req.(type) {
+		case *privval.PubKeyRequest:
+			res = &privval.PubKeyResponse{PubKey: pk.PubKey()}
+		case *SignPayloadRequest:
+			sig, err := NewFileSigner(pk).Sign(r.Type, r.Payload)
+			if err != nil {
+				res = &SignedPayloadResponse{Error: &privval.RemoteSignerError{Code: 0, Description: err.Error()}}
+			} else {
+				res = &SignedPayloadResponse{Signature: sig}
+			}
+		case *privval.PingRequest:
+			res = &privval.PingResponse{}
+		case *privval.SignVoteRequest:
+			res = &privval.SignedVoteResponse{Error: &privval.RemoteSignerError{Code: 0, Description: "the servicer key doesn't sign votes"}}
+		case *privval.SignProposalRequest:
+			res = &privval.SignedProposalResponse{Error: &privval.RemoteSignerError{Code: 0, Description: "the servicer key doesn't sign proposals"}}
+		default:
+			res = &SignedPayloadResponse{Error: &privval.RemoteSignerError{Code: 0, Description: fmt.Sprintf("unsupported request %T", req)}}
+		}
+		if _, err := privValCdc.MarshalBinaryLengthPrefixedWriter(conn, res); err != nil {
+			return err
+		}
+	}
+}
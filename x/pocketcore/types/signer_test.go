@@ -0,0 +1,291 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pokt-network/posmint/crypto"
+	sdk "github.com/pokt-network/posmint/types"
+	authTypes "github.com/pokt-network/posmint/x/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/privval"
+)
+
+func TestGetSigner(t *testing.T) {
+	kp := GetRandomPrivateKey()
+	InitPVKeyFile(privval.FilePVKey{
+		Address: kp.PubKey().Address(),
+		PubKey:  kp.PubKey(),
+		PrivKey: kp.PrivKey(),
+	})
+	signer, err := GetSigner()
+	assert.Nil(t, err)
+	assert.Equal(t, kp.PublicKey().RawString(), signer.PublicKey().RawString())
+	// a configured signer takes precedence over the file
+	other := GetRandomPrivateKey()
+	InitSigner(NewFileSigner(other))
+	defer InitSigner(nil)
+	signer, err = GetSigner()
+	assert.Nil(t, err)
+	assert.Equal(t, other.PublicKey().RawString(), signer.PublicKey().RawString())
+}
+
+func TestSignerKey(t *testing.T) {
+	kp := GetRandomPrivateKey()
+	sk := NewSignerKey(NewFileSigner(kp))
+	msg := testClaimSignBytes()
+	sig, err := sk.Sign(msg)
+	assert.Nil(t, err)
+	assert.True(t, kp.PublicKey().VerifyBytes(msg, sig))
+	// arbitrary bytes are never signed
+	_, err = sk.Sign([]byte("relay"))
+	assert.NotNil(t, err)
+	assert.True(t, sk.PubKey().Equals(kp.PubKey()))
+	assert.True(t, sk.Equals(kp.PrivKey()))
+	assert.False(t, sk.Equals(GetRandomPrivateKey().PrivKey()))
+	// the key material is never exposed
+	assert.Nil(t, sk.Bytes())
+	assert.Nil(t, sk.PrivKey())
+	assert.Empty(t, sk.RawString())
+}
+
+func TestSignBytes(t *testing.T) {
+	kp := GetRandomPrivateKey()
+	pubKey := kp.PublicKey().RawString()
+	resp := RelayResponse{Response: "{}", Proof: RelayProof{ServicerPubKey: pubKey, Blockchain: "0001"}}
+	foreignResp := RelayResponse{Response: "{}", Proof: RelayProof{ServicerPubKey: getRandomPubKey().RawString()}}
+	aat := AAT{ApplicationPublicKey: pubKey, ClientPublicKey: pubKey}
+	appAAT := AAT{ApplicationPublicKey: getRandomPubKey().RawString(), ClientPublicKey: pubKey}
+	probeProof := RelayProof{ServicerPubKey: getRandomPubKey().RawString(), Token: aat}
+	relayProof := RelayProof{ServicerPubKey: getRandomPubKey().RawString(), Token: appAAT}
+	proofTx := authTypes.StdSignBytes("pocket-test", 1, sdk.Coins{}, []sdk.Msg{MsgProof{}}, "")
+	stakeTx, _ := json.Marshal(map[string]interface{}{"msgs": []json.RawMessage{[]byte(`{"type":"pos/MsgStake","value":{}}`)}})
+	tests := []struct {
+		name     string
+		signType string
+		payload  interface{}
+		want     []byte
+		hasError bool
+	}{
+		{"relay response", RelayResponseSignType, resp, resp.Hash(), false},
+		{"relay response of another servicer", RelayResponseSignType, foreignResp, nil, true},
+		{"probe token", ProbeTokenSignType, aat, aat.Hash(), false},
+		{"token of an application", ProbeTokenSignType, appAAT, nil, true},
+		{"probe proof", ProbeProofSignType, probeProof, probeProof.Hash(), false},
+		{"proof of an application relay", ProbeProofSignType, relayProof, nil, true},
+		{"claim tx", TxSignType, json.RawMessage(testClaimSignBytes()), testClaimSignBytes(), false},
+		{"proof tx", TxSignType, json.RawMessage(proofTx), proofTx, false},
+		{"stake tx", TxSignType, json.RawMessage(stakeTx), nil, true},
+		{"raw bytes", "raw", "relay", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, _ := json.Marshal(tt.payload)
+			got, err := SignBytes(tt.signType, payload, kp.PublicKey())
+			assert.Equal(t, tt.hasError, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSignPayload(t *testing.T) {
+	kp := GetRandomPrivateKey()
+	resp := RelayResponse{Response: "{}", Proof: RelayProof{ServicerPubKey: kp.PublicKey().RawString()}}
+	// a signer key and a plain key sign the same bytes
+	sig, err := SignPayload(NewSignerKey(NewFileSigner(kp)), RelayResponseSignType, resp, resp.Hash())
+	assert.Nil(t, err)
+	assert.True(t, kp.PublicKey().VerifyBytes(resp.Hash(), sig))
+	sig, err = SignPayload(kp, RelayResponseSignType, resp, resp.Hash())
+	assert.Nil(t, err)
+	assert.True(t, kp.PublicKey().VerifyBytes(resp.Hash(), sig))
+}
+
+func TestHTTPSigner(t *testing.T) {
+	kp := GetRandomPrivateKey()
+	tlsConfig := newTestSignerTLSConfig(t)
+	server := httptest.NewUnstartedServer(NewHTTPSignerHandler(kp))
+	server.TLS = tlsConfig
+	server.StartTLS()
+	defer server.Close()
+	signer, err := NewHTTPSigner(server.URL, 1000, tlsConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, kp.PublicKey().RawString(), signer.PublicKey().RawString())
+	resp, _ := json.Marshal(RelayResponse{Response: "{}", Proof: RelayProof{ServicerPubKey: kp.PublicKey().RawString()}})
+	msg, _ := SignBytes(RelayResponseSignType, resp, kp.PublicKey())
+	sig, err := signer.Sign(RelayResponseSignType, resp)
+	assert.Nil(t, err)
+	assert.True(t, kp.PublicKey().VerifyBytes(msg, sig))
+	// the signer refuses arbitrary bytes
+	_, err = signer.Sign("raw", []byte("relay"))
+	assert.NotNil(t, err)
+	// a client without a certificate is refused
+	noCert := http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: tlsConfig.RootCAs}}}
+	res, err := noCert.Get(server.URL + HTTPSignerPubKeyPath)
+	if err == nil {
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+		_ = res.Body.Close()
+	}
+	// the handler refuses plain http
+	plain := httptest.NewServer(NewHTTPSignerHandler(kp))
+	defer plain.Close()
+	res, err = http.Get(plain.URL + HTTPSignerPubKeyPath)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	_ = res.Body.Close()
+	_, err = NewHTTPSigner(plain.URL, 1000, tlsConfig)
+	assert.NotNil(t, err)
+	_, err = NewHTTPSigner(server.URL, 1000, nil)
+	assert.NotNil(t, err)
+	// the signature of a signer holding a different key is rejected
+	wrong := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == HTTPSignerPubKeyPath {
+			writeHTTPSignerResponse(w, http.StatusOK, HTTPSignerPubKeyResponse{PublicKey: kp.PublicKey().RawString()})
+			return
+		}
+		sig, _ := GetRandomPrivateKey().Sign(msg)
+		writeHTTPSignerResponse(w, http.StatusOK, HTTPSignerSignResponse{Signature: hex.EncodeToString(sig)})
+	}))
+	wrong.TLS = tlsConfig
+	wrong.StartTLS()
+	defer wrong.Close()
+	signer, err = NewHTTPSigner(wrong.URL, 1000, tlsConfig)
+	assert.Nil(t, err)
+	_, err = signer.Sign(RelayResponseSignType, resp)
+	assert.NotNil(t, err)
+	// an unreachable signer
+	_, err = NewHTTPSigner("https://127.0.0.1:1", 1000, tlsConfig)
+	assert.NotNil(t, err)
+}
+
+func TestPrivValSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "privval")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	tests := []struct {
+		name       string
+		listenAddr string
+		dial       func(addr string) privval.SocketDialer
+	}{
+		{
+			name:       "unix socket",
+			listenAddr: "unix://" + filepath.Join(dir, "signer.sock"),
+			dial: func(addr string) privval.SocketDialer {
+				return privval.DialUnixFn(addr[len("unix://"):])
+			},
+		},
+		{
+			name:       "tcp socket",
+			listenAddr: "tcp://" + privval.GetFreeLocalhostAddrPort(),
+			dial: func(addr string) privval.SocketDialer {
+				return privval.DialTCPFn(addr[len("tcp://"):], time.Second, ed25519.GenPrivKey())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kp := GetRandomPrivateKey()
+			// the stand-in signer dials the node, retrying until the node listens
+			go standInPrivValSigner(tt.dial(tt.listenAddr), kp)
+			signer, err := NewPrivValSigner(tt.listenAddr, 5000)
+			assert.Nil(t, err)
+			defer signer.Close()
+			assert.Equal(t, kp.PublicKey().RawString(), signer.PublicKey().RawString())
+			msg := testClaimSignBytes()
+			sig, err := signer.Sign(TxSignType, msg)
+			assert.Nil(t, err)
+			assert.True(t, kp.PublicKey().VerifyBytes(msg, sig))
+			// the signer refuses arbitrary bytes
+			_, err = signer.Sign(TxSignType, []byte("relay"))
+			assert.NotNil(t, err)
+			// a relay response over the size of a privval message is signed with its payload
+			resp := RelayResponse{Response: `{"result":"` + strings.Repeat("a", 3*maxPrivValMsgSize) + `"}`,
+				Proof: RelayProof{ServicerPubKey: kp.PublicKey().RawString()}}
+			sig, err = SignPayload(NewSignerKey(signer), RelayResponseSignType, resp, resp.Hash())
+			assert.Nil(t, err)
+			assert.True(t, kp.PublicKey().VerifyBytes(resp.Hash(), sig))
+			// a relay response over the max payload size of the signer is refused
+			resp.Response = strings.Repeat("a", testPrivValMaxPayloadSize+maxPrivValMsgSize)
+			_, err = SignPayload(NewSignerKey(signer), RelayResponseSignType, resp, resp.Hash())
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+// the max payload size of the stand-in privval signer of the tests
+const testPrivValMaxPayloadSize = 1024 * 100
+
+func standInPrivValSigner(dial privval.SocketDialer, kp crypto.PrivateKey) {
+	for i := 0; i < 50; i++ {
+		conn, err := dial()
+		if err != nil {
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		_ = ServePrivValSigner(conn, kp, testPrivValMaxPayloadSize)
+		_ = conn.Close()
+		return
+	}
+}
+
+// the sign bytes of a claim transaction
+func testClaimSignBytes() []byte {
+	return []byte(`{"chain_id":"pocket-test","entropy":"1","fee":[],"memo":"","msgs":[{"type":"pocketcore/claim","value":{}}]}`)
+}
+
+// the mutual tls config of a test signer and node, with a certificate for 127.0.0.1 signed by a test authority
+func newTestSignerTLSConfig(t *testing.T) *tls.Config {
+	dir, err := ioutil.TempDir("", "signer-tls")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	caKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "signer test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	assert.Nil(t, err)
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	assert.Nil(t, err)
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	writePEM := func(name, blockType string, bz []byte) string {
+		path := filepath.Join(dir, name)
+		assert.Nil(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bz}), 0600))
+		return path
+	}
+	tlsConfig, err := NewSignerTLSConfig(writePEM("cert.pem", "CERTIFICATE", der), writePEM("key.pem", "EC PRIVATE KEY", keyDER), writePEM("ca.pem", "CERTIFICATE", caDER))
+	assert.Nil(t, err)
+	return tlsConfig
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"errors"
+)
+
+// "AppUsage" - The relays consumed by an application in a session, aggregated from the verified relay receipts
+type AppUsage struct {
+	ApplicationPubKey  string `json:"app_pubkey"`           // the public key of the application
+	SessionBlockHeight int64  `json:"session_block_height"` // the session block height
+	TotalRelays        int64  `json:"total_relays"`         // the total relays verified for the session
+	Receipts           int64  `json:"receipts"`             // the number of relay receipts aggregated
+}
+
+// "Validate" - Validates the app usage object
+func (au AppUsage) Validate() error {
+	// validate the public key of the application
+	if err := PubKeyVerification(au.ApplicationPubKey); err != nil {
+		return err
+	}
+	// validate the session block height
+	if au.SessionBlockHeight < 1 {
+		return NewInvalidBlockHeightError(ModuleName)
+	}
+	// validate the totals
+	if au.TotalRelays < 0 || au.Receipts < 0 {
+		return errors.New("the totals of the app usage are negative")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package pocketcore
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+var (
+	// the claim and proof workers of the session blocks
+	workers sync.WaitGroup
+	// guards the start of the workers against their stop
+	workersMu sync.Mutex
+	// true once the workers are stopped
+	workersStopped bool
+	// closed to interrupt the workers waiting to start
+	workersQuit = make(chan struct{})
+)
+
+// "startWorker" - Runs the worker in the background, unless the workers are stopped
+func startWorker(worker func()) bool {
+	workersMu.Lock()
+	defer workersMu.Unlock()
+	if workersStopped {
+		return false
+	}
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		worker()
+	}()
+	return true
+}
+
+// "waitToWork" - Sleeps for the duration, returns false if the workers are stopped in the meantime
+func waitToWork(d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-workersQuit:
+		return false
+	}
+}
+
+// "StopWorkers" - Stops the start of new claim and proof workers and waits on the running ones until the context is done
+func StopWorkers(ctx context.Context) error {
+	workersMu.Lock()
+	if !workersStopped {
+		workersStopped = true
+		close(workersQuit)
+	}
+	workersMu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
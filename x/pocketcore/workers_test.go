@@ -0,0 +1,53 @@
+package pocketcore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStopWorkers(t *testing.T) {
+	defer func() {
+		workersStopped = false
+		workersQuit = make(chan struct{})
+	}()
+	// a worker waiting to start is interrupted, a running worker is waited on
+	interrupted := make(chan bool, 1)
+	assert.True(t, startWorker(func() {
+		interrupted <- !waitToWork(time.Hour)
+	}))
+	running, finished := make(chan struct{}), make(chan struct{})
+	assert.True(t, startWorker(func() {
+		close(running)
+		time.Sleep(100 * time.Millisecond)
+		close(finished)
+	}))
+	<-running
+	assert.Nil(t, StopWorkers(context.Background()))
+	assert.True(t, <-interrupted)
+	select {
+	case <-finished:
+	default:
+		t.Fatal("the workers were not waited on")
+	}
+	// the workers don't start once stopped
+	assert.False(t, startWorker(func() {}))
+	assert.Nil(t, StopWorkers(context.Background()))
+}
+
+func TestStopWorkers_Timeout(t *testing.T) {
+	defer func() {
+		workersStopped = false
+		workersQuit = make(chan struct{})
+	}()
+	release := make(chan struct{})
+	defer close(release)
+	assert.True(t, startWorker(func() {
+		<-release
+	}))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, StopWorkers(ctx))
+}